@@ -2,20 +2,23 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
-	"fmt"
+	"errors"
+	"net"
+	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	child_process_manager "github.com/AgustinSRG/go-child-process-manager"
 	"github.com/facebookincubator/go-belt/tool/logger"
-	audio "github.com/xaionaro-go/audio/pkg/audio/types"
-	"github.com/xaionaro-go/avpipeline/codec"
-	codectypes "github.com/xaionaro-go/avpipeline/codec/types"
-	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
-	avptypes "github.com/xaionaro-go/avpipeline/types"
-	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream/ffargs"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreammetrics"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamwebhook"
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/xsync"
 )
@@ -38,138 +41,88 @@ func main() {
 	platformInit()
 	logger.Debugf(ctx, "platform initialized")
 
-	s, err := ffstream.New(ctx,
-		ffstream.OptionInputRetryIntervalValue(flags.RetryInputTimeoutOnFailure),
-	)
+	s, cfg, err := ffargs.New(ctx, flags)
 	assertNoError(ctx, err)
+	reloader := &configReloader{
+		FFStream: s,
+		Flags:    flags,
+	}
 
+	tlsConfig := sync.OnceValues(func() (*tls.Config, error) {
+		return flags.ControlTLSConfig(ctx)
+	})
 	if flags.ListenControlSocket != "" {
 		logger.Debugf(ctx, "flags.ListenControlSocket == '%s'", flags.ListenControlSocket)
-		listener, err := getListener(ctx, flags.ListenControlSocket)
+		listener, err := ffstreamserver.Listen(ctx, flags.ListenControlSocket, tlsConfig)
 		assertNoError(ctx, err)
 
 		observability.Go(ctx, func(ctx context.Context) {
 			logger.Infof(ctx, "listening for gRPC clients at %s (%T)", listener.Addr(), listener)
-			ffstreamserver.New(s).ServeContext(ctx, listener)
+			srv := ffstreamserver.New(s)
+			srv.ConfigApplier = reloader.Apply
+			srv.Auth = ffstreamserver.NewAuth(flags.AuthAdminToken, flags.AuthReadOnlyToken)
+			srv.ServeContext(ctx, listener)
 		})
 	}
 
-	for _, inputInfo := range flags.Inputs {
-		err = s.AddInput(ctx, inputInfo)
+	if flags.ListenHTTP != "" {
+		logger.Debugf(ctx, "flags.ListenHTTP == '%s'", flags.ListenHTTP)
+		listener, err := ffstreamserver.Listen(ctx, flags.ListenHTTP, tlsConfig)
 		assertNoError(ctx, err)
-	}
 
-	var resolution codec.Resolution
-	var audioSampleRate audio.SampleRate
-
-	var encoderVideoOptions avptypes.DictionaryItems
-	encoderVideoOptions = append(encoderVideoOptions,
-		codec.LowLatencyOptions(ctx, flags.VideoEncoder.Codec, true)...,
-	)
-	encoderVideoOptions = append(encoderVideoOptions,
-		convertUnknownOptionsToCustomOptions(flags.VideoEncoder.Options)...,
-	)
-	encoderVideoOptions = encoderVideoOptions.Deduplicate()
-
-	for idx, v := range encoderVideoOptions {
-		logger.Tracef(ctx, "encoderVideoOptions[%d]: %s=%s", idx, v.Key, v.Value)
-		if len(v.Key) == 0 {
-			logger.Fatalf(ctx, "unexpected empty output option key with value %q", v.Value)
-		}
-		switch v.Key {
-		case "s":
-			_, err := fmt.Sscanf(v.Value, "%dx%d", &resolution.Width, &resolution.Height)
-			assertNoError(ctx, err)
-			logger.Debugf(ctx, "parsed resolution: %dx%d", resolution.Width, resolution.Height)
-		}
+		observability.Go(ctx, func(ctx context.Context) {
+			logger.Infof(ctx, "listening for HTTP/JSON clients at %s (%T)", listener.Addr(), listener)
+			srv := ffstreamserver.New(s)
+			srv.ConfigApplier = reloader.Apply
+			srv.Auth = ffstreamserver.NewAuth(flags.AuthAdminToken, flags.AuthReadOnlyToken)
+			if err := srv.ServeHTTPContext(ctx, listener); err != nil {
+				logger.Errorf(ctx, "unable to serve the HTTP/JSON gateway: %v", err)
+			}
+		})
 	}
 
-	var encoderAudioOptions avptypes.DictionaryItems
-	encoderAudioOptions = append(encoderAudioOptions,
-		convertUnknownOptionsToCustomOptions(flags.AudioEncoder.Options)...,
-	)
-	encoderAudioOptions = encoderAudioOptions.Deduplicate()
-
-	for idx, v := range encoderAudioOptions {
-		logger.Tracef(ctx, "encoderAudioOptions[%d]: %s=%s", idx, v.Key, v.Value)
-		if len(v.Key) == 0 {
-			logger.Fatalf(ctx, "unexpected empty output option key with value %q", v.Value)
-		}
-		switch v.Key {
-		case "ar":
-			must(fmt.Sscanf(v.Value, "%d", &audioSampleRate))
-			logger.Debugf(ctx, "parsed audio sample rate: %d", audioSampleRate)
-		}
-	}
+	prometheus.MustRegister(ffstreammetrics.NewCollector(ctx, s))
+	if flags.ListenMetrics != "" {
+		logger.Debugf(ctx, "flags.ListenMetrics == '%s'", flags.ListenMetrics)
+		// plain HTTP (like -listen_net_pprof), as expected by Prometheus by default
+		listener, err := net.Listen("tcp", flags.ListenMetrics)
+		assertNoError(ctx, err)
 
-	for _, outputParams := range flags.Outputs {
-		logger.Debugf(ctx, "outputParams == %#+v", outputParams)
-		outputOptions := outputParams.CustomOptions
-		var outputFormat string
-		for _, v := range outputOptions {
-			switch v.Key {
-			case "-f":
-				outputFormat = v.Value
-			}
-		}
-		if outputFormat == "mpegts" {
-			var movFlags *avptypes.DictionaryItem
-			for idx, item := range outputOptions {
-				if item.Key == "movflags" {
-					movFlags = &outputOptions[idx]
-					break
-				}
-			}
-			if movFlags == nil {
-				outputOptions = append(outputOptions, avptypes.DictionaryItem{Key: "movflags"})
-				movFlags = &outputOptions[len(outputOptions)-1]
-			}
-			if movFlags.Value != "" {
-				movFlags.Value += "+"
+		observability.Go(ctx, func(ctx context.Context) {
+			logger.Infof(ctx, "listening for Prometheus scrapes at %s (%T)", listener.Addr(), listener)
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			srv := &http.Server{Handler: mux}
+			observability.Go(ctx, func(ctx context.Context) {
+				<-ctx.Done()
+				_ = srv.Close()
+			})
+			err := srv.Serve(listener)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Errorf(ctx, "unable to serve the metrics: %v", err)
 			}
-			movFlags.Value += "frag_keyframe+empty_moov+separate_moof"
-		}
-
-		err := s.AddOutputTemplate(ctx, ffstream.SenderTemplate{
-			URLTemplate:                 outputParams.URL,
-			Options:                     outputOptions,
-			RetryOutputTimeoutOnFailure: flags.RetryOutputTimeoutOnFailure,
 		})
-		assertNoError(ctx, err)
 	}
 
-	hardwareDeviceType := avptypes.HardwareDeviceTypeFromString(flags.HWAccelGlobal)
-	if hardwareDeviceType == -1 {
-		hardwareDeviceType = avptypes.HardwareDeviceTypeNone
-	}
-	transcoderConfig := streammuxtypes.TranscoderConfig{
-		Output: streammuxtypes.TranscoderOutputConfig{
-			VideoTrackConfigs: []streammuxtypes.OutputVideoTrackConfig{{
-				InputTrackIDs:      []int{0, 1, 2, 3, 4, 5, 6, 7},
-				OutputTrackIDs:     []int{0},
-				CodecName:          codectypes.Name(flags.VideoEncoder.Codec),
-				AverageBitRate:     flags.VideoEncoder.BitRate,
-				CustomOptions:      encoderVideoOptions,
-				HardwareDeviceType: hardwareDeviceType,
-				Resolution: codec.Resolution{
-					Width:  resolution.Width,
-					Height: resolution.Height,
-				},
-			}},
-			AudioTrackConfigs: []streammuxtypes.OutputAudioTrackConfig{{
-				InputTrackIDs:  []int{0, 1, 2, 3, 4, 5, 6, 7},
-				OutputTrackIDs: []int{1},
-				CodecName:      codectypes.Name(flags.AudioEncoder.Codec),
-				AverageBitRate: flags.AudioEncoder.BitRate,
-				CustomOptions:  convertUnknownOptionsToCustomOptions(flags.AudioEncoder.Options),
-				SampleRate:     audioSampleRate,
-			}},
-		},
+	var webhookDone chan struct{}
+	if flags.WebhookURL != "" {
+		notifier, err := ffstreamwebhook.New(webhookConfig(flags))
+		assertNoError(ctx, err)
+
+		events := s.SubscribeEvents(ctx)
+		webhookDone = make(chan struct{})
+		observability.Go(ctx, func(ctx context.Context) {
+			defer close(webhookDone)
+			logger.Infof(ctx, "sending the events to the webhook %s", flags.WebhookURL)
+			notifier.Serve(ctx, events)
+		})
 	}
 
-	err = s.Start(ctx, transcoderConfig, flags.MuxMode, flags.AutoBitRate)
+	err = ffargs.Start(ctx, s, cfg)
 	assertNoError(ctx, err)
 
+	observability.Go(ctx, reloader.ServeSIGHUP)
+
 	if logger.FromCtx(ctx).Level() >= logger.LevelTrace {
 		observability.Go(ctx, func(ctx context.Context) {
 			t := time.NewTicker(time.Second)
@@ -191,6 +144,14 @@ func main() {
 	}
 
 	err = s.Wait(ctx)
+	if webhookDone != nil {
+		// let the notifier deliver the last events (e.g. end_of_stream)
+		select {
+		case <-webhookDone:
+		case <-time.After(webhookConfig(flags).DrainTimeout()):
+			logger.Warnf(ctx, "timed out on sending the last events to the webhook")
+		}
+	}
 	assertNoError(ctx, err)
 
 	logger.Infof(ctx, "finished")
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream/ffargs"
+)
+
+// configReloader applies the changes of the configuration (the arguments
+// and the configuration file) to the running stream.
+type configReloader struct {
+	FFStream *ffstream.FFStream
+
+	// Flags are the flags the stream was started with.
+	Flags Flags
+}
+
+// Apply re-parses the arguments together with the given content of
+// the configuration file (nil means: the file of -config), and applies
+// the difference to the stream (see ffargs.Reload).
+func (r *configReloader) Apply(
+	ctx context.Context,
+	config []byte,
+	dryRun bool,
+) ([]ffstream.ConfigChange, error) {
+	return ffargs.Reload(ctx, r.FFStream, r.Flags, os.Args[1:], config, dryRun)
+}
+
+// ServeSIGHUP reloads the configuration on each SIGHUP until ctx is cancelled.
+func (r *configReloader) ServeSIGHUP(ctx context.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+		}
+		logger.Infof(ctx, "received SIGHUP, reloading the configuration")
+		changes, err := r.Apply(ctx, nil, false)
+		for _, change := range changes {
+			logger.Infof(ctx, "config change %s (live: %v, applied: %v)", change, change.IsLive, change.IsApplied)
+		}
+		switch {
+		case err != nil:
+			logger.Errorf(ctx, "unable to reload the configuration: %v", err)
+		case len(changes) == 0:
+			logger.Infof(ctx, "the configuration is not changed")
+		}
+	}
+}
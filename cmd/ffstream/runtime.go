@@ -24,10 +24,11 @@ func initRuntime(
 
 	if flags.ListenNetPprof != "" {
 		observability.Go(ctx, func(ctx context.Context) {
+			// kept for compatibility, use -listen_metrics instead
 			http.Handle(
 				"/metrics",
 				promhttp.Handler(),
-			) // TODO: either split this from pprof argument, or rename the argument (and re-describe it)
+			)
 
 			l.Infof("starting to listen for net/pprof requests at '%s'", flags.ListenNetPprof)
 			l.Error(http.ListenAndServe(flags.ListenNetPprof, nil))
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamwebhook"
+)
+
+// webhookConfig returns the configuration of the -webhook_* flags
+// (see ffstreamwebhook.New); it is meaningful only if WebhookURL is set.
+func webhookConfig(flags Flags) ffstreamwebhook.Config {
+	cfg := ffstreamwebhook.Config{
+		URL:         flags.WebhookURL,
+		Secret:      flags.WebhookSecret,
+		EventTypes:  flags.WebhookEvents,
+		RetryPolicy: ffstreamwebhook.DefaultRetryPolicy(),
+		Timeout:     flags.WebhookTimeout,
+	}
+	if flags.WebhookRetryPolicy != nil {
+		cfg.RetryPolicy = *flags.WebhookRetryPolicy
+	}
+	return cfg
+}
@@ -21,10 +21,14 @@ import (
 	goconvlibav "github.com/xaionaro-go/avpipeline/protobuf/goconv/libavnolibav"
 	avptypes "github.com/xaionaro-go/avpipeline/types"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/client"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/polyjson"
 )
 
+// TokenEnvVar is the environment variable with the default value of flag --token.
+const TokenEnvVar = "FFSTREAM_TOKEN"
+
 var (
 	// Access these variables only from a main package:
 
@@ -215,6 +219,24 @@ var (
 		Run:  inputsSetStop,
 	}
 
+	InputsAdd = &cobra.Command{
+		Use:  "add <input_priority> <url> [key=value...]",
+		Args: cobra.MinimumNArgs(2),
+		Run:  inputsAdd,
+	}
+
+	InputsRemove = &cobra.Command{
+		Use:  "remove <input_priority> <input_num>",
+		Args: cobra.ExactArgs(2),
+		Run:  inputsRemove,
+	}
+
+	InputsReplaceURL = &cobra.Command{
+		Use:  "replace_url <input_priority> <input_num> <url>",
+		Args: cobra.ExactArgs(3),
+		Run:  inputsReplaceURL,
+	}
+
 	Output = &cobra.Command{
 		Use: "output",
 	}
@@ -224,6 +246,50 @@ var (
 		Args: cobra.ExactArgs(8),
 		Run:  outputSwitch,
 	}
+
+	OutputList = &cobra.Command{
+		Use:  "list",
+		Args: cobra.ExactArgs(0),
+		Run:  outputList,
+	}
+
+	OutputRemove = &cobra.Command{
+		Use:  "remove <output_id>",
+		Args: cobra.ExactArgs(1),
+		Run:  outputRemove,
+	}
+
+	OutputCreate = &cobra.Command{
+		Use:  "create <video_codec> <video_width> <video_height> <audio_codec> <audio_sample_rate>",
+		Args: cobra.ExactArgs(5),
+		Run:  outputCreate,
+	}
+
+	OutputWatchSwitches = &cobra.Command{
+		Use:  "watch_switches",
+		Args: cobra.ExactArgs(0),
+		Run:  outputWatchSwitches,
+	}
+
+	Filter = &cobra.Command{
+		Use: "filter",
+	}
+
+	FilterSet = &cobra.Command{
+		Use:  "set <video|audio|complex> <filter_graph>",
+		Args: cobra.ExactArgs(2),
+		Run:  filterSet,
+	}
+
+	LogLevel = &cobra.Command{
+		Use: "log-level",
+	}
+
+	LogLevelSet = &cobra.Command{
+		Use:  "set <none|fatal|panic|error|warning|info|debug|trace>",
+		Args: cobra.ExactArgs(1),
+		Run:  logLevelSet,
+	}
 )
 
 func init() {
@@ -252,6 +318,12 @@ func init() {
 
 	Root.PersistentFlags().Var(&LoggerLevel, "log-level", "")
 	Root.PersistentFlags().String("remote-addr", "localhost:3594", "the address to an ffstream instance")
+	Root.PersistentFlags().String("token", "", "the bearer token to access the ffstream instance (default: $"+TokenEnvVar+")")
+	Root.PersistentFlags().String("ca", "", "the CA certificates file to verify the server certificate of a tls: address (default: the system ones)")
+	Root.PersistentFlags().String("cert", "", "the client certificate file for mutual TLS")
+	Root.PersistentFlags().String("key", "", "the client key file for mutual TLS")
+	Root.PersistentFlags().String("pin-sha256", "", "the expected pin of the server certificate (printed by ffstream on start); without --ca a self-signed certificate is accepted if it matches")
+	Root.PersistentFlags().String("stream", "", "the ID of the stream to control, if the server is ffstreamd (see the 'streams' commands)")
 	Root.PersistentFlags().String("go-net-pprof-addr", "", "address to listen to for net/pprof requests")
 
 	StatsEncoder.PersistentFlags().String("title", "", "stream title")
@@ -274,9 +346,23 @@ func init() {
 	Inputs.AddCommand(InputsInfo)
 	Inputs.AddCommand(InputsSetCustomOption)
 	Inputs.AddCommand(InputsSetStop)
+	Inputs.AddCommand(InputsAdd)
+	Inputs.AddCommand(InputsRemove)
+	Inputs.AddCommand(InputsReplaceURL)
 
 	Root.AddCommand(Output)
 	Output.AddCommand(OutputSwitch)
+	Output.AddCommand(OutputList)
+	Output.AddCommand(OutputRemove)
+	OutputRemove.Flags().Bool("drop", false, "close the output abruptly, dropping the data not sent yet")
+	Output.AddCommand(OutputCreate)
+	Output.AddCommand(OutputWatchSwitches)
+
+	Root.AddCommand(Filter)
+	Filter.AddCommand(FilterSet)
+
+	Root.AddCommand(LogLevel)
+	LogLevel.AddCommand(LogLevelSet)
 
 	polyjson.AutoRegisterTypes = true
 	polyjson.RegisterType(streammuxtypes.AutoBitrateCalculatorThresholds{})
@@ -300,7 +386,7 @@ func statsEncoder(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	stats, err := client.GetStats(ctx)
 	assertNoError(ctx, err)
@@ -314,7 +400,7 @@ func statsBitRates(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	bitRates, err := client.GetBitRates(ctx)
 	assertNoError(ctx, err)
@@ -328,7 +414,7 @@ func statsLatencies(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	latencies, err := client.GetLatencies(ctx)
 	assertNoError(ctx, err)
@@ -342,7 +428,7 @@ func statsInputQuality(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	inputQuality, err := client.GetInputQuality(ctx)
 	assertNoError(ctx, err)
@@ -356,7 +442,7 @@ func statsOutputQuality(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	outputQuality, err := client.GetOutputQuality(ctx)
 	assertNoError(ctx, err)
@@ -371,7 +457,7 @@ func encoderFPSFractionGet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	c := client.New(remoteAddr)
+	c := newClient(cmd, remoteAddr)
 
 	// expecting client.GetFPSFraction(ctx) to return (num uint32, den uint32, err error)
 	num, den, err := c.GetFPSFraction(ctx)
@@ -392,7 +478,7 @@ func encoderFPSFractionSet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	c := client.New(remoteAddr)
+	c := newClient(cmd, remoteAddr)
 
 	// expecting client.SetFPSFraction(ctx, num uint32, den uint32) error
 	err = c.SetFPSFraction(ctx, uint32(num64), uint32(den64))
@@ -405,7 +491,7 @@ func pipelinesGet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	pipelines, err := client.GetPipelines(ctx)
 	assertNoError(ctx, err)
@@ -419,7 +505,7 @@ func autoBitRateCalculatorGet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	calculator, err := client.GetVideoAutoBitRateCalculator(ctx)
 	assertNoError(ctx, err)
@@ -455,7 +541,7 @@ func autoBitRateCalculatorSet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	logger.Debugf(ctx, "setting AutoBitRateCalculator: %#v", m["calculator"])
 	err = client.SetVideoAutoBitRateCalculator(ctx, m["calculator"])
@@ -468,7 +554,7 @@ func autoBitRateConfigGet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	cfg, err := client.GetVideoAutoBitRateConfig(ctx)
 	assertNoError(ctx, err)
@@ -507,7 +593,7 @@ func autoBitRateConfigSet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	logger.Debugf(ctx, "setting AutoBitRateConfig: %#v", m["config"])
 	err = client.SetVideoAutoBitRateConfig(ctx, m["config"])
@@ -520,7 +606,7 @@ func monitor(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	objID, err := strconv.ParseUint(args[0], 10, 64)
 	assertNoError(ctx, err)
@@ -622,7 +708,7 @@ func inputsInfo(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	inputsInfo, err := client.GetInputsInfo(ctx)
 	assertNoError(ctx, err)
@@ -645,7 +731,7 @@ func inputsSetCustomOption(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	err = client.SetInputCustomOption(ctx, inputPriority, inputNum, avptypes.DictionaryItem{
 		Key:   key,
@@ -666,12 +752,81 @@ func inputsSetStop(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	err = client.SetStopInput(ctx, inputPriority, stop)
 	assertNoError(ctx, err)
 }
 
+func inputsAdd(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	inputPriority, err := strconv.ParseUint(args[0], 10, 32)
+	assertNoError(ctx, err)
+
+	url := args[1]
+
+	var customOptions []streammuxtypes.DictionaryItem
+	for _, arg := range args[2:] {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			logger.Panicf(ctx, "invalid custom option %q, expected 'key=value'", arg)
+		}
+		customOptions = append(customOptions, streammuxtypes.DictionaryItem{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	inputNum, err := client.AddInput(ctx, url, inputPriority, customOptions)
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), map[string]any{"input_num": inputNum})
+}
+
+func inputsRemove(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	inputPriority, err := strconv.ParseUint(args[0], 10, 32)
+	assertNoError(ctx, err)
+
+	inputNum, err := strconv.ParseUint(args[1], 10, 32)
+	assertNoError(ctx, err)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.RemoveInput(ctx, inputPriority, inputNum)
+	assertNoError(ctx, err)
+}
+
+func inputsReplaceURL(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	inputPriority, err := strconv.ParseUint(args[0], 10, 32)
+	assertNoError(ctx, err)
+
+	inputNum, err := strconv.ParseUint(args[1], 10, 32)
+	assertNoError(ctx, err)
+
+	url := args[2]
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.ReplaceInputURL(ctx, inputPriority, inputNum, url)
+	assertNoError(ctx, err)
+}
+
 func outputSwitch(cmd *cobra.Command, args []string) {
 	ctx := cmd.Context()
 
@@ -693,7 +848,7 @@ func outputSwitch(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	client := newClient(cmd, remoteAddr)
 
 	logger.Infof(ctx, "switching output: video=%s@%dx%d:%d audio=%s@%d:%d max=%d",
 		videoCodecName, videoWidth, videoHeight, videoAvgBitRate,
@@ -706,3 +861,153 @@ func outputSwitch(cmd *cobra.Command, args []string) {
 
 	logger.Infof(ctx, "output switch completed successfully")
 }
+
+func outputList(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	outputs, err := client.ListOutputs(ctx)
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), outputs)
+}
+
+func outputRemove(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	outputIDRaw, err := strconv.ParseUint(args[0], 10, 64)
+	assertNoError(ctx, err)
+	outputID := client.OutputID(outputIDRaw)
+
+	dropOnClose, err := cmd.Flags().GetBool("drop")
+	assertNoError(ctx, err)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.RemoveOutput(ctx, outputID, dropOnClose)
+	assertNoError(ctx, err)
+}
+
+func outputWatchSwitches(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	ch, err := client.WatchOutputSwitches(ctx)
+	assertNoError(ctx, err)
+
+	for ev := range ch {
+		jsonOutput(ctx, cmd.OutOrStdout(), ev)
+	}
+}
+
+func outputCreate(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	videoCodecName := args[0]
+	videoWidth, err := strconv.ParseUint(args[1], 10, 32)
+	assertNoError(ctx, err)
+	videoHeight, err := strconv.ParseUint(args[2], 10, 32)
+	assertNoError(ctx, err)
+	audioCodecName := args[3]
+	audioSampleRate, err := strconv.ParseUint(args[4], 10, 32)
+	assertNoError(ctx, err)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	outputID, err := client.CreateOutput(ctx, &ffstream_grpc.SenderKey{
+		AudioCodec:      audioCodecName,
+		AudioSampleRate: uint32(audioSampleRate),
+		VideoCodec:      videoCodecName,
+		VideoWidth:      uint32(videoWidth),
+		VideoHeight:     uint32(videoHeight),
+	})
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), map[string]any{"id": outputID})
+}
+
+func filterSet(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	var kind ffstream_grpc.FilterGraphKind
+	switch args[0] {
+	case "video", "v":
+		kind = ffstream_grpc.FilterGraphKind_FILTER_GRAPH_KIND_VIDEO
+	case "audio", "a":
+		kind = ffstream_grpc.FilterGraphKind_FILTER_GRAPH_KIND_AUDIO
+	case "complex":
+		kind = ffstream_grpc.FilterGraphKind_FILTER_GRAPH_KIND_COMPLEX
+	default:
+		logger.Panicf(ctx, "unknown filter graph kind %q, expected 'video', 'audio' or 'complex'", args[0])
+	}
+	description := args[1]
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.SetFilterGraph(ctx, kind, description)
+	assertNoError(ctx, err)
+}
+
+func logLevelSet(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	var level logger.Level
+	err := level.Set(args[0])
+	assertNoError(ctx, err)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.SetLoggingLevel(ctx, level)
+	assertNoError(ctx, err)
+}
+
+// newClient returns a client to the remote address with the token
+// of flag --token (or of the FFSTREAM_TOKEN environment variable)
+// and the TLS settings of flags --ca, --cert, --key and --pin-sha256.
+func newClient(
+	cmd *cobra.Command,
+	remoteAddr string,
+) *client.Client {
+	c := client.New(remoteAddr)
+	token, err := cmd.Flags().GetString("token")
+	assertNoError(cmd.Context(), err)
+	if token == "" {
+		token = os.Getenv(TokenEnvVar)
+	}
+	c.Token = token
+
+	for _, item := range []struct {
+		Flag  string
+		Value *string
+	}{
+		{"ca", &c.TLS.CAFile},
+		{"cert", &c.TLS.CertFile},
+		{"key", &c.TLS.KeyFile},
+		{"pin-sha256", &c.TLS.PinSHA256},
+		{"stream", &c.StreamID},
+	} {
+		*item.Value, err = cmd.Flags().GetString(item.Flag)
+		assertNoError(cmd.Context(), err)
+	}
+	return c
+}
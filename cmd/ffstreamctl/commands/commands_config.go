@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	Config = &cobra.Command{
+		Use: "config",
+	}
+
+	ConfigApply = &cobra.Command{
+		Use:   "apply <config.yaml>",
+		Short: "applies the configuration file to the running ffstream (only the changed parts)",
+		Args:  cobra.ExactArgs(1),
+		Run:   configApply,
+	}
+
+	ConfigReload = &cobra.Command{
+		Use:   "reload",
+		Short: "reloads the configuration file ffstream was started with (the same as SIGHUP)",
+		Args:  cobra.NoArgs,
+		Run:   configReload,
+	}
+)
+
+func init() {
+	ConfigApply.Flags().Bool("dry-run", false, "only print the changes, do not apply them")
+	ConfigReload.Flags().Bool("dry-run", false, "only print the changes, do not apply them")
+
+	Root.AddCommand(Config)
+	Config.AddCommand(ConfigApply)
+	Config.AddCommand(ConfigReload)
+}
+
+func configApply(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	config, err := os.ReadFile(args[0])
+	assertNoError(ctx, err)
+
+	applyConfig(cmd, config)
+}
+
+func configReload(cmd *cobra.Command, args []string) {
+	applyConfig(cmd, nil)
+}
+
+func applyConfig(cmd *cobra.Command, config []byte) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	changes, err := client.ApplyConfig(ctx, config, dryRun)
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), changes)
+}
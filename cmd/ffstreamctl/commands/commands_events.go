@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	Events = &cobra.Command{
+		Use:   "events",
+		Short: "prints the events of the stream (inputs, outputs, encoder changes, errors) as they happen",
+		Args:  cobra.NoArgs,
+		Run:   events,
+	}
+)
+
+func init() {
+	Root.AddCommand(Events)
+}
+
+func events(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	ch, err := client.SubscribeEvents(ctx)
+	assertNoError(ctx, err)
+
+	for ev := range ch {
+		jsonOutput(ctx, cmd.OutOrStdout(), ev)
+	}
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/spf13/cobra"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
+)
+
+var (
+	RetryPolicy = &cobra.Command{
+		Use: "retry_policy",
+	}
+
+	RetryPolicyGet = &cobra.Command{
+		Use:  "get <input|output>",
+		Args: cobra.ExactArgs(1),
+		Run:  retryPolicyGet,
+	}
+
+	RetryPolicySet = &cobra.Command{
+		Use:   "set <input|output>",
+		Short: "modifies the given fields of the current retry policy (or of the default one, if not set)",
+		Args:  cobra.ExactArgs(1),
+		Run:   retryPolicySet,
+	}
+
+	RetryPolicyReset = &cobra.Command{
+		Use:   "reset <input|output>",
+		Short: "removes the retry policy (returns to the legacy behavior)",
+		Args:  cobra.ExactArgs(1),
+		Run:   retryPolicyReset,
+	}
+)
+
+func init() {
+	RetryPolicySet.Flags().Duration("initial-interval", 500*time.Millisecond, "the delay before the first reconnect attempt")
+	RetryPolicySet.Flags().Duration("max-interval", 30*time.Second, "the maximal delay between reconnect attempts")
+	RetryPolicySet.Flags().Float64("multiplier", 2, "the delay is multiplied by this value after each failed attempt")
+	RetryPolicySet.Flags().Float64("jitter", 0.2, "the maximal relative random deviation of the delay")
+	RetryPolicySet.Flags().Uint64("max-attempts", 0, "the maximal amount of reconnect attempts; zero means no limit")
+	RetryPolicySet.Flags().Duration("max-elapsed-time", 0, "the maximal time to retry since the first failure; zero means no limit")
+	RetryPolicySet.Flags().String("on-exhausted", "fallback", "what to do when the retries are exhausted: stop, fallback, continue")
+
+	Root.AddCommand(RetryPolicy)
+	RetryPolicy.AddCommand(RetryPolicyGet)
+	RetryPolicy.AddCommand(RetryPolicySet)
+	RetryPolicy.AddCommand(RetryPolicyReset)
+}
+
+func parseRetryTarget(cmd *cobra.Command, s string) ffstream_grpc.RetryTarget {
+	switch s {
+	case "input":
+		return ffstream_grpc.RetryTarget_RETRY_TARGET_INPUT
+	case "output":
+		return ffstream_grpc.RetryTarget_RETRY_TARGET_OUTPUT
+	default:
+		logger.Panicf(cmd.Context(), "unknown retry target %q, expected 'input' or 'output'", s)
+		return ffstream_grpc.RetryTarget_RETRY_TARGET_UNDEFINED
+	}
+}
+
+func retryPolicyGet(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	target := parseRetryTarget(cmd, args[0])
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	resp, err := client.GetRetryPolicy(ctx, target)
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), resp)
+}
+
+func retryPolicySet(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	target := parseRetryTarget(cmd, args[0])
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	resp, err := client.GetRetryPolicy(ctx, target)
+	assertNoError(ctx, err)
+
+	policy := resp.GetPolicy()
+	isNew := policy == nil
+	if isNew {
+		policy = &ffstream_grpc.RetryPolicy{}
+	}
+	flags := cmd.Flags()
+	setDuration := func(name string, dst *uint64) {
+		if !isNew && !flags.Changed(name) {
+			return
+		}
+		v, err := flags.GetDuration(name)
+		assertNoError(ctx, err)
+		*dst = uint64(goconv.DurationToGRPC(v))
+	}
+	setFloat := func(name string, dst *float64) {
+		if !isNew && !flags.Changed(name) {
+			return
+		}
+		v, err := flags.GetFloat64(name)
+		assertNoError(ctx, err)
+		*dst = v
+	}
+	setDuration("initial-interval", &policy.InitialInterval)
+	setDuration("max-interval", &policy.MaxInterval)
+	setDuration("max-elapsed-time", &policy.MaxElapsedTime)
+	setFloat("multiplier", &policy.Multiplier)
+	setFloat("jitter", &policy.Jitter)
+	if isNew || flags.Changed("max-attempts") {
+		policy.MaxAttempts, err = flags.GetUint64("max-attempts")
+		assertNoError(ctx, err)
+	}
+	if isNew || flags.Changed("on-exhausted") {
+		onExhausted, err := flags.GetString("on-exhausted")
+		assertNoError(ctx, err)
+		v, ok := ffstream_grpc.RetryExhaustedAction_value["RETRY_EXHAUSTED_ACTION_"+strings.ToUpper(onExhausted)]
+		if !ok {
+			logger.Panicf(ctx, "unknown on-exhausted action %q, expected one of: stop, fallback, continue", onExhausted)
+		}
+		policy.OnExhausted = ffstream_grpc.RetryExhaustedAction(v)
+	}
+
+	err = client.SetRetryPolicy(ctx, target, policy)
+	assertNoError(ctx, err)
+}
+
+func retryPolicyReset(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	target := parseRetryTarget(cmd, args[0])
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.SetRetryPolicy(ctx, target, nil)
+	assertNoError(ctx, err)
+}
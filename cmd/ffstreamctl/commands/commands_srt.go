@@ -6,6 +6,7 @@ package commands
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/client"
@@ -13,17 +14,47 @@ import (
 
 var (
 	SRTFlagIntGet = &cobra.Command{
-		Use:  "get",
+		Use:  "get <flag>",
 		Args: cobra.ExactArgs(1),
 		Run:  srtFlagIntGet,
 	}
 
 	SRTFlagIntSet = &cobra.Command{
-		Use:  "set",
+		Use:  "set <flag> <value>",
 		Args: cobra.ExactArgs(2),
 		Run:  srtFlagIntSet,
 	}
 
+	SRTInput = &cobra.Command{
+		Use: "input",
+	}
+
+	SRTInputFlag = &cobra.Command{
+		Use: "flag",
+	}
+
+	SRTInputFlagInt = &cobra.Command{
+		Use: "int",
+	}
+
+	SRTInputFlagIntGet = &cobra.Command{
+		Use:  "get <flag>",
+		Args: cobra.ExactArgs(1),
+		Run:  srtInputFlagIntGet,
+	}
+
+	SRTInputFlagIntSet = &cobra.Command{
+		Use:  "set <flag> <value>",
+		Args: cobra.ExactArgs(2),
+		Run:  srtInputFlagIntSet,
+	}
+
+	StatsInputSRT = &cobra.Command{
+		Use:  "input_srt",
+		Args: cobra.ExactArgs(0),
+		Run:  statsInputSRT,
+	}
+
 	StatsOutputSRT = &cobra.Command{
 		Use:  "output_srt",
 		Args: cobra.ExactArgs(0),
@@ -33,14 +64,33 @@ var (
 
 func init() {
 	StatsOutputSRT.PersistentFlags().Bool("json", false, "use JSON output format")
+	StatsOutputSRT.PersistentFlags().Bool("follow", false, "keep printing the statistics every interval until interrupted")
+	StatsOutputSRT.PersistentFlags().Duration("interval", time.Second, "the interval between the statistics samples in the --follow mode")
+	StatsOutputSRT.PersistentFlags().Int32("output-id", client.ActiveOutputID, "the ID of the output (see 'output list'); negative means the active output")
+	SRT.PersistentFlags().Int32("output-id", client.ActiveOutputID, "the ID of the output (see 'output list'); negative means the active output")
+	for _, cmd := range []*cobra.Command{StatsOutputSRT, SRT} {
+		cmd.PersistentFlags().Uint32("destination", 0, "the index of the output template (see 'destinations' in 'output list'), if the output is sent to multiple destinations")
+	}
 
 	Stats.AddCommand(StatsOutputSRT)
 
+	for _, cmd := range []*cobra.Command{StatsInputSRT, SRTInput} {
+		cmd.PersistentFlags().Uint64("input-id", 0, "the ID of the input (see 'inputs info'); if zero, the input is addressed by --input-priority and --input-num")
+		cmd.PersistentFlags().Uint64("input-priority", 0, "the fallback priority of the input")
+		cmd.PersistentFlags().Uint64("input-num", 0, "the number of the input within its fallback priority")
+	}
+	Stats.AddCommand(StatsInputSRT)
+
 	Root.AddCommand(SRT)
 	SRT.AddCommand(SRTFlag)
 	SRTFlag.AddCommand(SRTFlagInt)
 	SRTFlagInt.AddCommand(SRTFlagIntGet)
 	SRTFlagInt.AddCommand(SRTFlagIntSet)
+	SRT.AddCommand(SRTInput)
+	SRTInput.AddCommand(SRTInputFlag)
+	SRTInputFlag.AddCommand(SRTInputFlagInt)
+	SRTInputFlagInt.AddCommand(SRTInputFlagIntGet)
+	SRTInputFlagInt.AddCommand(SRTInputFlagIntSet)
 }
 
 func statsOutputSRT(cmd *cobra.Command, args []string) {
@@ -49,12 +99,51 @@ func statsOutputSRT(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	outputID, err := cmd.Flags().GetInt32("output-id")
+	assertNoError(ctx, err)
 
-	stats, err := client.GetOutputSRTStats(ctx)
+	destination, err := cmd.Flags().GetUint32("destination")
 	assertNoError(ctx, err)
 
-	jsonOutput(ctx, cmd.OutOrStdout(), stats)
+	follow, err := cmd.Flags().GetBool("follow")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	if !follow {
+		stats, err := client.GetOutputSRTStats(ctx, outputID, destination)
+		assertNoError(ctx, err)
+
+		jsonOutput(ctx, cmd.OutOrStdout(), stats)
+		return
+	}
+
+	interval, err := cmd.Flags().GetDuration("interval")
+	assertNoError(ctx, err)
+
+	useJSON, err := cmd.Flags().GetBool("json")
+	assertNoError(ctx, err)
+
+	ch, err := client.WatchOutputSRTStats(ctx, outputID, destination, interval)
+	assertNoError(ctx, err)
+
+	for stats := range ch {
+		if useJSON {
+			jsonOutput(ctx, cmd.OutOrStdout(), stats)
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(),
+			"%s rtt=%.1fms send=%.3fMbps bw=%.3fMbps loss=%d(total %d) retrans=%d(total %d) drop=%d snd_buf=%dms\n",
+			time.Now().Format(time.TimeOnly),
+			stats.MsRTT,
+			stats.MbpsSendRate,
+			stats.MbpsBandwidth,
+			stats.PktSndLoss, stats.PktSndLossTotal,
+			stats.PktRetrans, stats.PktRetransTotal,
+			stats.PktSndDrop,
+			stats.MsSndBuf,
+		)
+	}
 }
 
 func srtFlagIntGet(cmd *cobra.Command, args []string) {
@@ -66,9 +155,15 @@ func srtFlagIntGet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	outputID, err := cmd.Flags().GetInt32("output-id")
+	assertNoError(ctx, err)
+
+	destination, err := cmd.Flags().GetUint32("destination")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
 
-	value, err := client.GetSRTFlagInt(ctx, flagID)
+	value, err := client.GetSRTFlagInt(ctx, outputID, destination, flagID)
 	assertNoError(ctx, err)
 
 	fmt.Fprintf(cmd.OutOrStdout(), "%d\n", value)
@@ -86,8 +181,88 @@ func srtFlagIntSet(cmd *cobra.Command, args []string) {
 	remoteAddr, err := cmd.Flags().GetString("remote-addr")
 	assertNoError(ctx, err)
 
-	client := client.New(remoteAddr)
+	outputID, err := cmd.Flags().GetInt32("output-id")
+	assertNoError(ctx, err)
+
+	destination, err := cmd.Flags().GetUint32("destination")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.SetSRTFlagInt(ctx, outputID, destination, flagID, value)
+	assertNoError(ctx, err)
+}
+
+func inputAddressFromFlags(cmd *cobra.Command) client.InputAddress {
+	ctx := cmd.Context()
+
+	inputID, err := cmd.Flags().GetUint64("input-id")
+	assertNoError(ctx, err)
+
+	inputPriority, err := cmd.Flags().GetUint64("input-priority")
+	assertNoError(ctx, err)
+
+	inputNum, err := cmd.Flags().GetUint64("input-num")
+	assertNoError(ctx, err)
+
+	return client.InputAddress{
+		ID:       client.InputID(inputID),
+		Priority: inputPriority,
+		Num:      inputNum,
+	}
+}
+
+func statsInputSRT(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	input := inputAddressFromFlags(cmd)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	stats, err := client.GetInputSRTStats(ctx, input)
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), stats)
+}
+
+func srtInputFlagIntGet(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	flagID, err := srtFlagNameToID(args[0])
+	assertNoError(ctx, err)
+
+	input := inputAddressFromFlags(cmd)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	value, err := client.GetInputSRTFlagInt(ctx, input, flagID)
+	assertNoError(ctx, err)
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%d\n", value)
+}
+
+func srtInputFlagIntSet(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	flagID, err := srtFlagNameToID(args[0])
+	assertNoError(ctx, err)
+
+	value, err := strconv.ParseInt(args[1], 10, 64)
+	assertNoError(ctx, err)
+
+	input := inputAddressFromFlags(cmd)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
 
-	err = client.SetSRTFlagInt(ctx, flagID, value)
+	err = client.SetInputSRTFlagInt(ctx, input, flagID, value)
 	assertNoError(ctx, err)
 }
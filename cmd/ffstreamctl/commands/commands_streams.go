@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	Streams = &cobra.Command{
+		Use:   "streams",
+		Short: "manages the streams of ffstreamd (use --stream to address the other commands to one of them)",
+	}
+
+	StreamsList = &cobra.Command{
+		Use:   "list",
+		Short: "lists the streams",
+		Args:  cobra.NoArgs,
+		Run:   streamsList,
+	}
+
+	StreamsCreate = &cobra.Command{
+		Use:   "create <id> -- <ffstream arguments>",
+		Short: "creates a stream from the arguments of the ffstream command, e.g.: create main -- -i rtmp://127.0.0.1/live/main -c copy -f flv rtmp://example.com/live/key",
+		Args:  cobra.MinimumNArgs(1),
+		Run:   streamsCreate,
+	}
+
+	StreamsStart = &cobra.Command{
+		Use:   "start <id>",
+		Short: "starts the stream (a stopped stream is re-created from its arguments)",
+		Args:  cobra.ExactArgs(1),
+		Run:   streamsStart,
+	}
+
+	StreamsStop = &cobra.Command{
+		Use:   "stop <id>",
+		Short: "stops the stream",
+		Args:  cobra.ExactArgs(1),
+		Run:   streamsStop,
+	}
+
+	StreamsDelete = &cobra.Command{
+		Use:   "delete <id>",
+		Short: "stops (if running) and deletes the stream",
+		Args:  cobra.ExactArgs(1),
+		Run:   streamsDelete,
+	}
+
+	StreamsLogLevel = &cobra.Command{
+		Use:   "log-level <none|fatal|panic|error|warning|info|debug|trace>",
+		Short: "sets the logging level of ffstreamd (it is process-wide, so it affects all the streams)",
+		Args:  cobra.ExactArgs(1),
+		Run:   streamsLogLevel,
+	}
+)
+
+func init() {
+	StreamsCreate.Flags().String("config", "", "the configuration file of the stream (see `ffstream -config`), sent to ffstreamd")
+	StreamsCreate.Flags().Bool("start", false, "start the stream right after creating it")
+
+	Root.AddCommand(Streams)
+	Streams.AddCommand(StreamsList)
+	Streams.AddCommand(StreamsCreate)
+	Streams.AddCommand(StreamsStart)
+	Streams.AddCommand(StreamsStop)
+	Streams.AddCommand(StreamsDelete)
+	Streams.AddCommand(StreamsLogLevel)
+}
+
+func streamsList(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	streams, err := client.ListStreams(ctx)
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), streams)
+}
+
+func streamsCreate(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+	configPath, err := cmd.Flags().GetString("config")
+	assertNoError(ctx, err)
+	start, err := cmd.Flags().GetBool("start")
+	assertNoError(ctx, err)
+
+	var config []byte
+	if configPath != "" {
+		config, err = os.ReadFile(configPath)
+		assertNoError(ctx, err)
+	}
+
+	client := newClient(cmd, remoteAddr)
+
+	stream, err := client.CreateStream(ctx, args[0], args[1:], config, start)
+	assertNoError(ctx, err)
+
+	jsonOutput(ctx, cmd.OutOrStdout(), stream)
+}
+
+func streamsStart(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.StartStream(ctx, args[0])
+	assertNoError(ctx, err)
+}
+
+func streamsStop(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.StopStream(ctx, args[0])
+	assertNoError(ctx, err)
+}
+
+func streamsDelete(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.DeleteStream(ctx, args[0])
+	assertNoError(ctx, err)
+}
+
+func streamsLogLevel(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+
+	var level logger.Level
+	err := level.Set(args[0])
+	assertNoError(ctx, err)
+
+	remoteAddr, err := cmd.Flags().GetString("remote-addr")
+	assertNoError(ctx, err)
+
+	client := newClient(cmd, remoteAddr)
+
+	err = client.SetDaemonLoggingLevel(ctx, level)
+	assertNoError(ctx, err)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	prometheusadapter "github.com/facebookincubator/go-belt/tool/experimental/metrics/implementation/prometheus"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	xlogrus "github.com/facebookincubator/go-belt/tool/logger/implementation/logrus"
+	"github.com/sirupsen/logrus"
+	"github.com/xaionaro-go/astiavlogger"
+	"github.com/xaionaro-go/avpipeline"
+	"github.com/xaionaro-go/observability"
+)
+
+const (
+	AppName = "ffstreamd"
+)
+
+func getContext(
+	flags Flags,
+) context.Context {
+	observability.LogLevelFilter.SetLevel(flags.LoggerLevel)
+
+	ctx := context.Background()
+	ctx = metrics.CtxWithMetrics(ctx, prometheusadapter.Default())
+
+	ll := xlogrus.DefaultLogrusLogger()
+	ll.Formatter.(*logrus.TextFormatter).ForceColors = true
+	l := xlogrus.New(ll).WithLevel(logger.LevelTrace).WithPreHooks(&observability.LogLevelFilter)
+	logrus.SetLevel(xlogrus.LevelToLogrus(l.Level()))
+
+	ctx = logger.CtxWithLogger(ctx, l)
+	ctx = belt.WithField(ctx, "program", AppName)
+	if hostname, err := os.Hostname(); err == nil {
+		ctx = belt.WithField(ctx, "hostname", hostname)
+	}
+	ctx = belt.WithField(ctx, "pid", os.Getpid())
+
+	l = logger.FromCtx(ctx)
+	logger.Default = func() logger.Logger {
+		return l
+	}
+
+	astiav.SetLogLevel(avpipeline.LogLevelToAstiav(flags.LoggerLevel))
+	astiav.SetLogCallback(astiavlogger.Callback(l))
+	return ctx
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	flag "github.com/xaionaro-go/ffstream/pkg/ffflag"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream/ffargs"
+)
+
+// Flags are the flags of ffstreamd; the streams have their own
+// arguments, see `ffstreamctl streams create`.
+type Flags struct {
+	ListenControlSocket string
+	ListenHTTP          string
+	AuthAdminToken      string
+	AuthReadOnlyToken   string
+	TLSCert             string
+	TLSKey              string
+	TLSClientCA         string
+	LoggerLevel         logger.Level
+}
+
+func parseFlags(args []string) (Flags, error) {
+	p := flag.NewParser()
+	listenControlSocket := flag.AddParameter(p, "listen_control", false, ptr(flag.String("")))
+	listenHTTP := flag.AddParameter(p, "listen_http", false, ptr(flag.String("")))
+	authAdminToken := flag.AddParameter(p, "auth_admin_token", false, ptr(flag.String("")))
+	authReadOnlyToken := flag.AddParameter(p, "auth_read_only_token", false, ptr(flag.String("")))
+	tlsCert := flag.AddParameter(p, "tls_cert", false, ptr(flag.String("")))
+	tlsKey := flag.AddParameter(p, "tls_key", false, ptr(flag.String("")))
+	tlsClientCA := flag.AddParameter(p, "tls_client_ca", false, ptr(flag.String("")))
+	loggerLevel := flag.AddParameter(p, "v", false, ptr(flag.LogLevel(logger.LevelInfo)))
+
+	if err := p.Parse(args); err != nil {
+		return Flags{}, err
+	}
+	if unknown := append(p.CollectedUnknownOptions, p.CollectedNonFlags...); len(unknown) != 0 {
+		return Flags{}, fmt.Errorf("unexpected arguments: %s", strings.Join(unknown, " "))
+	}
+
+	flags := Flags{
+		ListenControlSocket: listenControlSocket.Value(),
+		ListenHTTP:          listenHTTP.Value(),
+		AuthAdminToken:      authAdminToken.Value(),
+		AuthReadOnlyToken:   authReadOnlyToken.Value(),
+		TLSCert:             tlsCert.Value(),
+		TLSKey:              tlsKey.Value(),
+		TLSClientCA:         tlsClientCA.Value(),
+		LoggerLevel:         loggerLevel.Value(),
+	}
+	if flags.ListenControlSocket == "" && flags.ListenHTTP == "" {
+		return Flags{}, fmt.Errorf("at least one of -listen_control and -listen_http is required")
+	}
+	return flags, nil
+}
+
+// ffargs returns the flags in the form of the ffstream command,
+// to reuse the same TLS and authentication settings.
+func (flags Flags) ffargs() ffargs.Flags {
+	return ffargs.Flags{
+		ListenControlSocket: flags.ListenControlSocket,
+		ListenHTTP:          flags.ListenHTTP,
+		AuthAdminToken:      flags.AuthAdminToken,
+		AuthReadOnlyToken:   flags.AuthReadOnlyToken,
+		TLSCert:             flags.TLSCert,
+		TLSKey:              flags.TLSKey,
+		TLSClientCA:         flags.TLSClientCA,
+		LoggerLevel:         flags.LoggerLevel,
+	}
+}
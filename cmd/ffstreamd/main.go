@@ -0,0 +1,88 @@
+// ffstreamd hosts many streams (each is what a separate ffstream process
+// would be) in one process behind one control API; the streams are
+// managed by `ffstreamctl streams ...` and the other ffstreamctl commands
+// are addressed to one of them by `ffstreamctl --stream <id>`.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	child_process_manager "github.com/AgustinSRG/go-child-process-manager"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamd"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/xsync"
+)
+
+func main() {
+	err := child_process_manager.InitializeChildProcessManager()
+	if err != nil {
+		panic(err)
+	}
+	defer child_process_manager.DisposeChildProcessManager()
+
+	flags, err := parseFlags(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(2)
+	}
+	ctx := getContext(flags)
+	ctx, cancelFn := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancelFn()
+	ctx = xsync.WithNoLogging(ctx, true)
+
+	logger.Debugf(ctx, "flags == %#+v", flags)
+
+	d := ffstreamd.New(ctx)
+	d.Auth = ffstreamserver.NewAuth(flags.AuthAdminToken, flags.AuthReadOnlyToken)
+
+	tlsConfig := sync.OnceValues(func() (*tls.Config, error) {
+		return flags.ffargs().ControlTLSConfig(ctx)
+	})
+	if flags.ListenControlSocket != "" {
+		listener, err := ffstreamserver.Listen(ctx, flags.ListenControlSocket, tlsConfig)
+		assertNoError(ctx, err)
+
+		observability.Go(ctx, func(ctx context.Context) {
+			logger.Infof(ctx, "listening for gRPC clients at %s (%T)", listener.Addr(), listener)
+			if err := d.ServeContext(ctx, listener); err != nil {
+				logger.Errorf(ctx, "unable to serve the gRPC clients: %v", err)
+			}
+		})
+	}
+
+	if flags.ListenHTTP != "" {
+		listener, err := ffstreamserver.Listen(ctx, flags.ListenHTTP, tlsConfig)
+		assertNoError(ctx, err)
+
+		observability.Go(ctx, func(ctx context.Context) {
+			logger.Infof(ctx, "listening for HTTP/JSON clients at %s (%T)", listener.Addr(), listener)
+			if err := d.ServeHTTPContext(ctx, listener); err != nil {
+				logger.Errorf(ctx, "unable to serve the HTTP/JSON gateway: %v", err)
+			}
+		})
+	}
+
+	<-ctx.Done()
+	logger.Infof(ctx, "stopping the streams")
+	if err := d.Close(context.WithoutCancel(ctx)); err != nil {
+		logger.Errorf(ctx, "%v", err)
+	}
+	logger.Infof(ctx, "finished")
+}
+
+func assertNoError(
+	ctx context.Context,
+	err error,
+) {
+	if err != nil {
+		logger.Fatal(ctx, err)
+	}
+}
@@ -34,6 +34,7 @@ require (
 	github.com/xaionaro-go/xsync v0.0.0-20250928140805-f801683b71ba
 	google.golang.org/grpc v1.76.0
 	google.golang.org/protobuf v1.36.10
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -1,44 +1,76 @@
 package cert
 
 import (
-	"crypto/ed25519"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"fmt"
 	"math/big"
+	"net"
 	"time"
 )
 
-func GenerateSelfSignedForServer() (tls.Certificate, error) {
-	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+// GenerateSelfSigned generates a self-signed server certificate
+// for the given host names and IP addresses, in the PEM format.
+func GenerateSelfSigned(hosts []string) (certPEM, keyPEM []byte, _ error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
-		return tls.Certificate{}, err
+		return nil, nil, fmt.Errorf("unable to generate a key: %w", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to generate a serial number: %w", err)
 	}
 
+	now := time.Now()
 	tmpl := x509.Certificate{
-		SerialNumber: big.NewInt(1),
+		SerialNumber: serialNumber,
 		Subject: pkix.Name{
-			Organization: []string{"DX.center"},
+			Organization: []string{"ffstream"},
 		},
-		NotBefore: time.Now(),
-		NotAfter:  time.Now().Add(10 * 365 * 24 * time.Hour),
+		NotBefore: now.Add(-time.Hour),
+		NotAfter:  now.Add(10 * 365 * 24 * time.Hour),
 
 		KeyUsage:    x509.KeyUsageDigitalSignature,
 		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
-		DNSNames:    []string{"wingout.dx.center"},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, host)
+		}
+	}
+	if len(hosts) > 0 {
+		tmpl.Subject.CommonName = hosts[0]
 	}
 
-	certDER, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, pub, priv)
+	certDER, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &priv.PublicKey, priv)
 	if err != nil {
-		return tls.Certificate{}, err
+		return nil, nil, fmt.Errorf("unable to create the certificate: %w", err)
 	}
 
 	keyBytes, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to serialize the key: %w", err)
+	}
 
-	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
-	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+	return certPEM, keyPEM, nil
+}
 
+// GenerateSelfSignedForServer generates an ephemeral self-signed server
+// certificate for the given host names and IP addresses.
+func GenerateSelfSignedForServer(hosts ...string) (tls.Certificate, error) {
+	certPEM, keyPEM, err := GenerateSelfSigned(hosts)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
 	return tls.X509KeyPair(certPEM, keyPEM)
 }
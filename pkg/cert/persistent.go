@@ -0,0 +1,75 @@
+package cert
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// LoadOrGenerateSelfSigned loads the certificate and the key from the files,
+// or, if neither of the files exists, generates a self-signed certificate
+// (see GenerateSelfSigned) and saves it to the files, so that the same
+// certificate is used after restarts (and may be pinned by clients).
+func LoadOrGenerateSelfSigned(
+	certFile string,
+	keyFile string,
+	hosts []string,
+) (_ tls.Certificate, isGenerated bool, _ error) {
+	certExists, err := fileExists(certFile)
+	if err != nil {
+		return tls.Certificate{}, false, err
+	}
+	keyExists, err := fileExists(keyFile)
+	if err != nil {
+		return tls.Certificate{}, false, err
+	}
+	switch {
+	case certExists && keyExists:
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return tls.Certificate{}, false, fmt.Errorf("unable to load the certificate from %q and %q: %w", certFile, keyFile, err)
+		}
+		return cert, false, nil
+	case certExists != keyExists:
+		return tls.Certificate{}, false, fmt.Errorf("only one of %q and %q exists", certFile, keyFile)
+	}
+
+	certPEM, keyPEM, err := GenerateSelfSigned(hosts)
+	if err != nil {
+		return tls.Certificate{}, false, err
+	}
+	for _, item := range []struct {
+		Path string
+		Data []byte
+	}{
+		{keyFile, keyPEM},
+		{certFile, certPEM},
+	} {
+		if err := os.MkdirAll(filepath.Dir(item.Path), 0o700); err != nil {
+			return tls.Certificate{}, false, fmt.Errorf("unable to create the directory of %q: %w", item.Path, err)
+		}
+		if err := os.WriteFile(item.Path, item.Data, 0o600); err != nil {
+			return tls.Certificate{}, false, fmt.Errorf("unable to write %q: %w", item.Path, err)
+		}
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return tls.Certificate{}, false, err
+	}
+	return cert, true, nil
+}
+
+func fileExists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	default:
+		return false, fmt.Errorf("unable to stat %q: %w", path, err)
+	}
+}
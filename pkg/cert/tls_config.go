@@ -0,0 +1,128 @@
+package cert
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// PinSHA256 returns the base64-encoded SHA-256 hash of the public key
+// (SubjectPublicKeyInfo) of the certificate, the same as in HPKP:
+//
+//	openssl x509 -pubkey -noout | openssl pkey -pubin -outform der | openssl dgst -sha256 -binary | base64
+func PinSHA256(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// LeafPinSHA256 returns PinSHA256 of the leaf of the TLS certificate.
+func LeafPinSHA256(cert tls.Certificate) (string, error) {
+	if len(cert.Certificate) == 0 {
+		return "", fmt.Errorf("the certificate is empty")
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the certificate: %w", err)
+	}
+	return PinSHA256(leaf), nil
+}
+
+// ServerConfig is the TLS configuration of a server.
+type ServerConfig struct {
+	Certificate tls.Certificate
+
+	// ClientCAFile (if set) enables mutual TLS: a client certificate
+	// signed by a CA of the file is required.
+	ClientCAFile string
+}
+
+// TLSConfig returns the configuration for crypto/tls.
+func (cfg ServerConfig) TLSConfig() (*tls.Config, error) {
+	result := &tls.Config{
+		Certificates: []tls.Certificate{cfg.Certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAFile != "" {
+		pool, err := loadCertPool(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		result.ClientCAs = pool
+		result.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return result, nil
+}
+
+// ClientConfig is the TLS configuration of a client.
+type ClientConfig struct {
+	// CAFile (if set) is the file of the CA certificates the server
+	// certificate is verified against (instead of the system ones).
+	CAFile string
+
+	// CertFile and KeyFile (if set) are the client certificate
+	// for mutual TLS.
+	CertFile string
+	KeyFile  string
+
+	// PinSHA256 (if set) is the expected PinSHA256 of the server
+	// certificate; if CAFile is not set, the certificate chain is
+	// not verified, so a self-signed certificate is accepted.
+	PinSHA256 string
+}
+
+// TLSConfig returns the configuration for crypto/tls.
+func (cfg ClientConfig) TLSConfig() (*tls.Config, error) {
+	result := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.CAFile != "" {
+		pool, err := loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		result.RootCAs = pool
+	}
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, fmt.Errorf("the client certificate and key should be set together")
+	}
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the client certificate from %q and %q: %w", cfg.CertFile, cfg.KeyFile, err)
+		}
+		result.Certificates = []tls.Certificate{cert}
+	}
+	if pin := strings.TrimPrefix(cfg.PinSHA256, "sha256/"); pin != "" {
+		if _, err := base64.StdEncoding.DecodeString(pin); err != nil {
+			return nil, fmt.Errorf("the pin %q is not a valid base64 string: %w", cfg.PinSHA256, err)
+		}
+		// the chain is verified by crypto/tls if CAFile is set, the pin is checked in any case
+		result.InsecureSkipVerify = cfg.CAFile == ""
+		result.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return fmt.Errorf("the server has not provided a certificate")
+			}
+			if got := PinSHA256(cs.PeerCertificates[0]); got != pin {
+				return fmt.Errorf("the server certificate pin is %q, but expected %q", got, pin)
+			}
+			return nil
+		}
+	}
+	return result, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no PEM certificates found in %q", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,106 @@
+package cert
+
+import (
+	"crypto/tls"
+	"net"
+	"path/filepath"
+	"testing"
+)
+
+func handshake(t *testing.T, server ServerConfig, client ClientConfig) error {
+	t.Helper()
+	serverCfg, err := server.TLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCfg, err := client.TLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCfg.ServerName = "localhost"
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	serverErrCh := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErrCh <- err
+			return
+		}
+		defer conn.Close()
+		tlsConn := tls.Server(conn, serverCfg)
+		err = tlsConn.Handshake()
+		if err == nil {
+			// with TLS 1.3 the client considers the handshake complete
+			// before the server verifies the client certificate, so
+			// the client waits for this byte to learn the result
+			_, err = tlsConn.Write([]byte{0})
+		}
+		serverErrCh <- err
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientCfg)
+	if err != nil {
+		<-serverErrCh
+		return err
+	}
+	defer conn.Close()
+	_, clientErr := conn.Read(make([]byte, 1))
+	if serverErr := <-serverErrCh; serverErr != nil {
+		return serverErr
+	}
+	return clientErr
+}
+
+func TestLoadOrGenerateSelfSigned(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls", "cert.pem")
+	keyFile := filepath.Join(dir, "tls", "key.pem")
+
+	cert, isGenerated, err := LoadOrGenerateSelfSigned(certFile, keyFile, []string{"localhost", "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isGenerated {
+		t.Fatalf("expected the certificate to be generated")
+	}
+	pin, err := LeafPinSHA256(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, isGenerated, err := LoadOrGenerateSelfSigned(certFile, keyFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isGenerated {
+		t.Fatalf("expected the certificate to be loaded")
+	}
+	loadedPin, err := LeafPinSHA256(loaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedPin != pin {
+		t.Fatalf("the pin has changed after reloading: %q != %q", loadedPin, pin)
+	}
+
+	if err := handshake(t, ServerConfig{Certificate: cert}, ClientConfig{PinSHA256: pin}); err != nil {
+		t.Errorf("expected the handshake to succeed with the right pin: %v", err)
+	}
+	if err := handshake(t, ServerConfig{Certificate: cert}, ClientConfig{CAFile: certFile, PinSHA256: "sha256/" + pin}); err != nil {
+		t.Errorf("expected the handshake to succeed with the CA and the pin: %v", err)
+	}
+	if err := handshake(t, ServerConfig{Certificate: cert}, ClientConfig{PinSHA256: "AAAA"}); err == nil {
+		t.Errorf("expected the handshake to fail with a wrong pin")
+	}
+	if err := handshake(t, ServerConfig{Certificate: cert}, ClientConfig{}); err == nil {
+		t.Errorf("expected the handshake to fail without the CA or the pin")
+	}
+	if err := handshake(t, ServerConfig{Certificate: cert, ClientCAFile: certFile}, ClientConfig{PinSHA256: pin}); err == nil {
+		t.Errorf("expected the handshake to fail without a client certificate")
+	}
+}
@@ -18,6 +18,9 @@ type GenericOption struct {
 	OptionSettings
 	Wrapped[any]
 	CollectedUnknownOptions [][]string
+
+	// IsSet is true if the option was met in the parsed arguments.
+	IsSet bool
 }
 
 type Option[V any, W Wrapped[V]] struct {
@@ -91,6 +91,7 @@ func (p *Parser) Parse(args []string) error {
 		if err != nil {
 			return fmt.Errorf("unable to parse the argument to the flag '%s' (raw: '%s') at position #%d: %w", flag.Name, arg, idx, err)
 		}
+		flag.IsSet = true
 
 		if flag.CollectUnknownOptions {
 			flag.CollectedUnknownOptions = append(
@@ -105,6 +106,19 @@ func (p *Parser) Parse(args []string) error {
 	return nil
 }
 
+// SetDefault parses the value into the option without marking it as set,
+// so the value may still be overridden by Parse.
+func (p *Parser) SetDefault(name string, value string) error {
+	opt := p.findOptionByName(name)
+	if opt == nil {
+		return fmt.Errorf("unknown option '%s'", name)
+	}
+	if err := opt.Parse(value); err != nil {
+		return fmt.Errorf("unable to parse the default value '%s' of the option '%s': %w", value, name, err)
+	}
+	return nil
+}
+
 func (p *Parser) findOptionByName(flagName string) *GenericOption {
 	for _, opt := range p.Options {
 		if opt.Name == "" {
@@ -0,0 +1,596 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/kernel"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+)
+
+// ErrRestartRequired is returned by ApplyConfig if some of the changes
+// cannot be applied to a running stream.
+var ErrRestartRequired = errors.New("a restart is required to apply the changes")
+
+// RuntimeConfig is the configuration of a running stream, see ApplyConfig.
+type RuntimeConfig struct {
+	Inputs Resources
+
+	// Outputs are the output templates in the order of the command line,
+	// the fallbacks follow their primary output (see AddOutputTemplate).
+	Outputs []SenderTemplate
+
+	TranscoderConfig  streammuxtypes.TranscoderConfig
+	MuxMode           streammuxtypes.MuxMode
+	AutoBitRateVideo  *streammuxtypes.AutoBitRateVideoConfig
+	StreamMap         StreamMap
+	FilterComplex     *FilterComplex
+	VideoFilter       string
+	AudioFilter       string
+	MissingTrackFill  MissingTrackFill
+	InputRetryPolicy  *RetryPolicy
+	OutputRetryPolicy *RetryPolicy
+}
+
+// ConfigChange is a difference between the running and the new configuration.
+type ConfigChange struct {
+	// Key is the changed part of the configuration, e.g. "inputs[priority=1]".
+	Key         string
+	Description string
+
+	// IsLive is false if the change cannot be applied without a restart.
+	IsLive    bool
+	IsApplied bool
+
+	apply  func(ctx context.Context) error
+	revert func(ctx context.Context) error
+}
+
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("%s: %s", c.Key, c.Description)
+}
+
+// ApplyConfig computes the difference between the running stream and cfg,
+// and applies only the changed parts: inputs, encoders, the automatic
+// bit rate ladder, output URLs, filters, the filling of the missing
+// tracks and retry policies.
+//
+// If any of the changes cannot be applied live, nothing is applied and
+// an error wrapping ErrRestartRequired is returned. If a change fails to be
+// applied, the changes applied before it are reverted. If dryRun is true,
+// the changes are only computed.
+//
+// cfg is compared with the running stream rather than with the previously
+// applied configuration, so the runtime changes of the listed parts (e.g.
+// the inputs added via AddInput, or a SwitchOutputByProps) are reverted to
+// cfg. The outputs created via CreateOutput are kept.
+func (s *FFStream) ApplyConfig(
+	ctx context.Context,
+	cfg RuntimeConfig,
+	dryRun bool,
+) (_ret []ConfigChange, _err error) {
+	logger.Debugf(ctx, "ApplyConfig(ctx, %#+v, %v)", cfg, dryRun)
+	defer func() { logger.Debugf(ctx, "/ApplyConfig(ctx, %#+v, %v): %v, %v", cfg, dryRun, _ret, _err) }()
+	if s.StreamMux == nil {
+		return nil, fmt.Errorf("it is allowed to use ApplyConfig only after Start is invoked")
+	}
+
+	s.configLocker.Lock()
+	defer s.configLocker.Unlock()
+
+	changes, err := s.diffConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var notLive []string
+	for _, change := range changes {
+		if !change.IsLive {
+			notLive = append(notLive, change.String())
+		}
+	}
+	if len(notLive) > 0 {
+		return changes, fmt.Errorf("%w: %s", ErrRestartRequired, strings.Join(notLive, "; "))
+	}
+	if dryRun {
+		return changes, nil
+	}
+
+	return changes, applyConfigChanges(ctx, changes)
+}
+
+// applyConfigChanges applies the changes in order; if a change fails,
+// the changes applied before it are reverted.
+func applyConfigChanges(
+	ctx context.Context,
+	changes []ConfigChange,
+) error {
+	for idx := range changes {
+		change := &changes[idx]
+		logger.Infof(ctx, "applying the config change %s", change)
+		if err := change.apply(ctx); err != nil {
+			err = fmt.Errorf("unable to apply the change %s: %w", change, err)
+			if revertErr := revertConfigChanges(ctx, changes[:idx]); revertErr != nil {
+				return fmt.Errorf("%w; unable to revert the changes applied before it: %w", err, revertErr)
+			}
+			return fmt.Errorf("%w (the changes applied before it are reverted)", err)
+		}
+		change.IsApplied = true
+	}
+	return nil
+}
+
+// revertConfigChanges reverts the applied changes in the reverse order.
+func revertConfigChanges(
+	ctx context.Context,
+	changes []ConfigChange,
+) error {
+	var errs []error
+	for idx := len(changes) - 1; idx >= 0; idx-- {
+		change := &changes[idx]
+		if !change.IsApplied {
+			continue
+		}
+		logger.Infof(ctx, "reverting the config change %s", change)
+		if err := change.revert(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("unable to revert the change %s: %w", change, err))
+			continue
+		}
+		change.IsApplied = false
+	}
+	return errors.Join(errs...)
+}
+
+func (s *FFStream) diffConfig(
+	ctx context.Context,
+	cfg RuntimeConfig,
+) ([]ConfigChange, error) {
+	var changes []ConfigChange
+	add := func(key string, isLive bool, apply, revert func(ctx context.Context) error, format string, args ...any) {
+		changes = append(changes, ConfigChange{
+			Key:         key,
+			Description: fmt.Sprintf(format, args...),
+			IsLive:      isLive,
+			apply:       apply,
+			revert:      revert,
+		})
+	}
+
+	if cfg.MuxMode != s.StreamMux.MuxMode {
+		add("mux_mode", false, nil, nil, "%s -> %s", s.StreamMux.MuxMode, cfg.MuxMode)
+	}
+	if !reflect.DeepEqual(cfg.StreamMap, s.Config.StreamMap) {
+		add("map", false, nil, nil, "the stream mapping changed")
+	}
+
+	oldComplex := s.Filters.GetFilterComplex(ctx)
+	switch {
+	case oldComplex == nil && cfg.FilterComplex == nil:
+	case oldComplex == nil || cfg.FilterComplex == nil:
+		add("filter_complex", false, nil, nil, "the graph is added or removed")
+	case oldComplex.Description == cfg.FilterComplex.Description:
+	case !oldComplex.HasSamePads(cfg.FilterComplex):
+		add("filter_complex", false, nil, nil, "the labeled inputs or outputs of the graph changed")
+	default:
+		description, oldDescription := cfg.FilterComplex.Description, oldComplex.Description
+		add("filter_complex", true, func(ctx context.Context) error {
+			return s.SetFilterComplex(ctx, description)
+		}, func(ctx context.Context) error {
+			return s.SetFilterComplex(ctx, oldDescription)
+		}, "%q -> %q", oldDescription, description)
+	}
+
+	for _, item := range []struct {
+		Key         string
+		MediaType   astiav.MediaType
+		Description string
+	}{
+		{"vf", astiav.MediaTypeVideo, cfg.VideoFilter},
+		{"af", astiav.MediaTypeAudio, cfg.AudioFilter},
+	} {
+		old := s.GetFilterGraph(ctx, item.MediaType)
+		if old == item.Description {
+			continue
+		}
+		add(item.Key, true, func(ctx context.Context) error {
+			return s.SetFilterGraph(ctx, item.MediaType, item.Description)
+		}, func(ctx context.Context) error {
+			return s.SetFilterGraph(ctx, item.MediaType, old)
+		}, "%q -> %q", old, item.Description)
+	}
+
+	if oldFill := s.GetMissingTrackFill(ctx); cfg.MissingTrackFill != oldFill {
+		fill := cfg.MissingTrackFill
+		add("fill_missing_tracks", true, func(ctx context.Context) error {
+			return s.SetMissingTrackFill(ctx, fill)
+		}, func(ctx context.Context) error {
+			return s.SetMissingTrackFill(ctx, oldFill)
+		}, "%s -> %s", oldFill, fill)
+	}
+
+	for _, item := range []struct {
+		Target RetryTarget
+		Policy *RetryPolicy
+	}{
+		{RetryTargetInput, cfg.InputRetryPolicy},
+		{RetryTargetOutput, cfg.OutputRetryPolicy},
+	} {
+		old, err := s.GetRetryPolicy(ctx, item.Target)
+		if err != nil {
+			return nil, err
+		}
+		if reflect.DeepEqual(old, item.Policy) {
+			continue
+		}
+		add(fmt.Sprintf("retry_%s_policy", item.Target), true, func(ctx context.Context) error {
+			return s.SetRetryPolicy(ctx, item.Target, item.Policy)
+		}, func(ctx context.Context) error {
+			return s.SetRetryPolicy(ctx, item.Target, old)
+		}, "%v -> %v", old, item.Policy)
+	}
+
+	changes = append(changes, s.diffInputs(ctx, cfg.Inputs)...)
+	outputChanges, err := s.diffOutputs(ctx, cfg.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, outputChanges...)
+	changes = append(changes, s.diffTranscoderConfig(ctx, cfg.TranscoderConfig)...)
+	autoBitRateChanges, err := s.diffAutoBitRate(ctx, cfg.AutoBitRateVideo)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, autoBitRateChanges...)
+	return changes, nil
+}
+
+func (s *FFStream) diffInputs(
+	ctx context.Context,
+	inputs Resources,
+) []ConfigChange {
+	var wanted []Resources
+	for _, input := range inputs {
+		priority := input.GetFallbackPriority(ctx)
+		for len(wanted) <= int(priority) {
+			wanted = append(wanted, nil)
+		}
+		wanted[priority] = append(wanted[priority], input)
+	}
+
+	s.locker.Lock()
+	current := slices.Clone(s.InputsInfo)
+	s.locker.Unlock()
+
+	var changes []ConfigChange
+	for priority := 0; priority < max(len(current), len(wanted)); priority++ {
+		var oldResources, newResources Resources
+		if priority < len(current) {
+			oldResources = current[priority]
+		}
+		if priority < len(wanted) {
+			newResources = wanted[priority]
+		}
+		if (len(oldResources) == 0 && len(newResources) == 0) || reflect.DeepEqual(oldResources, newResources) {
+			continue
+		}
+		added := countMissing(newResources, oldResources)
+		removed := countMissing(oldResources, newResources)
+		if added == 0 && removed == 0 {
+			// the same inputs in a different order
+			added, removed = len(newResources), len(oldResources)
+		}
+		changes = append(changes, ConfigChange{
+			Key:         fmt.Sprintf("inputs[priority=%d]", priority),
+			Description: fmt.Sprintf("%d added, %d removed", added, removed),
+			IsLive:      true,
+			apply: func(ctx context.Context) error {
+				return s.setInputs(ctx, uint(priority), newResources)
+			},
+			revert: func(ctx context.Context) error {
+				return s.setInputs(ctx, uint(priority), oldResources)
+			},
+		})
+	}
+	return changes
+}
+
+// countMissing returns the amount of resources of a that are not in b.
+func countMissing(a, b Resources) int {
+	count := 0
+	for _, r := range a {
+		if !slices.ContainsFunc(b, func(other Resource) bool {
+			return reflect.DeepEqual(r, other)
+		}) {
+			count++
+		}
+	}
+	return count
+}
+
+// setInputs replaces the inputs of the given fallback priority.
+func (s *FFStream) setInputs(
+	ctx context.Context,
+	priority uint,
+	resources Resources,
+) (_err error) {
+	logger.Debugf(ctx, "setInputs(ctx, %d, %d inputs)", priority, len(resources))
+	defer func() { logger.Debugf(ctx, "/setInputs(ctx, %d, %d inputs): %v", priority, len(resources), _err) }()
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	if int(priority) >= len(s.InputsInfo) {
+		return s.addInputPriorityLocked(ctx, priority, resources)
+	}
+	// copy-on-write: InputFactory may be reading the old slice concurrently
+	s.InputsInfo[priority] = slices.Clone(resources)
+	return s.restartInputChainLocked(ctx, priority)
+}
+
+func (s *FFStream) diffOutputs(
+	ctx context.Context,
+	outputs []SenderTemplate,
+) ([]ConfigChange, error) {
+	var wanted []SenderTemplate
+	for _, t := range outputs {
+		var err error
+		wanted, err = appendOutputTemplate(wanted, t)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.locker.Lock()
+	current := s.OutputTemplates
+	s.locker.Unlock()
+	if len(wanted) != len(current) {
+		return []ConfigChange{{
+			Key:         "outputs",
+			Description: fmt.Sprintf("the amount of outputs changed: %d -> %d", len(current), len(wanted)),
+		}}, nil
+	}
+
+	var changes []ConfigChange
+	for destination := range current {
+		key := fmt.Sprintf("outputs[%d]", destination)
+		oldChain := append([]SenderTemplate{current[destination]}, current[destination].Fallbacks...)
+		newChain := append([]SenderTemplate{wanted[destination]}, wanted[destination].Fallbacks...)
+		if len(oldChain) != len(newChain) {
+			changes = append(changes, ConfigChange{
+				Key:         key,
+				Description: fmt.Sprintf("the amount of fallbacks changed: %d -> %d", len(oldChain)-1, len(newChain)-1),
+			})
+			continue
+		}
+
+		var changedURLs []string
+		isLive := true
+		for idx := range oldChain {
+			oldT, newT := withoutURL(oldChain[idx]), withoutURL(newChain[idx])
+			if !reflect.DeepEqual(oldT, newT) {
+				isLive = false
+				changes = append(changes, ConfigChange{
+					Key:         fmt.Sprintf("%s[priority=%d]", key, oldT.FallbackPriority),
+					Description: "only the URL of an output may be changed live",
+				})
+			}
+			if oldChain[idx].URLTemplate != newChain[idx].URLTemplate {
+				changedURLs = append(changedURLs, fmt.Sprintf("priority %d", oldT.FallbackPriority))
+			}
+		}
+		if !isLive || len(changedURLs) == 0 {
+			continue
+		}
+		if _, ok := s.getOutputRetryPolicy(ctx, current[destination]); !ok && len(current[destination].Fallbacks) == 0 {
+			changes = append(changes, ConfigChange{
+				Key:         key + ".url",
+				Description: "the output has no retry policy, so it cannot be reconnected",
+			})
+			continue
+		}
+		newTemplate, oldTemplate := wanted[destination], current[destination]
+		changes = append(changes, ConfigChange{
+			Key:         key + ".url",
+			Description: fmt.Sprintf("the URL of %s changed", strings.Join(changedURLs, ", ")),
+			IsLive:      true,
+			apply: func(ctx context.Context) error {
+				return s.setOutputTemplate(ctx, destination, newTemplate)
+			},
+			revert: func(ctx context.Context) error {
+				return s.setOutputTemplate(ctx, destination, oldTemplate)
+			},
+		})
+	}
+	return changes, nil
+}
+
+// setOutputTemplate replaces the primary output template number `destination`
+// and reconnects its outputs, so that its URLs are used.
+func (s *FFStream) setOutputTemplate(
+	ctx context.Context,
+	destination int,
+	t SenderTemplate,
+) error {
+	s.locker.Lock()
+	// copy-on-write: the sender factory may be reading the old slice concurrently
+	templates := slices.Clone(s.OutputTemplates)
+	templates[destination] = t
+	s.OutputTemplates = templates
+	s.locker.Unlock()
+	return s.reconnectOutputs(ctx, destination)
+}
+
+func withoutURL(t SenderTemplate) SenderTemplate {
+	t.URLTemplate = ""
+	t.Fallbacks = nil
+	return t
+}
+
+// getOutputURLTemplates returns the URL templates of the primary output
+// template number `destination` followed by its fallbacks.
+func (s *FFStream) getOutputURLTemplates(destination int) []string {
+	s.locker.Lock()
+	templates := s.OutputTemplates
+	s.locker.Unlock()
+	if destination >= len(templates) {
+		return nil
+	}
+	t := templates[destination]
+	result := []string{t.URLTemplate}
+	for _, fallback := range t.Fallbacks {
+		result = append(result, fallback.URLTemplate)
+	}
+	return result
+}
+
+// reconnectOutputs makes the senders of the primary output template number
+// `destination` to reconnect, so that they use the current URL templates.
+func (s *FFStream) reconnectOutputs(
+	ctx context.Context,
+	destination int,
+) error {
+	var errs []error
+	s.StreamMux.Outputs.Range(func(id streammux.OutputID, output *streammux.Output[CustomData]) bool {
+		sendingNode := output.SendingNode
+		if fanOut, ok := sendingNode.(nodeFanOutWrapper); ok {
+			if destination >= len(fanOut.Destinations) {
+				errs = append(errs, fmt.Errorf("output %d has no destination #%d", id, destination))
+				return true
+			}
+			sendingNode = fanOut.Destinations[destination]
+		}
+		switch n := sendingNode.(type) {
+		case nodeWithFallbackWrapper:
+			n.Chain.reconnect(ctx)
+		case nodeWithRetrySetDropOnCloserWrapper:
+			closeRetryableOutputKernel(ctx, n.Processor.Kernel)
+		default:
+			errs = append(errs, fmt.Errorf("output %d (%T) cannot be reconnected", id, sendingNode))
+		}
+		return true
+	})
+	return errors.Join(errs...)
+}
+
+// closeRetryableOutputKernel closes the current kernel of the retryable
+// output, which makes it to reopen the output.
+func closeRetryableOutputKernel(
+	ctx context.Context,
+	k *kernel.Retryable[*kernel.Output],
+) {
+	k.KernelLocker.Do(ctx, func() {
+		if k.Kernel == nil {
+			return
+		}
+		if err := k.Kernel.Close(ctx); err != nil {
+			logger.Debugf(ctx, "unable to close the output: %v", err)
+		}
+	})
+}
+
+func (s *FFStream) diffTranscoderConfig(
+	ctx context.Context,
+	cfg streammuxtypes.TranscoderConfig,
+) []ConfigChange {
+	s.locker.Lock()
+	current := s.transcoderConfig
+	s.locker.Unlock()
+	if reflect.DeepEqual(current, cfg) {
+		return nil
+	}
+
+	oldVideo, newVideo := current.Output.VideoTrackConfigs, cfg.Output.VideoTrackConfigs
+	oldAudio, newAudio := current.Output.AudioTrackConfigs, cfg.Output.AudioTrackConfigs
+	isSameLayout := len(oldVideo) == len(newVideo) && len(oldAudio) == len(newAudio)
+	for idx := 0; isSameLayout && idx < len(oldVideo); idx++ {
+		isSameLayout = slices.Equal(oldVideo[idx].InputTrackIDs, newVideo[idx].InputTrackIDs) &&
+			slices.Equal(oldVideo[idx].OutputTrackIDs, newVideo[idx].OutputTrackIDs)
+	}
+	for idx := 0; isSameLayout && idx < len(oldAudio); idx++ {
+		isSameLayout = slices.Equal(oldAudio[idx].InputTrackIDs, newAudio[idx].InputTrackIDs) &&
+			slices.Equal(oldAudio[idx].OutputTrackIDs, newAudio[idx].OutputTrackIDs)
+	}
+	if !isSameLayout {
+		return []ConfigChange{{
+			Key:         "encoders",
+			Description: "the layout of the output tracks changed",
+		}}
+	}
+
+	var changed []string
+	for idx := range oldVideo {
+		if !reflect.DeepEqual(oldVideo[idx], newVideo[idx]) {
+			changed = append(changed, fmt.Sprintf("video track #%d: %s -> %s", idx, oldVideo[idx].CodecName, newVideo[idx].CodecName))
+		}
+	}
+	for idx := range oldAudio {
+		if !reflect.DeepEqual(oldAudio[idx], newAudio[idx]) {
+			changed = append(changed, fmt.Sprintf("audio track #%d: %s -> %s", idx, oldAudio[idx].CodecName, newAudio[idx].CodecName))
+		}
+	}
+	if len(changed) == 0 {
+		changed = append(changed, "the transcoder settings changed")
+	}
+	return []ConfigChange{{
+		Key:         "encoders",
+		Description: strings.Join(changed, "; "),
+		IsLive:      true,
+		apply: func(ctx context.Context) error {
+			return s.SwitchOutputByProps(ctx, streammuxtypes.SenderProps{
+				TranscoderConfig: cfg,
+			})
+		},
+		revert: func(ctx context.Context) error {
+			return s.SwitchOutputByProps(ctx, streammuxtypes.SenderProps{
+				TranscoderConfig: current,
+			})
+		},
+	}}
+}
+
+func (s *FFStream) diffAutoBitRate(
+	ctx context.Context,
+	cfg *streammuxtypes.AutoBitRateVideoConfig,
+) ([]ConfigChange, error) {
+	current, err := s.GetAutoBitRateVideoConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		// the current config belongs to the handler, which is replaced on a change
+		current = ptr(*current)
+	}
+	var description string
+	switch {
+	case current == nil && cfg == nil:
+		return nil, nil
+	case current == nil:
+		description = "enabled"
+	case cfg == nil:
+		description = "disabled"
+	case !reflect.DeepEqual(current.ResolutionsAndBitRates, cfg.ResolutionsAndBitRates):
+		description = "the resolutions and bit rates changed"
+	case current.AutoByPass != cfg.AutoByPass,
+		current.MaxBitRate != cfg.MaxBitRate,
+		current.MinBitRate != cfg.MinBitRate:
+		description = "the bit rate limits or the bypass changed"
+	default:
+		return nil, nil
+	}
+	return []ConfigChange{{
+		Key:         "auto_bitrate",
+		Description: description,
+		IsLive:      true,
+		apply: func(ctx context.Context) error {
+			return s.SetAutoBitRateVideoConfig(ctx, cfg)
+		},
+		revert: func(ctx context.Context) error {
+			return s.SetAutoBitRateVideoConfig(ctx, current)
+		},
+	}}, nil
+}
@@ -0,0 +1,100 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestApplyConfigChangesRevert(t *testing.T) {
+	ctx := context.Background()
+	errApply := errors.New("apply failed")
+	errRevert := errors.New("revert failed")
+
+	for _, tc := range []struct {
+		name           string
+		failApply      string
+		failRevert     string
+		expectedCalls  []string
+		expectedErrs   []error
+		expectedErrMsg string
+		expectedState  []bool
+	}{
+		{
+			name:          "success",
+			expectedCalls: []string{"apply a", "apply b", "apply c"},
+			expectedState: []bool{true, true, true},
+		},
+		{
+			name:           "revert",
+			failApply:      "c",
+			expectedCalls:  []string{"apply a", "apply b", "apply c", "revert b", "revert a"},
+			expectedErrs:   []error{errApply},
+			expectedErrMsg: "the changes applied before it are reverted",
+			expectedState:  []bool{false, false, false},
+		},
+		{
+			name:          "revert_failed",
+			failApply:     "c",
+			failRevert:    "b",
+			expectedCalls: []string{"apply a", "apply b", "apply c", "revert b", "revert a"},
+			expectedErrs:  []error{errApply, errRevert},
+			expectedState: []bool{false, true, false},
+		},
+		{
+			name:          "first_failed",
+			failApply:     "a",
+			expectedCalls: []string{"apply a"},
+			expectedErrs:  []error{errApply},
+			expectedState: []bool{false, false, false},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			var changes []ConfigChange
+			for _, key := range []string{"a", "b", "c"} {
+				changes = append(changes, ConfigChange{
+					Key:    key,
+					IsLive: true,
+					apply: func(ctx context.Context) error {
+						calls = append(calls, "apply "+key)
+						if key == tc.failApply {
+							return errApply
+						}
+						return nil
+					},
+					revert: func(ctx context.Context) error {
+						calls = append(calls, "revert "+key)
+						if key == tc.failRevert {
+							return errRevert
+						}
+						return nil
+					},
+				})
+			}
+
+			err := applyConfigChanges(ctx, changes)
+			if !slices.Equal(calls, tc.expectedCalls) {
+				t.Fatalf("expected calls %v, got %v", tc.expectedCalls, calls)
+			}
+			if len(tc.expectedErrs) == 0 && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, expectedErr := range tc.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("expected error %v, got %v", expectedErr, err)
+				}
+			}
+			if tc.expectedErrMsg != "" && !strings.Contains(err.Error(), tc.expectedErrMsg) {
+				t.Fatalf("expected %q in the error, got %v", tc.expectedErrMsg, err)
+			}
+			for idx, change := range changes {
+				if change.IsApplied != tc.expectedState[idx] {
+					t.Fatalf("expected IsApplied=%v of change %s, got %v", tc.expectedState[idx], change.Key, change.IsApplied)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,427 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/codec"
+	codectypes "github.com/xaionaro-go/avpipeline/codec/types"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+	"github.com/xaionaro-go/observability"
+)
+
+// EventStatePollInterval is how often the state of the stream (the opened
+// inputs, the outputs, the encoder, the FPS fraction) is checked for
+// the changes reported by SubscribeEvents.
+const EventStatePollInterval = 200 * time.Millisecond
+
+// eventBufferSize is the amount of events a subscriber may lag behind
+// before the events are dropped.
+const eventBufferSize = 256
+
+// Event is a change of the state of the stream, see SubscribeEvents.
+type Event interface {
+	GetTime() time.Time
+	String() string
+}
+
+// InputActivatedEvent is reported when the stream starts to be received
+// from the inputs of the fallback priority (see GetActiveInputPriority).
+type InputActivatedEvent struct {
+	Time     time.Time
+	Priority uint
+}
+
+func (ev InputActivatedEvent) GetTime() time.Time { return ev.Time }
+func (ev InputActivatedEvent) String() string {
+	return fmt.Sprintf("the inputs at priority %d are activated", ev.Priority)
+}
+
+// InputDeactivatedEvent is reported when the stream stops to be received
+// from the inputs of the fallback priority.
+type InputDeactivatedEvent struct {
+	Time     time.Time
+	Priority uint
+}
+
+func (ev InputDeactivatedEvent) GetTime() time.Time { return ev.Time }
+func (ev InputDeactivatedEvent) String() string {
+	return fmt.Sprintf("the inputs at priority %d are deactivated", ev.Priority)
+}
+
+// InputReconnectingEvent is reported when the inputs of the fallback priority
+// are about to be reopened.
+type InputReconnectingEvent struct {
+	Time     time.Time
+	Priority uint
+
+	// Attempt is the number of the attempt according to
+	// Config.InputRetryPolicy, or zero if no policy is set.
+	Attempt uint
+	Delay   time.Duration
+}
+
+func (ev InputReconnectingEvent) GetTime() time.Time { return ev.Time }
+func (ev InputReconnectingEvent) String() string {
+	return fmt.Sprintf("reopening the inputs at priority %d in %v (attempt %d)", ev.Priority, ev.Delay, ev.Attempt)
+}
+
+// InputDemotedEvent is reported when the inputs of the fallback priority are
+// stopped since their quality violates their InputHealthPolicy.
+type InputDemotedEvent struct {
+	Time     time.Time
+	Priority uint
+
+	// Hold is for how long the inputs (measured in the background) should
+	// be healthy to promote the priority back.
+	Hold   time.Duration
+	Reason error
+}
+
+func (ev InputDemotedEvent) GetTime() time.Time { return ev.Time }
+func (ev InputDemotedEvent) String() string {
+	return fmt.Sprintf("the inputs at priority %d are demoted until they are healthy for %v: %v", ev.Priority, ev.Hold, ev.Reason)
+}
+
+// InputPromotedEvent is reported when the demoted inputs of the fallback
+// priority are resumed, since they are healthy for the hold, see InputDemotedEvent.
+type InputPromotedEvent struct {
+	Time     time.Time
+	Priority uint
+}
+
+func (ev InputPromotedEvent) GetTime() time.Time { return ev.Time }
+func (ev InputPromotedEvent) String() string {
+	return fmt.Sprintf("the demoted inputs at priority %d are resumed", ev.Priority)
+}
+
+// OutputCreatedEvent is reported when an output is added to the StreamMux.
+type OutputCreatedEvent struct {
+	Time      time.Time
+	OutputID  streammux.OutputID
+	SenderKey streammux.SenderKey
+	URLs      []string
+}
+
+func (ev OutputCreatedEvent) GetTime() time.Time { return ev.Time }
+func (ev OutputCreatedEvent) String() string {
+	return fmt.Sprintf("output %d is created", ev.OutputID)
+}
+
+// OutputRemovedEvent is reported when an output is removed from the StreamMux.
+type OutputRemovedEvent struct {
+	Time      time.Time
+	OutputID  streammux.OutputID
+	SenderKey streammux.SenderKey
+}
+
+func (ev OutputRemovedEvent) GetTime() time.Time { return ev.Time }
+func (ev OutputRemovedEvent) String() string {
+	return fmt.Sprintf("output %d is removed", ev.OutputID)
+}
+
+// OutputReconnectingEvent is reported when an output (with a retry policy
+// or fallbacks) failed and is about to be reopened.
+type OutputReconnectingEvent struct {
+	Time      time.Time
+	SenderKey streammux.SenderKey
+
+	// Destination is the index of the primary output template.
+	Destination int
+
+	URL   string
+	Delay time.Duration
+	Err   error
+}
+
+func (ev OutputReconnectingEvent) GetTime() time.Time { return ev.Time }
+func (ev OutputReconnectingEvent) String() string {
+	return fmt.Sprintf("reopening output #%d in %v: %v", ev.Destination, ev.Delay, ev.Err)
+}
+
+func (ev OutputSwitchEvent) GetTime() time.Time { return ev.Time }
+func (ev OutputSwitchEvent) String() string {
+	return fmt.Sprintf("output #%d switched from priority %d to priority %d: %s", ev.Destination, ev.FromPriority, ev.ToPriority, ev.Reason)
+}
+
+// RetriesExhaustedEvent is reported when the retries of an input or
+// an output are exhausted (see RetryPolicy).
+type RetriesExhaustedEvent struct {
+	Time        time.Time
+	Target      RetryTarget
+	OnExhausted RetryExhaustedAction
+	Err         error
+}
+
+func (ev RetriesExhaustedEvent) GetTime() time.Time { return ev.Time }
+func (ev RetriesExhaustedEvent) String() string {
+	return fmt.Sprintf("the %s retries are exhausted (%s): %v", ev.Target, ev.OnExhausted, ev.Err)
+}
+
+// VideoEncoderChangedEvent is reported when the resolution or the bit rate
+// of the video encoder is changed (e.g. by the automatic bit rate control).
+type VideoEncoderChangedEvent struct {
+	Time           time.Time
+	FromResolution codec.Resolution
+	ToResolution   codec.Resolution
+	FromBitRate    uint64
+	ToBitRate      uint64
+}
+
+func (ev VideoEncoderChangedEvent) GetTime() time.Time { return ev.Time }
+func (ev VideoEncoderChangedEvent) String() string {
+	return fmt.Sprintf("the video encoder changed from %dx%d@%d to %dx%d@%d",
+		ev.FromResolution.Width, ev.FromResolution.Height, ev.FromBitRate,
+		ev.ToResolution.Width, ev.ToResolution.Height, ev.ToBitRate,
+	)
+}
+
+// BypassChangedEvent is reported when the active output switches between
+// the encoded video and the bypass (the video of the input as is).
+type BypassChangedEvent struct {
+	Time     time.Time
+	IsBypass bool
+}
+
+func (ev BypassChangedEvent) GetTime() time.Time { return ev.Time }
+func (ev BypassChangedEvent) String() string {
+	return fmt.Sprintf("bypass: %v", ev.IsBypass)
+}
+
+// FPSFractionChangedEvent is reported when the FPS fraction is changed.
+type FPSFractionChangedEvent struct {
+	Time time.Time
+	Num  uint32
+	Den  uint32
+}
+
+func (ev FPSFractionChangedEvent) GetTime() time.Time { return ev.Time }
+func (ev FPSFractionChangedEvent) String() string {
+	return fmt.Sprintf("the FPS fraction changed to %d/%d", ev.Num, ev.Den)
+}
+
+// ErrorEvent is reported on an error of the pipeline.
+type ErrorEvent struct {
+	Time time.Time
+	Err  error
+}
+
+func (ev ErrorEvent) GetTime() time.Time { return ev.Time }
+func (ev ErrorEvent) String() string {
+	return fmt.Sprintf("error: %v", ev.Err)
+}
+
+// EndOfStreamEvent is reported when the stream is finished; Err is nil
+// if it is finished normally (the end of the input, or cancellation).
+type EndOfStreamEvent struct {
+	Time time.Time
+	Err  error
+}
+
+func (ev EndOfStreamEvent) GetTime() time.Time { return ev.Time }
+func (ev EndOfStreamEvent) String() string {
+	if ev.Err == nil {
+		return "end of stream"
+	}
+	return fmt.Sprintf("end of stream: %v", ev.Err)
+}
+
+// SubscribeEvents returns a channel of the events of the stream; the channel
+// is closed when ctx is cancelled. The changes of the state are detected
+// within EventStatePollInterval. Slow subscribers miss events rather than
+// block the stream.
+func (s *FFStream) SubscribeEvents(
+	ctx context.Context,
+) <-chan Event {
+	ch := make(chan Event, eventBufferSize)
+	s.eventLocker.Do(ctx, func() {
+		if s.eventSubscribers == nil {
+			s.eventSubscribers = map[chan Event]struct{}{}
+		}
+		s.eventSubscribers[ch] = struct{}{}
+	})
+	observability.Go(ctx, func(ctx context.Context) {
+		<-ctx.Done()
+		s.eventLocker.Do(context.WithoutCancel(ctx), func() {
+			delete(s.eventSubscribers, ch)
+			close(ch)
+		})
+	})
+	return ch
+}
+
+func (s *FFStream) publishEvent(
+	ctx context.Context,
+	ev Event,
+) {
+	logger.Tracef(ctx, "publishEvent: %s", ev)
+	// the events are also published when the stream is being cancelled
+	s.eventLocker.Do(context.WithoutCancel(ctx), func() {
+		for ch := range s.eventSubscribers {
+			select {
+			case ch <- ev:
+			default:
+				logger.Warnf(ctx, "an event subscriber is too slow, dropping the event: %s", ev)
+			}
+		}
+	})
+}
+
+// streamState is the part of the state of the stream that is polled
+// to report its changes, see watchState.
+type streamState struct {
+	ActiveInputs    []bool
+	Outputs         map[streammux.OutputID]OutputInfo
+	VideoResolution codec.Resolution
+	VideoBitRate    uint64
+	IsBypass        bool
+	FPSFraction     avptypes.Rational
+}
+
+// watchState reports the changes of the state of the stream until ctx is cancelled;
+// it also passes the changes of the encoders to the filling of the missing tracks.
+func (s *FFStream) watchState(ctx context.Context) {
+	t := time.NewTicker(EventStatePollInterval)
+	defer t.Stop()
+	var prev *streamState
+	for {
+		cfg := s.StreamMux.GetTranscoderConfig(ctx)
+		// the encoders are changed by the auto bitrate as well,
+		// not only by SwitchOutputByProps
+		s.Filters.setTranscoderConfig(ctx, cfg)
+		cur := s.getStreamState(ctx, cfg)
+		for _, ev := range diffStreamStates(time.Now(), prev, cur) {
+			s.publishEvent(ctx, ev)
+		}
+		prev = cur
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *FFStream) getStreamState(
+	ctx context.Context,
+	cfg streammuxtypes.TranscoderConfig,
+) *streamState {
+	state := &streamState{
+		Outputs: map[streammux.OutputID]OutputInfo{},
+	}
+	state.ActiveInputs = make([]bool, s.Inputs.GetInputChainsCount(ctx))
+	if priority, ok := s.GetActiveInputPriority(ctx); ok && int(priority) < len(state.ActiveInputs) {
+		state.ActiveInputs[priority] = true
+	}
+
+	s.StreamMux.Outputs.Range(func(id streammux.OutputID, output *streammux.Output[CustomData]) bool {
+		info := OutputInfo{
+			ID:        id,
+			SenderKey: output.GetKey(),
+		}
+		if sendingNode, ok := output.SendingNode.(SendingNodeAbstract); ok {
+			info.URLs = sendingNode.GetURLs()
+		}
+		state.Outputs[id] = info
+		return true
+	})
+	if activeOutput := s.StreamMux.GetActiveOutput(ctx); activeOutput != nil {
+		state.IsBypass = activeOutput.GetKey().VideoCodec == codectypes.NameCopy
+	}
+
+	if len(cfg.Output.VideoTrackConfigs) > 0 {
+		video := cfg.Output.VideoTrackConfigs[0]
+		state.VideoResolution = video.Resolution
+		state.VideoBitRate = video.AverageBitRate
+	}
+	state.FPSFraction = s.StreamMux.GetFPSFraction(ctx)
+	return state
+}
+
+// diffStreamStates returns the events of the change from prev to cur;
+// prev == nil means the start of the stream: then only the opened inputs
+// and the created outputs are reported.
+func diffStreamStates(
+	now time.Time,
+	prev *streamState,
+	cur *streamState,
+) []Event {
+	if prev == nil {
+		prev = &streamState{
+			VideoResolution: cur.VideoResolution,
+			VideoBitRate:    cur.VideoBitRate,
+			IsBypass:        cur.IsBypass,
+			FPSFraction:     cur.FPSFraction,
+		}
+	}
+
+	var events []Event
+	for priority := 0; priority < max(len(prev.ActiveInputs), len(cur.ActiveInputs)); priority++ {
+		wasActive := priority < len(prev.ActiveInputs) && prev.ActiveInputs[priority]
+		isActive := priority < len(cur.ActiveInputs) && cur.ActiveInputs[priority]
+		switch {
+		case !wasActive && isActive:
+			events = append(events, InputActivatedEvent{Time: now, Priority: uint(priority)})
+		case wasActive && !isActive:
+			events = append(events, InputDeactivatedEvent{Time: now, Priority: uint(priority)})
+		}
+	}
+
+	for _, id := range sortedOutputIDs(cur.Outputs) {
+		if _, ok := prev.Outputs[id]; ok {
+			continue
+		}
+		output := cur.Outputs[id]
+		events = append(events, OutputCreatedEvent{
+			Time:      now,
+			OutputID:  id,
+			SenderKey: output.SenderKey,
+			URLs:      output.URLs,
+		})
+	}
+	for _, id := range sortedOutputIDs(prev.Outputs) {
+		if _, ok := cur.Outputs[id]; ok {
+			continue
+		}
+		events = append(events, OutputRemovedEvent{
+			Time:      now,
+			OutputID:  id,
+			SenderKey: prev.Outputs[id].SenderKey,
+		})
+	}
+
+	if prev.VideoResolution != cur.VideoResolution || prev.VideoBitRate != cur.VideoBitRate {
+		events = append(events, VideoEncoderChangedEvent{
+			Time:           now,
+			FromResolution: prev.VideoResolution,
+			ToResolution:   cur.VideoResolution,
+			FromBitRate:    prev.VideoBitRate,
+			ToBitRate:      cur.VideoBitRate,
+		})
+	}
+	if prev.IsBypass != cur.IsBypass {
+		events = append(events, BypassChangedEvent{Time: now, IsBypass: cur.IsBypass})
+	}
+	if prev.FPSFraction != cur.FPSFraction {
+		events = append(events, FPSFractionChangedEvent{
+			Time: now,
+			Num:  uint32(cur.FPSFraction.Num),
+			Den:  uint32(cur.FPSFraction.Den),
+		})
+	}
+	return events
+}
+
+func sortedOutputIDs(outputs map[streammux.OutputID]OutputInfo) []streammux.OutputID {
+	ids := make([]streammux.OutputID, 0, len(outputs))
+	for id := range outputs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,63 @@
+package ffstream
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/avpipeline/codec"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+)
+
+func TestDiffStreamStates(t *testing.T) {
+	now := time.Unix(1, 0)
+	initial := &streamState{
+		ActiveInputs:    []bool{true, false},
+		Outputs:         map[streammux.OutputID]OutputInfo{2: {ID: 2}, 1: {ID: 1, URLs: []string{"rtmp://a"}}},
+		VideoResolution: codec.Resolution{Width: 1920, Height: 1080},
+		VideoBitRate:    6_000_000,
+		FPSFraction:     avptypes.Rational{Num: 1, Den: 1},
+	}
+
+	got := diffStreamStates(now, nil, initial)
+	want := []Event{
+		InputActivatedEvent{Time: now, Priority: 0},
+		OutputCreatedEvent{Time: now, OutputID: 1, URLs: []string{"rtmp://a"}},
+		OutputCreatedEvent{Time: now, OutputID: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected events on start\nwant: %#v\n got: %#v", want, got)
+	}
+
+	if got := diffStreamStates(now, initial, initial); len(got) != 0 {
+		t.Fatalf("expected no events without changes, got %#v", got)
+	}
+
+	changed := &streamState{
+		ActiveInputs:    []bool{false, true},
+		Outputs:         map[streammux.OutputID]OutputInfo{1: {ID: 1}},
+		VideoResolution: codec.Resolution{Width: 1280, Height: 720},
+		VideoBitRate:    3_000_000,
+		IsBypass:        true,
+		FPSFraction:     avptypes.Rational{Num: 2, Den: 1},
+	}
+	got = diffStreamStates(now, initial, changed)
+	want = []Event{
+		InputDeactivatedEvent{Time: now, Priority: 0},
+		InputActivatedEvent{Time: now, Priority: 1},
+		OutputRemovedEvent{Time: now, OutputID: 2},
+		VideoEncoderChangedEvent{
+			Time:           now,
+			FromResolution: initial.VideoResolution,
+			ToResolution:   changed.VideoResolution,
+			FromBitRate:    6_000_000,
+			ToBitRate:      3_000_000,
+		},
+		BypassChangedEvent{Time: now, IsBypass: true},
+		FPSFractionChangedEvent{Time: now, Num: 2, Den: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected events\nwant: %#v\n got: %#v", want, got)
+	}
+}
@@ -0,0 +1,56 @@
+package ffargs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/xaionaro-go/avpipeline/codec"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+)
+
+// parseAutoBitRateLadder parses the value of -auto_bitrate_ladder:
+// a comma-separated list of "<width>x<height>:<min bit rate>-<max bit rate>",
+// e.g. "1920x1080:3M-8M,1280x720:1500k-4M".
+func parseAutoBitRateLadder(
+	s string,
+) (streammuxtypes.AutoBitRateResolutionAndBitRateConfigs, error) {
+	var result streammuxtypes.AutoBitRateResolutionAndBitRateConfigs
+	for _, item := range splitList(s) {
+		resolutionString, bitRates, ok := strings.Cut(item, ":")
+		if !ok {
+			return nil, fmt.Errorf("expected <width>x<height>:<min bit rate>-<max bit rate>, got %q", item)
+		}
+		var resolution codec.Resolution
+		if _, err := fmt.Sscanf(resolutionString, "%dx%d", &resolution.Width, &resolution.Height); err != nil {
+			return nil, fmt.Errorf("unable to parse the resolution %q: %w", resolutionString, err)
+		}
+		if resolution.Width == 0 || resolution.Height == 0 {
+			return nil, fmt.Errorf("the resolution %q is empty", resolutionString)
+		}
+		lowString, highString, ok := strings.Cut(bitRates, "-")
+		if !ok {
+			return nil, fmt.Errorf("expected <min bit rate>-<max bit rate>, got %q", bitRates)
+		}
+		low, err := humanize.ParseBytes(lowString)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the bit rate %q: %w", lowString, err)
+		}
+		high, err := humanize.ParseBytes(highString)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the bit rate %q: %w", highString, err)
+		}
+		if high == 0 || low > high {
+			return nil, fmt.Errorf("invalid bit rate range %q of %s", bitRates, resolutionString)
+		}
+		result = append(result, streammuxtypes.AutoBitRateResolutionAndBitRateConfig{
+			Resolution:  resolution,
+			BitrateLow:  streammuxtypes.Ubps(low),
+			BitrateHigh: streammuxtypes.Ubps(high),
+		})
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("the ladder is empty")
+	}
+	return result, nil
+}
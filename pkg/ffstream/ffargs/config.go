@@ -0,0 +1,183 @@
+package ffargs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xaionaro-go/avpipeline/codec"
+	"github.com/xaionaro-go/avpipeline/kernel"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+	flag "github.com/xaionaro-go/ffstream/pkg/ffflag"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamconfig"
+)
+
+// findConfigPath returns the value of -config, it is needed before parsing
+// the rest of the flags, since the config file provides their default values.
+func findConfigPath(args []string) string {
+	for idx := 0; idx < len(args)-1; idx++ {
+		switch args[idx] {
+		case "--":
+			return ""
+		case "-config":
+			return args[idx+1]
+		}
+	}
+	return ""
+}
+
+type flagValue struct {
+	Flag  string
+	Value string
+}
+
+// setDefaultsFromConfig sets the scalar values of the config file as
+// the default values of the flags, so that the flags override them.
+func setDefaultsFromConfig(
+	p *flag.Parser,
+	cfg *ffstreamconfig.Config,
+) error {
+	values := []flagValue{
+		{"v", cfg.Logging.Level},
+		{"log_file", cfg.Logging.File},
+		{"logstash_addr", cfg.Logging.LogstashAddr},
+		{"sentry_dsn", cfg.Logging.SentryDSN},
+		{"listen_control", cfg.Listen.Control},
+		{"listen_http", cfg.Listen.HTTP},
+		{"auth_admin_token", cfg.Auth.AdminToken},
+		{"auth_read_only_token", cfg.Auth.ReadOnlyToken},
+		{"tls_cert", cfg.Listen.TLS.Cert},
+		{"tls_key", cfg.Listen.TLS.Key},
+		{"tls_client_ca", cfg.Listen.TLS.ClientCA},
+		{"listen_metrics", cfg.Listen.Metrics},
+		{"listen_net_pprof", cfg.Listen.NetPprof},
+		{"hwaccel", cfg.HWAccel},
+		{"vf", cfg.Filters.Video},
+		{"af", cfg.Filters.Audio},
+		{"fill_missing_tracks", cfg.Encoders.FillMissingTracks},
+		{"mux_mode", cfg.MuxMode},
+		{"retry_input_policy", cfg.Retry.InputPolicy},
+		{"retry_output_policy", cfg.Retry.OutputPolicy},
+	}
+	if cfg.Logging.RemoveSecrets {
+		values = append(values, flagValue{"remove_secrets_from_logs", "true"})
+	}
+	if cfg.Retry.InputTimeoutOnFailure != 0 {
+		values = append(values, flagValue{"retry_input_timeout_on_failure", cfg.Retry.InputTimeoutOnFailure.String()})
+	}
+	if cfg.Retry.OutputTimeoutOnFailure != 0 {
+		values = append(values, flagValue{"retry_output_timeout_on_failure", cfg.Retry.OutputTimeoutOnFailure.String()})
+	}
+	if wh := cfg.Webhook; wh != nil {
+		values = append(values,
+			flagValue{"webhook_url", wh.URL},
+			flagValue{"webhook_secret", wh.Secret},
+			flagValue{"webhook_events", strings.Join(wh.Events, ",")},
+			flagValue{"webhook_retry_policy", wh.RetryPolicy},
+		)
+		if wh.Timeout != 0 {
+			values = append(values, flagValue{"webhook_timeout", wh.Timeout.String()})
+		}
+	}
+	if abr := cfg.AutoBitRate; abr != nil {
+		values = append(values, flagValue{"auto_bitrate", "true"})
+		if abr.MaxHeight != 0 {
+			values = append(values, flagValue{"auto_bitrate_max_height", strconv.FormatUint(uint64(abr.MaxHeight), 10)})
+		}
+		if abr.MinHeight != 0 {
+			values = append(values, flagValue{"auto_bitrate_min_height", strconv.FormatUint(uint64(abr.MinHeight), 10)})
+		}
+		if abr.AutoBypass != nil {
+			values = append(values, flagValue{"auto_bitrate_auto_bypass", strconv.FormatBool(*abr.AutoBypass)})
+		}
+		var ladder []string
+		for _, step := range abr.Ladder {
+			ladder = append(ladder, fmt.Sprintf("%dx%d:%d-%d", step.Width, step.Height, step.MinBitRate, step.MaxBitRate))
+		}
+		values = append(values, flagValue{"auto_bitrate_ladder", strings.Join(ladder, ",")})
+	}
+	for _, v := range values {
+		if v.Value == "" {
+			continue
+		}
+		if err := p.SetDefault(v.Flag, v.Value); err != nil {
+			return fmt.Errorf("unable to apply the config value of -%s: %w", v.Flag, err)
+		}
+	}
+	return nil
+}
+
+func configInputs(cfg *ffstreamconfig.Config) ffstream.Resources {
+	var result ffstream.Resources
+	for _, in := range cfg.Inputs {
+		opts := configOptionsToAVP(in.Options)
+		if in.FallbackPriority != 0 {
+			opts = append(opts, avptypes.DictionaryItem{
+				Key:   "fallback_priority",
+				Value: strconv.FormatUint(uint64(in.FallbackPriority), 10),
+			})
+		}
+		if in.HealthPolicy != "" {
+			opts = append(opts, avptypes.DictionaryItem{
+				Key:   "health_policy",
+				Value: in.HealthPolicy,
+			})
+		}
+		result = append(result, ffstream.Resource{
+			URL: in.URL,
+			InputConfig: kernel.InputConfig{
+				CustomOptions: opts,
+			},
+		})
+	}
+	return result
+}
+
+// configOutputs converts the outputs to the same form as the outputs
+// of the command line (see the output options handling in main).
+func configOutputs(cfg *ffstreamconfig.Config) ffstream.Resources {
+	var result ffstream.Resources
+	for _, out := range cfg.Outputs {
+		var opts avptypes.DictionaryItems
+		if out.Format != "" {
+			opts = append(opts, avptypes.DictionaryItem{Key: "f", Value: out.Format})
+		}
+		opts = append(opts, configOptionsToAVP(out.Options)...)
+		if out.RetryTimeout != 0 {
+			opts = append(opts, avptypes.DictionaryItem{Key: "retry_timeout", Value: out.RetryTimeout.String()})
+		}
+		if out.RetryPolicy != "" {
+			opts = append(opts, avptypes.DictionaryItem{Key: "retry_policy", Value: out.RetryPolicy})
+		}
+		if out.FallbackPriority != 0 {
+			opts = append(opts, avptypes.DictionaryItem{Key: "output_fallback_priority", Value: strconv.FormatUint(uint64(out.FallbackPriority), 10)})
+		}
+		if out.FallbackRecheckInterval != 0 {
+			opts = append(opts, avptypes.DictionaryItem{Key: "output_fallback_recheck_interval", Value: out.FallbackRecheckInterval.String()})
+		}
+		result = append(result, ffstream.Resource{
+			URL: out.URL,
+			InputConfig: kernel.InputConfig{
+				CustomOptions: opts,
+			},
+		})
+	}
+	return result
+}
+
+func configEncoder(enc *ffstreamconfig.Encoder) Encoder {
+	return Encoder{
+		Codec:   codec.Name(enc.Codec),
+		BitRate: uint64(enc.BitRate),
+		Options: enc.Options.Args(),
+	}
+}
+
+func configOptionsToAVP(opts ffstreamconfig.Options) avptypes.DictionaryItems {
+	result := make(avptypes.DictionaryItems, 0, len(opts))
+	for _, opt := range opts {
+		result = append(result, avptypes.DictionaryItem{Key: opt.Key, Value: opt.Value})
+	}
+	return result
+}
@@ -0,0 +1,458 @@
+// Package ffargs converts ffmpeg-style arguments (and the -config file)
+// into the configuration of an FFStream, with the same semantics
+// as the ffstream command.
+package ffargs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/codec"
+	"github.com/xaionaro-go/avpipeline/kernel"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	flag "github.com/xaionaro-go/ffstream/pkg/ffflag"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamconfig"
+)
+
+// defaultWebhookTimeout is the default of -webhook_timeout
+// (the same as ffstreamwebhook.DefaultTimeout).
+const defaultWebhookTimeout = 10 * time.Second
+
+// Flags are the parsed arguments, see Parse.
+type Flags struct {
+	HWAccelGlobal               string
+	Inputs                      ffstream.Resources
+	ListenControlSocket         string
+	ListenHTTP                  string
+	AuthAdminToken              string
+	AuthReadOnlyToken           string
+	TLSCert                     string
+	TLSKey                      string
+	TLSClientCA                 string
+	ListenNetPprof              string
+	ListenMetrics               string
+	LoggerLevel                 logger.Level
+	LogstashAddr                string
+	SentryDSN                   string
+	LogFile                     string
+	LockTimeout                 time.Duration
+	InsecureDebug               bool
+	RemoveSecretsFromLogs       bool
+	VideoEncoder                Encoder
+	AudioEncoder                Encoder
+	MuxMode                     streammuxtypes.MuxMode
+	AutoBitRate                 *streammuxtypes.AutoBitRateVideoConfig
+	RetryInputTimeoutOnFailure  time.Duration
+	RetryOutputTimeoutOnFailure time.Duration
+	RetryInputPolicy            *ffstream.RetryPolicy
+	RetryOutputPolicy           *ffstream.RetryPolicy
+	WebhookURL                  string
+	WebhookSecret               string
+	WebhookEvents               []string
+	WebhookRetryPolicy          *ffstream.RetryPolicy
+	WebhookTimeout              time.Duration
+	VideoFilter                 string
+	AudioFilter                 string
+	FilterComplex               *ffstream.FilterComplex
+	StreamMap                   ffstream.StreamMap
+	MissingTrackFill            ffstream.MissingTrackFill
+	Outputs                     ffstream.Resources
+
+	// PrintVersion, PrintDemuxers, PrintEncoders and PrintDecoders are
+	// the informational flags of the command (-version, -demuxers, etc);
+	// if any of them is set, the arguments are not validated further.
+	PrintVersion  bool
+	PrintDemuxers bool
+	PrintEncoders bool
+	PrintDecoders bool
+}
+
+// Encoder is an encoder of the -c, -c:v or -c:a flags with its options.
+type Encoder struct {
+	Codec   codec.Name
+	BitRate uint64
+	Options []string
+}
+
+// Parse parses the arguments (without the program name) and
+// the configuration file of -config, if any.
+func Parse(
+	ctx context.Context,
+	args []string,
+) (Flags, error) {
+	return ParseWithConfig(ctx, args, nil)
+}
+
+// ParseWithConfig is the same as Parse, but uses the given content of
+// the configuration file instead of the file of -config (if config is not nil).
+func ParseWithConfig(
+	ctx context.Context,
+	args []string,
+	config []byte,
+) (Flags, error) {
+	p := flag.NewParser()
+	hwAccelFlag := flag.AddParameter(p, "hwaccel", false, ptr(flag.String("none")))
+	inputsFlag := flag.AddParameter(p, "i", true, ptr(flag.StringsAsSeparateFlags(nil)))
+	encoderBothFlag := flag.AddParameter(p, "c", true, ptr(flag.String("copy")))
+	encoderVideoFlag := flag.AddParameter(p, "c:v", true, ptr(flag.String("")))
+	encoderAudioFlag := flag.AddParameter(p, "c:a", true, ptr(flag.String("")))
+	bitrateVideoFlag := flag.AddParameter(p, "b:v", true, ptr(flag.Uint64(0)))
+	bitrateAudioFlag := flag.AddParameter(p, "b:a", true, ptr(flag.Uint64(0)))
+	listenControlSocket := flag.AddParameter(p, "listen_control", false, ptr(flag.String("")))
+	listenHTTP := flag.AddParameter(p, "listen_http", false, ptr(flag.String("")))
+	authAdminToken := flag.AddParameter(p, "auth_admin_token", false, ptr(flag.String("")))
+	authReadOnlyToken := flag.AddParameter(p, "auth_read_only_token", false, ptr(flag.String("")))
+	tlsCert := flag.AddParameter(p, "tls_cert", false, ptr(flag.String("")))
+	tlsKey := flag.AddParameter(p, "tls_key", false, ptr(flag.String("")))
+	tlsClientCA := flag.AddParameter(p, "tls_client_ca", false, ptr(flag.String("")))
+	listenNetPprof := flag.AddParameter(p, "listen_net_pprof", false, ptr(flag.String("")))
+	listenMetrics := flag.AddParameter(p, "listen_metrics", false, ptr(flag.String("")))
+	loggerLevel := flag.AddParameter(p, "v", false, ptr(flag.LogLevel(logger.LevelInfo)))
+	logstashAddr := flag.AddParameter(p, "logstash_addr", false, ptr(flag.String("")))
+	sentryDSN := flag.AddParameter(p, "sentry_dsn", false, ptr(flag.String("")))
+	logFile := flag.AddParameter(p, "log_file", false, ptr(flag.String("")))
+	lockTimeout := flag.AddParameter(p, "lock_timeout", false, ptr(flag.Duration(time.Minute)))
+	insecureDebug := flag.AddParameter(p, "insecure_debug", false, ptr(flag.Bool(false)))
+	removeSecretsFromLogs := flag.AddParameter(p, "remove_secrets_from_logs", false, ptr(flag.Bool(false)))
+	filterFlag := flag.AddParameter(p, "filter", false, ptr(flag.StringsAsSeparateFlags(nil)))
+	videoFilterFlag := flag.AddParameter(p, "vf", false, ptr(flag.String("")))
+	videoFilterLongFlag := flag.AddParameter(p, "filter:v", false, ptr(flag.String("")))
+	audioFilterFlag := flag.AddParameter(p, "af", false, ptr(flag.String("")))
+	audioFilterLongFlag := flag.AddParameter(p, "filter:a", false, ptr(flag.String("")))
+	filterComplexFlag := flag.AddParameter(p, "filter_complex", false, ptr(flag.StringsAsSeparateFlags(nil)))
+	mapFlag := flag.AddParameter(p, "map", false, ptr(flag.StringsAsSeparateFlags(nil)))
+	fillMissingTracks := flag.AddParameter(p, "fill_missing_tracks", false, ptr(flag.String(ffstream.MissingTrackFillNone.String())))
+	muxModeString := flag.AddParameter(p, "mux_mode", false, ptr(flag.String("forbid")))
+	autoBitrate := flag.AddParameter(p, "auto_bitrate", false, ptr(flag.Bool(false)))
+	autoBitrateMaxHeight := flag.AddParameter(p, "auto_bitrate_max_height", false, ptr(flag.Uint64(1080)))
+	autoBitrateMinHeight := flag.AddParameter(p, "auto_bitrate_min_height", false, ptr(flag.Uint64(480)))
+	autoBitrateAutoBypass := flag.AddParameter(p, "auto_bitrate_auto_bypass", false, ptr(flag.Bool(true)))
+	autoBitrateLadder := flag.AddParameter(p, "auto_bitrate_ladder", false, ptr(flag.String("")))
+	retryInputTimeoutOnFailure := flag.AddParameter(p, "retry_input_timeout_on_failure", false, ptr(flag.Duration(ffstream.DefaultConfig().InputRetryInterval)))
+	retryOutputTimeoutOnFailure := flag.AddParameter(p, "retry_output_timeout_on_failure", false, ptr(flag.Duration(0)))
+	retryInputPolicy := flag.AddParameter(p, "retry_input_policy", false, ptr(flag.String("")))
+	retryOutputPolicy := flag.AddParameter(p, "retry_output_policy", false, ptr(flag.String("")))
+	webhookURL := flag.AddParameter(p, "webhook_url", false, ptr(flag.String("")))
+	webhookSecret := flag.AddParameter(p, "webhook_secret", false, ptr(flag.String("")))
+	webhookEvents := flag.AddParameter(p, "webhook_events", false, ptr(flag.String("")))
+	webhookRetryPolicy := flag.AddParameter(p, "webhook_retry_policy", false, ptr(flag.String("")))
+	webhookTimeout := flag.AddParameter(p, "webhook_timeout", false, ptr(flag.Duration(defaultWebhookTimeout)))
+	flag.AddParameter(p, "config", false, ptr(flag.String(""))) // see findConfigPath
+	version := flag.AddFlag(p, "version", false)
+
+	demuxers := flag.AddFlag(p, "demuxers", false)
+	encoders := flag.AddFlag(p, "encoders", false)
+	decoders := flag.AddFlag(p, "decoders", false)
+
+	var cfg *ffstreamconfig.Config
+	switch configPath := findConfigPath(args); {
+	case config != nil:
+		var err error
+		cfg, err = ffstreamconfig.Parse(config)
+		if err != nil {
+			return Flags{}, err
+		}
+	case configPath != "":
+		var err error
+		cfg, err = ffstreamconfig.Load(configPath)
+		if err != nil {
+			return Flags{}, fmt.Errorf("unable to load the config file %q: %w", configPath, err)
+		}
+	}
+	if cfg != nil {
+		if err := setDefaultsFromConfig(p, cfg); err != nil {
+			return Flags{}, err
+		}
+	}
+
+	if err := p.Parse(args); err != nil {
+		return Flags{}, err
+	}
+
+	if version.Value() || demuxers.Value() || encoders.Value() || decoders.Value() {
+		return Flags{
+			LoggerLevel:   loggerLevel.Value(),
+			PrintVersion:  version.Value(),
+			PrintDemuxers: demuxers.Value(),
+			PrintEncoders: encoders.Value(),
+			PrintDecoders: decoders.Value(),
+		}, nil
+	}
+
+	logger.Debugf(ctx, "p.CollectedNonFlags: %#+v", p.CollectedNonFlags)
+	logger.Debugf(ctx, "p.CollectedUnknownOptions: %#+v", p.CollectedUnknownOptions)
+	var unknownOptions [][]string
+	var nextUnknownOptions []string
+	var unknownNonOptions []string
+	var nextIsOption bool
+	for _, opt := range p.CollectedUnknownOptions {
+		if strings.HasPrefix(opt, "-") && len(opt) != 1 {
+			nextUnknownOptions = append(nextUnknownOptions, opt)
+			nextIsOption = true
+			continue
+		}
+		if nextIsOption {
+			nextUnknownOptions = append(nextUnknownOptions, opt)
+			nextIsOption = false
+			continue
+		}
+		unknownOptions = append(unknownOptions, nextUnknownOptions)
+		nextUnknownOptions = nil
+		unknownNonOptions = append(unknownNonOptions, opt)
+	}
+
+	logger.Debugf(ctx, "unknownNonOptions: %#+v", unknownNonOptions)
+	logger.Debugf(ctx, "unknownOptions: %#+v", unknownOptions)
+	var outputs ffstream.Resources
+	for idx, nonFlag := range unknownNonOptions {
+		outputs = append(outputs, ffstream.Resource{
+			URL: nonFlag,
+			InputConfig: kernel.InputConfig{
+				CustomOptions: convertUnknownOptionsToAVPCustomOptions(unknownOptions[idx]),
+			},
+		})
+	}
+
+	if len(outputs) == 0 && cfg != nil {
+		outputs = configOutputs(cfg)
+	}
+	if len(outputs) == 0 {
+		return Flags{}, fmt.Errorf("expected at least one output, but have not received any")
+	}
+
+	var inputs ffstream.Resources
+	if !inputsFlag.IsSet && cfg != nil {
+		inputs = configInputs(cfg)
+	}
+	for idx, input := range inputsFlag.Value() {
+		collectedOptions := inputsFlag.CollectedUnknownOptions[idx]
+		inputs = append(inputs, ffstream.Resource{
+			URL: input,
+			InputConfig: kernel.InputConfig{
+				CustomOptions: convertUnknownOptionsToAVPCustomOptions(collectedOptions),
+			},
+		})
+	}
+
+	for _, input := range inputs {
+		if _, err := input.GetHealthPolicy(); err != nil {
+			return Flags{}, fmt.Errorf("invalid -health_policy of input %q: %w", input.URL, err)
+		}
+		if ffstream.IsSlateURL(input.URL) {
+			if _, err := ffstream.ParseSlateURL(input.URL); err != nil {
+				return Flags{}, fmt.Errorf("invalid slate %q: %w", input.URL, err)
+			}
+		}
+	}
+
+	filterComplexDescriptions := filterComplexFlag.Value()
+	if !filterComplexFlag.IsSet && cfg != nil && cfg.Filters.Complex != "" {
+		filterComplexDescriptions = []string{cfg.Filters.Complex}
+	}
+	var filterComplex *ffstream.FilterComplex
+	if descriptions := filterComplexDescriptions; len(descriptions) != 0 {
+		var err error
+		filterComplex, err = ffstream.ParseFilterComplex(strings.Join(descriptions, ";"))
+		if err != nil {
+			return Flags{}, fmt.Errorf("unable to parse -filter_complex: %w", err)
+		}
+	}
+
+	mapValues := mapFlag.Value()
+	if !mapFlag.IsSet && cfg != nil {
+		mapValues = cfg.Map
+	}
+	streamMap, err := ffstream.ParseStreamMap(mapValues, filterComplex)
+	if err != nil {
+		return Flags{}, fmt.Errorf("unable to parse the stream mapping: %w", err)
+	}
+
+	if len(filterFlag.Value()) != 0 {
+		return Flags{}, fmt.Errorf("'-filter' without a stream specifier is not supported, use '-vf' or '-af'")
+	}
+
+	missingTrackFill := ffstream.MissingTrackFillFromString(fillMissingTracks.Value())
+	if missingTrackFill == ffstream.UndefinedMissingTrackFill {
+		return Flags{}, fmt.Errorf("unable to parse -fill_missing_tracks %q: expected none, black or freeze", fillMissingTracks.Value())
+	}
+
+	muxMode := streammuxtypes.MuxModeFromString(muxModeString.Value())
+	if muxMode == streammuxtypes.UndefinedMuxMode {
+		return Flags{}, fmt.Errorf("unable to parse the mux mode %q", muxModeString.Value())
+	}
+
+	retryInputPolicyValue, err := parseRetryPolicyFlag("retry_input_policy", retryInputPolicy.Value())
+	if err != nil {
+		return Flags{}, err
+	}
+	retryOutputPolicyValue, err := parseRetryPolicyFlag("retry_output_policy", retryOutputPolicy.Value())
+	if err != nil {
+		return Flags{}, err
+	}
+
+	webhookRetryPolicyValue, err := parseRetryPolicyFlag("webhook_retry_policy", webhookRetryPolicy.Value())
+	if err != nil {
+		return Flags{}, err
+	}
+	if webhookTimeout.Value() < 0 {
+		return Flags{}, fmt.Errorf("-webhook_timeout is negative: %v", webhookTimeout.Value())
+	}
+
+	flags := Flags{
+		ListenControlSocket: listenControlSocket.Value(),
+		ListenHTTP:          listenHTTP.Value(),
+		AuthAdminToken:      authAdminToken.Value(),
+		AuthReadOnlyToken:   authReadOnlyToken.Value(),
+		TLSCert:             tlsCert.Value(),
+		TLSKey:              tlsKey.Value(),
+		TLSClientCA:         tlsClientCA.Value(),
+		ListenNetPprof:      listenNetPprof.Value(),
+		ListenMetrics:       listenMetrics.Value(),
+		LoggerLevel:         loggerLevel.Value(),
+		LogstashAddr:        logstashAddr.Value(),
+		SentryDSN:           sentryDSN.Value(),
+		LogFile:             logFile.Value(),
+		LockTimeout:         lockTimeout.Value(),
+
+		InsecureDebug:         insecureDebug.Value(),
+		RemoveSecretsFromLogs: removeSecretsFromLogs.Value(),
+		MuxMode:               muxMode,
+
+		RetryInputTimeoutOnFailure:  retryInputTimeoutOnFailure.Value(),
+		RetryOutputTimeoutOnFailure: retryOutputTimeoutOnFailure.Value(),
+		RetryInputPolicy:            retryInputPolicyValue,
+		RetryOutputPolicy:           retryOutputPolicyValue,
+
+		WebhookURL:         webhookURL.Value(),
+		WebhookSecret:      webhookSecret.Value(),
+		WebhookEvents:      splitList(webhookEvents.Value()),
+		WebhookRetryPolicy: webhookRetryPolicyValue,
+		WebhookTimeout:     webhookTimeout.Value(),
+
+		HWAccelGlobal: hwAccelFlag.Value(),
+		Inputs:        inputs,
+		VideoFilter:   firstNonEmpty(videoFilterLongFlag.Value(), videoFilterFlag.Value()),
+		AudioFilter:   firstNonEmpty(audioFilterLongFlag.Value(), audioFilterFlag.Value()),
+		FilterComplex: filterComplex,
+		StreamMap:     streamMap,
+		Outputs:       outputs,
+
+		MissingTrackFill: missingTrackFill,
+	}
+
+	if v := encoderBothFlag.Value(); v != "" {
+		flags.AudioEncoder = Encoder{
+			Codec:   codec.Name(v),
+			Options: indexSafe(encoderBothFlag.CollectedUnknownOptions, 0),
+		}
+		flags.VideoEncoder = Encoder{
+			Codec:   codec.Name(v),
+			Options: indexSafe(encoderBothFlag.CollectedUnknownOptions, 0),
+		}
+	}
+
+	if v := encoderVideoFlag.Value(); v != "" {
+		flags.VideoEncoder = Encoder{
+			Codec:   codec.Name(v),
+			BitRate: bitrateVideoFlag.Value(),
+			Options: encoderVideoFlag.CollectedUnknownOptions[0],
+		}
+	}
+
+	if v := encoderAudioFlag.Value(); v != "" {
+		flags.AudioEncoder = Encoder{
+			Codec:   codec.Name(v),
+			BitRate: bitrateAudioFlag.Value(),
+			Options: encoderAudioFlag.CollectedUnknownOptions[0],
+		}
+	}
+
+	if cfg != nil {
+		isEncoderSet := encoderBothFlag.IsSet
+		if enc := cfg.Encoders.Video; enc != nil && !isEncoderSet && !encoderVideoFlag.IsSet {
+			flags.VideoEncoder = configEncoder(enc)
+			if bitrateVideoFlag.IsSet {
+				flags.VideoEncoder.BitRate = bitrateVideoFlag.Value()
+			}
+		}
+		if enc := cfg.Encoders.Audio; enc != nil && !isEncoderSet && !encoderAudioFlag.IsSet {
+			flags.AudioEncoder = configEncoder(enc)
+			if bitrateAudioFlag.IsSet {
+				flags.AudioEncoder.BitRate = bitrateAudioFlag.Value()
+			}
+		}
+	}
+
+	if autoBitrate.Value() {
+		logger.Tracef(ctx, "enabling auto bitrate")
+		vCodec := flags.VideoEncoder.Codec.Codec(ctx, true)
+		if vCodec == nil {
+			return Flags{}, fmt.Errorf("unable to determine video codec from %q", flags.VideoEncoder.Codec)
+		}
+		cfg := streammux.DefaultAutoBitRateVideoConfig(vCodec.ID())
+		if autoBitrateLadder.Value() != "" {
+			ladder, err := parseAutoBitRateLadder(autoBitrateLadder.Value())
+			if err != nil {
+				return Flags{}, fmt.Errorf("unable to parse -auto_bitrate_ladder %q: %w", autoBitrateLadder.Value(), err)
+			}
+			cfg.ResolutionsAndBitRates = ladder
+		}
+		cfg.ResolutionsAndBitRates = cfg.ResolutionsAndBitRates.MaxHeight(uint32(autoBitrateMaxHeight.Value()))
+		cfg.ResolutionsAndBitRates = cfg.ResolutionsAndBitRates.MinHeight(uint32(autoBitrateMinHeight.Value()))
+		if len(cfg.ResolutionsAndBitRates) == 0 {
+			return Flags{}, fmt.Errorf("no resolutions of the auto bit rate ladder are within -auto_bitrate_min_height and -auto_bitrate_max_height")
+		}
+		if flags.MuxMode == streammuxtypes.MuxModeForbid {
+			cfg.ResolutionsAndBitRates = streammuxtypes.AutoBitRateResolutionAndBitRateConfigs{
+				*cfg.ResolutionsAndBitRates.Best(),
+			}
+		}
+		cfg.AutoByPass = autoBitrateAutoBypass.Value()
+		cfg.MaxBitRate = cfg.ResolutionsAndBitRates.Best().BitrateHigh
+		cfg.MinBitRate = cfg.ResolutionsAndBitRates.Worst().BitrateLow
+		flags.AutoBitRate = &cfg
+	}
+
+	return flags, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// splitList splits a comma-separated list, ignoring the empty items.
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func parseRetryPolicyFlag(
+	flagName string,
+	value string,
+) (*ffstream.RetryPolicy, error) {
+	if value == "" {
+		return nil, nil
+	}
+	policy, err := ffstream.ParseRetryPolicy(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse -%s %q: %w", flagName, value, err)
+	}
+	return &policy, nil
+}
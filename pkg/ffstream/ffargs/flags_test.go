@@ -0,0 +1,134 @@
+package ffargs
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/avpipeline/codec"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+)
+
+func TestParse(t *testing.T) {
+	ctx := context.Background()
+
+	flags, err := Parse(ctx, []string{
+		"-i", "rtmp://127.0.0.1:1937/test/camera",
+		"-fallback_priority", "1", "-i", "/data/brb.flv",
+		"-c:v", "libx264", "-b:v", "4000000",
+		"-fill_missing_tracks", "freeze",
+		"-retry_output_policy", "initial=1s,max=1m",
+		"-f", "flv", "rtmp://primary.example/live/key",
+		"-retry_timeout", "1m", "-output_fallback_priority", "1", "-f", "mpegts", "srt://backup.example:9000",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(flags.Inputs) != 2 || flags.Inputs[1].URL != "/data/brb.flv" {
+		t.Fatalf("unexpected inputs: %#+v", flags.Inputs)
+	}
+	if got := flags.Inputs[1].GetFallbackPriority(ctx); got != 1 {
+		t.Fatalf("expected the fallback priority 1 of the second input, got %d", got)
+	}
+	if flags.VideoEncoder.Codec != "libx264" || flags.VideoEncoder.BitRate != 4_000_000 {
+		t.Fatalf("unexpected video encoder: %#+v", flags.VideoEncoder)
+	}
+	if flags.MissingTrackFill != ffstream.MissingTrackFillFreeze {
+		t.Fatalf("expected -fill_missing_tracks freeze, got %v", flags.MissingTrackFill)
+	}
+	if flags.RetryOutputPolicy == nil || flags.RetryOutputPolicy.InitialInterval != time.Second {
+		t.Fatalf("unexpected output retry policy: %#+v", flags.RetryOutputPolicy)
+	}
+	if len(flags.Outputs) != 2 || flags.Outputs[1].URL != "srt://backup.example:9000" {
+		t.Fatalf("unexpected outputs: %#+v", flags.Outputs)
+	}
+
+	template, err := newSenderTemplate(flags.Outputs[1], flags.RetryOutputTimeoutOnFailure)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if template.RetryOutputTimeoutOnFailure != time.Minute || template.FallbackPriority != 1 {
+		t.Fatalf("unexpected output template: %#+v", template)
+	}
+	wantOptions := avptypes.DictionaryItems{{Key: "f", Value: "mpegts"}}
+	if len(template.Options) != 1 || template.Options[0] != wantOptions[0] {
+		t.Fatalf("expected options %#+v, got %#+v", wantOptions, template.Options)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "no_outputs",
+			args: []string{"-i", "rtmp://127.0.0.1:1937/test/camera"},
+			want: "at least one output",
+		},
+		{
+			name: "filter_without_specifier",
+			args: []string{"-filter", "scale=1280:720", "-f", "flv", "rtmp://out"},
+			want: "'-filter'",
+		},
+		{
+			name: "mux_mode",
+			args: []string{"-mux_mode", "sometimes", "-f", "flv", "rtmp://out"},
+			want: `"sometimes"`,
+		},
+		{
+			name: "retry_policy",
+			args: []string{"-retry_input_policy", "initial=0s", "-f", "flv", "rtmp://out"},
+			want: "-retry_input_policy",
+		},
+		{
+			name: "auto_bitrate_ladder",
+			args: []string{"-c:v", "libx264", "-auto_bitrate", "-auto_bitrate_ladder", "1280x720:4M-1M", "-f", "flv", "rtmp://out"},
+			want: "-auto_bitrate_ladder",
+		},
+		{
+			name: "fill_missing_tracks",
+			args: []string{"-fill_missing_tracks", "grey", "-f", "flv", "rtmp://out"},
+			want: "-fill_missing_tracks",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(ctx, tc.args)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected the error to contain %q, got: %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestParseAutoBitRateLadder(t *testing.T) {
+	ladder, err := parseAutoBitRateLadder("1920x1080:3M-8M, 1280x720:1500k-4000000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := streammuxtypes.AutoBitRateResolutionAndBitRateConfigs{
+		streammuxtypes.AutoBitRateResolutionAndBitRateConfig{Resolution: codec.Resolution{Width: 1920, Height: 1080}, BitrateLow: 3_000_000, BitrateHigh: 8_000_000},
+		streammuxtypes.AutoBitRateResolutionAndBitRateConfig{Resolution: codec.Resolution{Width: 1280, Height: 720}, BitrateLow: 1_500_000, BitrateHigh: 4_000_000},
+	}
+	if !reflect.DeepEqual(ladder, want) {
+		t.Fatalf("expected %#+v, got %#+v", want, ladder)
+	}
+
+	for _, in := range []string{"", "1280x720", "1280x720:1M", "0x720:1M-2M", "1280x720:2M-1M", "1280x720:x-1M"} {
+		t.Run("invalid_"+in, func(t *testing.T) {
+			if _, err := parseAutoBitRateLadder(in); err == nil {
+				t.Fatalf("expected an error for %q", in)
+			}
+		})
+	}
+}
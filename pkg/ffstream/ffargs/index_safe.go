@@ -1,4 +1,4 @@
-package main
+package ffargs
 
 func indexSafe[T any](s []T, index int) T {
 	if index >= len(s) {
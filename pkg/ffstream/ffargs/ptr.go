@@ -0,0 +1,5 @@
+package ffargs
+
+func ptr[T any](in T) *T {
+	return &in
+}
@@ -0,0 +1,72 @@
+package ffargs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+)
+
+// Reload re-parses the arguments together with the given content of
+// the configuration file (nil means: the file of -config), and applies
+// the difference to the stream started with the flags prev
+// (see FFStream.ApplyConfig).
+func Reload(
+	ctx context.Context,
+	s *ffstream.FFStream,
+	prev Flags,
+	args []string,
+	config []byte,
+	dryRun bool,
+) ([]ffstream.ConfigChange, error) {
+	flags, err := ParseWithConfig(ctx, args, config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the configuration: %w", err)
+	}
+	if changed := flags.StartupFlagsChanged(prev); len(changed) > 0 {
+		return nil, fmt.Errorf("%w: changed %s", ffstream.ErrRestartRequired, strings.Join(changed, ", "))
+	}
+	cfg, err := flags.RuntimeConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ApplyConfig(ctx, cfg, dryRun)
+}
+
+// StartupFlagsChanged returns the flags used only on start that differ
+// from the ones in prev.
+func (flags Flags) StartupFlagsChanged(prev Flags) []string {
+	var result []string
+	for _, item := range []struct {
+		Flag      string
+		IsChanged bool
+	}{
+		{"-listen_control", flags.ListenControlSocket != prev.ListenControlSocket},
+		{"-listen_http", flags.ListenHTTP != prev.ListenHTTP},
+		{"-tls_*", flags.TLSCert != prev.TLSCert || flags.TLSKey != prev.TLSKey || flags.TLSClientCA != prev.TLSClientCA},
+		{"-auth_*", flags.AuthAdminToken != prev.AuthAdminToken || flags.AuthReadOnlyToken != prev.AuthReadOnlyToken},
+		{"-listen_net_pprof", flags.ListenNetPprof != prev.ListenNetPprof},
+		{"-listen_metrics", flags.ListenMetrics != prev.ListenMetrics},
+		{"-v", flags.LoggerLevel != prev.LoggerLevel},
+		{"-logstash_addr", flags.LogstashAddr != prev.LogstashAddr},
+		{"-sentry_dsn", flags.SentryDSN != prev.SentryDSN},
+		{"-log_file", flags.LogFile != prev.LogFile},
+		{"-lock_timeout", flags.LockTimeout != prev.LockTimeout},
+		{"-insecure_debug", flags.InsecureDebug != prev.InsecureDebug},
+		{"-remove_secrets_from_logs", flags.RemoveSecretsFromLogs != prev.RemoveSecretsFromLogs},
+		{"-retry_input_timeout_on_failure", flags.RetryInputTimeoutOnFailure != prev.RetryInputTimeoutOnFailure},
+		{"-webhook_*", flags.WebhookURL != prev.WebhookURL ||
+			flags.WebhookSecret != prev.WebhookSecret ||
+			!slices.Equal(flags.WebhookEvents, prev.WebhookEvents) ||
+			!reflect.DeepEqual(flags.WebhookRetryPolicy, prev.WebhookRetryPolicy) ||
+			flags.WebhookTimeout != prev.WebhookTimeout},
+	} {
+		if item.IsChanged {
+			result = append(result, item.Flag)
+		}
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+package ffargs
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+)
+
+// newTestListenURL returns the URL of an input waiting for a TCP connection,
+// so the stream runs (with the input not opened) until it is stopped.
+func newTestListenURL(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return "tcp://" + addr + "?listen=1"
+}
+
+type reloadTestArgs struct {
+	// Inputs are the input URLs by the fallback priority.
+	Inputs   [][]string
+	Encoders []string
+	Outputs  [][]string
+}
+
+func (a reloadTestArgs) clone() reloadTestArgs {
+	a.Inputs = slices.Clone(a.Inputs)
+	for idx := range a.Inputs {
+		a.Inputs[idx] = slices.Clone(a.Inputs[idx])
+	}
+	a.Encoders = slices.Clone(a.Encoders)
+	a.Outputs = slices.Clone(a.Outputs)
+	for idx := range a.Outputs {
+		a.Outputs[idx] = slices.Clone(a.Outputs[idx])
+	}
+	return a
+}
+
+func (a reloadTestArgs) build() []string {
+	var result []string
+	for priority, urls := range a.Inputs {
+		for _, url := range urls {
+			if priority > 0 {
+				result = append(result, "-fallback_priority", strconv.Itoa(priority))
+			}
+			result = append(result, "-i", url)
+		}
+	}
+	result = append(result, a.Encoders...)
+	for _, output := range a.Outputs {
+		result = append(result, output...)
+	}
+	return result
+}
+
+func TestReloadDiff(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	dir := t.TempDir()
+	base := reloadTestArgs{
+		Inputs: [][]string{
+			{newTestListenURL(t)},
+			{newTestListenURL(t)},
+		},
+		Encoders: []string{"-c:v", "copy", "-c:a", "copy"},
+		Outputs: [][]string{
+			// with a retry policy, so the URL may be changed live
+			{"-retry_timeout", "1m", "-f", "mpegts", filepath.Join(dir, "output0.ts")},
+			{"-f", "mpegts", filepath.Join(dir, "output1.ts")},
+		},
+	}
+	prev, err := Parse(ctx, base.build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, cfg, err := New(ctx, prev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Start(ctx, s, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	type expectedChange struct {
+		Key         string
+		Description string
+		IsLive      bool
+	}
+	for _, tc := range []struct {
+		name            string
+		modify          func(a *reloadTestArgs)
+		expected        []expectedChange
+		restartRequired bool
+	}{
+		{
+			name:   "unchanged",
+			modify: func(a *reloadTestArgs) {},
+		},
+		{
+			name: "input_replaced_at_priority_1",
+			modify: func(a *reloadTestArgs) {
+				a.Inputs[1] = []string{newTestListenURL(t)}
+			},
+			expected: []expectedChange{{"inputs[priority=1]", "1 added, 1 removed", true}},
+		},
+		{
+			name: "input_added_at_priority_0",
+			modify: func(a *reloadTestArgs) {
+				a.Inputs[0] = append(a.Inputs[0], newTestListenURL(t))
+			},
+			expected: []expectedChange{{"inputs[priority=0]", "1 added, 0 removed", true}},
+		},
+		{
+			name: "priority_added",
+			modify: func(a *reloadTestArgs) {
+				a.Inputs = append(a.Inputs, []string{newTestListenURL(t)})
+			},
+			expected: []expectedChange{{"inputs[priority=2]", "1 added, 0 removed", true}},
+		},
+		{
+			name: "output_url",
+			modify: func(a *reloadTestArgs) {
+				a.Outputs[0][len(a.Outputs[0])-1] = filepath.Join(dir, "output0-new.ts")
+			},
+			expected: []expectedChange{{"outputs[0].url", "the URL of priority 0 changed", true}},
+		},
+		{
+			name: "output_url_without_retry_policy",
+			modify: func(a *reloadTestArgs) {
+				a.Outputs[1][len(a.Outputs[1])-1] = filepath.Join(dir, "output1-new.ts")
+			},
+			expected:        []expectedChange{{"outputs[1].url", "the output has no retry policy, so it cannot be reconnected", false}},
+			restartRequired: true,
+		},
+		{
+			name: "output_format",
+			modify: func(a *reloadTestArgs) {
+				a.Outputs[0][3] = "flv"
+			},
+			expected:        []expectedChange{{"outputs[0][priority=0]", "only the URL of an output may be changed live", false}},
+			restartRequired: true,
+		},
+		{
+			name: "output_added",
+			modify: func(a *reloadTestArgs) {
+				a.Outputs = append(a.Outputs, []string{"-f", "mpegts", filepath.Join(dir, "output2.ts")})
+			},
+			expected:        []expectedChange{{"outputs", "the amount of outputs changed: 2 -> 3", false}},
+			restartRequired: true,
+		},
+		{
+			name: "map",
+			modify: func(a *reloadTestArgs) {
+				a.Encoders = append(a.Encoders, "-map", "0:v")
+			},
+			expected:        []expectedChange{{"map", "the stream mapping changed", false}},
+			restartRequired: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			args := base.clone()
+			tc.modify(&args)
+			changes, err := Reload(ctx, s, prev, args.build(), nil, true)
+			switch {
+			case tc.restartRequired && !errors.Is(err, ffstream.ErrRestartRequired):
+				t.Fatalf("expected a restart to be required, got %v", err)
+			case !tc.restartRequired && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []expectedChange
+			for _, change := range changes {
+				got = append(got, expectedChange{change.Key, change.Description, change.IsLive})
+			}
+			if !slices.Equal(got, tc.expected) {
+				t.Fatalf("expected changes %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
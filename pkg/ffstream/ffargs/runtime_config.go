@@ -0,0 +1,257 @@
+package ffargs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	audio "github.com/xaionaro-go/audio/pkg/audio/types"
+	"github.com/xaionaro-go/avpipeline/codec"
+	codectypes "github.com/xaionaro-go/avpipeline/codec/types"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+)
+
+// New creates an FFStream configured by the flags: with the inputs, the output
+// templates and the filters added. Use Start to start it.
+func New(
+	ctx context.Context,
+	flags Flags,
+	opts ...ffstream.Option,
+) (*ffstream.FFStream, ffstream.RuntimeConfig, error) {
+	cfg, err := flags.RuntimeConfig(ctx)
+	if err != nil {
+		return nil, ffstream.RuntimeConfig{}, err
+	}
+
+	s, err := ffstream.New(ctx, append(flags.Options(), opts...)...)
+	if err != nil {
+		return nil, ffstream.RuntimeConfig{}, err
+	}
+
+	if err := s.SetFilterGraph(ctx, astiav.MediaTypeVideo, cfg.VideoFilter); err != nil {
+		return nil, ffstream.RuntimeConfig{}, fmt.Errorf("unable to set the video filter: %w", err)
+	}
+	if err := s.SetFilterGraph(ctx, astiav.MediaTypeAudio, cfg.AudioFilter); err != nil {
+		return nil, ffstream.RuntimeConfig{}, fmt.Errorf("unable to set the audio filter: %w", err)
+	}
+
+	for _, inputInfo := range cfg.Inputs {
+		if _, err := s.AddInput(ctx, inputInfo); err != nil {
+			return nil, ffstream.RuntimeConfig{}, fmt.Errorf("unable to add the input %q: %w", inputInfo.URL, err)
+		}
+	}
+
+	for _, outputTemplate := range cfg.Outputs {
+		if err := s.AddOutputTemplate(ctx, outputTemplate); err != nil {
+			return nil, ffstream.RuntimeConfig{}, fmt.Errorf("unable to add the output %q: %w", outputTemplate.URLTemplate, err)
+		}
+	}
+
+	return s, cfg, nil
+}
+
+// Start starts the stream created by New.
+func Start(
+	ctx context.Context,
+	s *ffstream.FFStream,
+	cfg ffstream.RuntimeConfig,
+) error {
+	return s.Start(ctx, cfg.TranscoderConfig, cfg.MuxMode, cfg.AutoBitRateVideo)
+}
+
+// Options returns the options of ffstream.New corresponding to the flags.
+func (flags Flags) Options() ffstream.Options {
+	opts := ffstream.Options{
+		ffstream.OptionInputRetryIntervalValue(flags.RetryInputTimeoutOnFailure),
+		ffstream.OptionStreamMap(flags.StreamMap),
+		ffstream.OptionFilterComplex{FilterComplex: flags.FilterComplex},
+		ffstream.OptionMissingTrackFill(flags.MissingTrackFill),
+	}
+	if flags.RetryInputPolicy != nil {
+		opts = append(opts, ffstream.OptionInputRetryPolicy(*flags.RetryInputPolicy))
+	}
+	if flags.RetryOutputPolicy != nil {
+		opts = append(opts, ffstream.OptionOutputRetryPolicy(*flags.RetryOutputPolicy))
+	}
+	return opts
+}
+
+// RuntimeConfig converts the flags to the configuration of the stream:
+// the inputs, the output templates, the transcoder config, etc.
+func (flags Flags) RuntimeConfig(
+	ctx context.Context,
+) (ffstream.RuntimeConfig, error) {
+	var resolution codec.Resolution
+	var audioSampleRate audio.SampleRate
+
+	var encoderVideoOptions avptypes.DictionaryItems
+	encoderVideoOptions = append(encoderVideoOptions,
+		codec.LowLatencyOptions(ctx, flags.VideoEncoder.Codec, true)...,
+	)
+	encoderVideoOptions = append(encoderVideoOptions,
+		convertUnknownOptionsToCustomOptions(flags.VideoEncoder.Options)...,
+	)
+	encoderVideoOptions = encoderVideoOptions.Deduplicate()
+
+	for idx, v := range encoderVideoOptions {
+		logger.Tracef(ctx, "encoderVideoOptions[%d]: %s=%s", idx, v.Key, v.Value)
+		if len(v.Key) == 0 {
+			return ffstream.RuntimeConfig{}, fmt.Errorf("unexpected empty output option key with value %q", v.Value)
+		}
+		switch v.Key {
+		case "s":
+			if _, err := fmt.Sscanf(v.Value, "%dx%d", &resolution.Width, &resolution.Height); err != nil {
+				return ffstream.RuntimeConfig{}, fmt.Errorf("unable to parse the resolution %q: %w", v.Value, err)
+			}
+			logger.Debugf(ctx, "parsed resolution: %dx%d", resolution.Width, resolution.Height)
+		}
+	}
+
+	var encoderAudioOptions avptypes.DictionaryItems
+	encoderAudioOptions = append(encoderAudioOptions,
+		convertUnknownOptionsToCustomOptions(flags.AudioEncoder.Options)...,
+	)
+	encoderAudioOptions = encoderAudioOptions.Deduplicate()
+
+	for idx, v := range encoderAudioOptions {
+		logger.Tracef(ctx, "encoderAudioOptions[%d]: %s=%s", idx, v.Key, v.Value)
+		if len(v.Key) == 0 {
+			return ffstream.RuntimeConfig{}, fmt.Errorf("unexpected empty output option key with value %q", v.Value)
+		}
+		switch v.Key {
+		case "ar":
+			if _, err := fmt.Sscanf(v.Value, "%d", &audioSampleRate); err != nil {
+				return ffstream.RuntimeConfig{}, fmt.Errorf("unable to parse the audio sample rate %q: %w", v.Value, err)
+			}
+			logger.Debugf(ctx, "parsed audio sample rate: %d", audioSampleRate)
+		}
+	}
+
+	var outputs []ffstream.SenderTemplate
+	for _, outputParams := range flags.Outputs {
+		logger.Debugf(ctx, "outputParams == %#+v", outputParams)
+		outputTemplate, err := newSenderTemplate(outputParams, flags.RetryOutputTimeoutOnFailure)
+		if err != nil {
+			return ffstream.RuntimeConfig{}, err
+		}
+		outputs = append(outputs, outputTemplate)
+	}
+
+	hardwareDeviceType := avptypes.HardwareDeviceTypeFromString(flags.HWAccelGlobal)
+	if hardwareDeviceType == -1 {
+		hardwareDeviceType = avptypes.HardwareDeviceTypeNone
+	}
+	transcoderConfig, err := flags.StreamMap.TranscoderConfig(
+		streammuxtypes.OutputVideoTrackConfig{
+			CodecName:          codectypes.Name(flags.VideoEncoder.Codec),
+			AverageBitRate:     flags.VideoEncoder.BitRate,
+			CustomOptions:      encoderVideoOptions,
+			HardwareDeviceType: hardwareDeviceType,
+			Resolution: codec.Resolution{
+				Width:  resolution.Width,
+				Height: resolution.Height,
+			},
+		},
+		streammuxtypes.OutputAudioTrackConfig{
+			CodecName:      codectypes.Name(flags.AudioEncoder.Codec),
+			AverageBitRate: flags.AudioEncoder.BitRate,
+			CustomOptions:  convertUnknownOptionsToCustomOptions(flags.AudioEncoder.Options),
+			SampleRate:     audioSampleRate,
+		},
+	)
+	if err != nil {
+		return ffstream.RuntimeConfig{}, fmt.Errorf("unable to build the transcoder config: %w", err)
+	}
+
+	return ffstream.RuntimeConfig{
+		Inputs:            flags.Inputs,
+		Outputs:           outputs,
+		TranscoderConfig:  transcoderConfig,
+		MuxMode:           flags.MuxMode,
+		AutoBitRateVideo:  flags.AutoBitRate,
+		StreamMap:         flags.StreamMap,
+		FilterComplex:     flags.FilterComplex,
+		VideoFilter:       flags.VideoFilter,
+		AudioFilter:       flags.AudioFilter,
+		MissingTrackFill:  flags.MissingTrackFill,
+		InputRetryPolicy:  flags.RetryInputPolicy,
+		OutputRetryPolicy: flags.RetryOutputPolicy,
+	}, nil
+}
+
+func newSenderTemplate(
+	outputParams ffstream.Resource,
+	retryOutputTimeoutOnFailure time.Duration,
+) (ffstream.SenderTemplate, error) {
+	var outputOptions avptypes.DictionaryItems
+	var outputFormat string
+	var fallbackPriority uint64
+	var retryPolicy *ffstream.RetryPolicy
+	var fallbackRecheckInterval time.Duration
+	var err error
+	for _, v := range outputParams.CustomOptions {
+		switch v.Key {
+		case "-f":
+			outputFormat = v.Value
+		case "retry_timeout":
+			// a per-output override of the global value
+			retryOutputTimeoutOnFailure, err = time.ParseDuration(v.Value)
+			if err != nil {
+				return ffstream.SenderTemplate{}, fmt.Errorf("unable to parse -retry_timeout %q: %w", v.Value, err)
+			}
+			continue
+		case "retry_policy":
+			// a per-output override of -retry_output_policy
+			policy, err := ffstream.ParseRetryPolicy(v.Value)
+			if err != nil {
+				return ffstream.SenderTemplate{}, fmt.Errorf("unable to parse -retry_policy %q: %w", v.Value, err)
+			}
+			retryPolicy = &policy
+			continue
+		case "output_fallback_priority":
+			fallbackPriority, err = strconv.ParseUint(v.Value, 10, 0)
+			if err != nil {
+				return ffstream.SenderTemplate{}, fmt.Errorf("unable to parse -output_fallback_priority %q: %w", v.Value, err)
+			}
+			continue
+		case "output_fallback_recheck_interval":
+			fallbackRecheckInterval, err = time.ParseDuration(v.Value)
+			if err != nil {
+				return ffstream.SenderTemplate{}, fmt.Errorf("unable to parse -output_fallback_recheck_interval %q: %w", v.Value, err)
+			}
+			continue
+		}
+		outputOptions = append(outputOptions, v)
+	}
+	if outputFormat == "mpegts" {
+		var movFlags *avptypes.DictionaryItem
+		for idx, item := range outputOptions {
+			if item.Key == "movflags" {
+				movFlags = &outputOptions[idx]
+				break
+			}
+		}
+		if movFlags == nil {
+			outputOptions = append(outputOptions, avptypes.DictionaryItem{Key: "movflags"})
+			movFlags = &outputOptions[len(outputOptions)-1]
+		}
+		if movFlags.Value != "" {
+			movFlags.Value += "+"
+		}
+		movFlags.Value += "frag_keyframe+empty_moov+separate_moof"
+	}
+
+	return ffstream.SenderTemplate{
+		URLTemplate:                 outputParams.URL,
+		Options:                     outputOptions,
+		RetryOutputTimeoutOnFailure: retryOutputTimeoutOnFailure,
+		RetryPolicy:                 retryPolicy,
+		FallbackPriority:            uint(fallbackPriority),
+		FallbackRecheckInterval:     fallbackRecheckInterval,
+	}, nil
+}
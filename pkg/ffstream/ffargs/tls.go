@@ -0,0 +1,75 @@
+package ffargs
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/cert"
+)
+
+// ControlTLSConfig returns the TLS config of the control listeners with
+// the "tls:" scheme, set by flags -tls_cert, -tls_key and -tls_client_ca.
+//
+// If -tls_cert and -tls_key are not set, a self-signed certificate is
+// generated on the first run and saved to the user config directory
+// (see DefaultTLSCertPaths); its pin is logged, so that clients may
+// verify it (see `ffstreamctl --pin-sha256`).
+func (flags Flags) ControlTLSConfig(ctx context.Context) (*tls.Config, error) {
+	var (
+		certificate tls.Certificate
+		err         error
+	)
+	switch {
+	case flags.TLSCert != "" || flags.TLSKey != "":
+		if flags.TLSCert == "" || flags.TLSKey == "" {
+			return nil, fmt.Errorf("-tls_cert and -tls_key should be set together")
+		}
+		certificate, err = tls.LoadX509KeyPair(flags.TLSCert, flags.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the certificate from %q and %q: %w", flags.TLSCert, flags.TLSKey, err)
+		}
+	default:
+		certFile, keyFile, err := DefaultTLSCertPaths()
+		if err != nil {
+			return nil, fmt.Errorf("%w; consider setting -tls_cert and -tls_key", err)
+		}
+		hosts := []string{"localhost", "127.0.0.1", "::1"}
+		if hostname, err := os.Hostname(); err == nil {
+			hosts = append([]string{hostname}, hosts...)
+		}
+		var isGenerated bool
+		certificate, isGenerated, err = cert.LoadOrGenerateSelfSigned(certFile, keyFile, hosts)
+		if err != nil {
+			return nil, err
+		}
+		if isGenerated {
+			logger.Infof(ctx, "generated a self-signed TLS certificate %q", certFile)
+		}
+	}
+
+	pin, err := cert.LeafPinSHA256(certificate)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "the pin of the TLS certificate (see ffstreamctl --pin-sha256): %s", pin)
+
+	return cert.ServerConfig{
+		Certificate:  certificate,
+		ClientCAFile: flags.TLSClientCA,
+	}.TLSConfig()
+}
+
+// DefaultTLSCertPaths returns the paths of the self-signed certificate
+// and key, used if -tls_cert and -tls_key are not set.
+func DefaultTLSCertPaths() (certFile, keyFile string, _ error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", "", fmt.Errorf("unable to get the user config directory: %w", err)
+	}
+	dir = filepath.Join(dir, "ffstream", "tls")
+	return filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), nil
+}
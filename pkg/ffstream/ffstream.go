@@ -1,12 +1,16 @@
 package ffstream
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sync"
+	"time"
 
+	"github.com/asticode/go-astiav"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/avpipeline"
 	"github.com/xaionaro-go/avpipeline/codec"
@@ -20,16 +24,19 @@ import (
 	"github.com/xaionaro-go/avpipeline/preset/inputwithfallback"
 	streammux "github.com/xaionaro-go/avpipeline/preset/streammux"
 	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	"github.com/xaionaro-go/avpipeline/processor"
 	avpipeline_grpc "github.com/xaionaro-go/avpipeline/protobuf/avpipeline"
 	goconvavp "github.com/xaionaro-go/avpipeline/protobuf/goconv/avpipeline"
 	avptypes "github.com/xaionaro-go/avpipeline/types"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
 	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/xsync"
 )
 
 type Inputs = inputwithfallback.InputWithFallback[*Input, *DecoderFactory, CustomData]
 type InputChain = inputwithfallback.InputChain[*Input, *DecoderFactory, CustomData]
+type FilterNode = node.NodeWithCustomData[CustomData, *processor.FromKernel[*FilterKernel]]
 
 type FFStream struct {
 	Config          Config
@@ -37,13 +44,38 @@ type FFStream struct {
 	InputsInfo      []Resources
 	OutputTemplates []SenderTemplate
 
-	StreamMux *streammux.StreamMux[CustomData]
+	Filters    *FilterKernel
+	FilterNode *FilterNode
+	StreamMux  *streammux.StreamMux[CustomData]
 
 	InputQualityMeasurer  *quality.Measurements
 	OutputQualityMeasurer *extra.QualityT
 
 	cancelFunc context.CancelFunc
 	locker     sync.Mutex
+
+	// inputPauseReasons are why the inputs of the fallback priorities
+	// are paused, see setInputChainPauseReasonLocked.
+	inputPauseReasons map[uint]inputPauseReason
+
+	// serveCtx and inputChainErrCh are to serve the input chains of
+	// the fallback priorities added after Start, see addInputPriorityLocked.
+	serveCtx        context.Context
+	inputChainErrCh chan node.Error
+
+	// configLocker serializes ApplyConfig calls; transcoderConfig
+	// is the last config passed to SwitchOutputByProps.
+	configLocker     sync.Mutex
+	transcoderConfig streammuxtypes.TranscoderConfig
+
+	eventLocker      xsync.Mutex
+	eventSubscribers map[chan Event]struct{}
+
+	InputRetryCounters  RetryCounters
+	OutputRetryCounters RetryCounters
+	retryLocker         xsync.Mutex
+	inputRetryPolicy    *RetryPolicy
+	outputRetryPolicy   *RetryPolicy
 }
 
 func New(
@@ -51,6 +83,17 @@ func New(
 	opts ...Option,
 ) (*FFStream, error) {
 	cfg := Options(opts).Config()
+	for _, policy := range []*RetryPolicy{cfg.InputRetryPolicy, cfg.OutputRetryPolicy} {
+		if policy == nil {
+			continue
+		}
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid retry policy %v: %w", policy, err)
+		}
+	}
+	if cfg.MissingTrackFill <= UndefinedMissingTrackFill || cfg.MissingTrackFill >= EndOfMissingTrackFill {
+		return nil, fmt.Errorf("unknown missing track fill: %v", cfg.MissingTrackFill)
+	}
 
 	var inputOpts []inputwithfallback.Option
 	inputOpts = append(inputOpts, inputwithfallback.OptionRetryInterval(cfg.InputRetryInterval))
@@ -61,9 +104,20 @@ func New(
 	s := &FFStream{
 		Config:                cfg,
 		Inputs:                inputs,
+		Filters:               newFilterKernel(),
 		InputQualityMeasurer:  quality.NewMeasurements(),
 		OutputQualityMeasurer: extra.NewQuality(),
+		inputRetryPolicy:      cfg.InputRetryPolicy,
+		outputRetryPolicy:     cfg.OutputRetryPolicy,
+		inputPauseReasons:     map[uint]inputPauseReason{},
+	}
+	s.Filters.onGraphError = func(ctx context.Context, err error) {
+		s.publishEvent(ctx, ErrorEvent{Time: time.Now(), Err: err})
 	}
+	if cfg.FilterComplex != nil {
+		s.Filters.initFilterComplex(cfg.FilterComplex, cfg.StreamMap)
+	}
+	s.Filters.initMissingTracks(cfg.MissingTrackFill, cfg.StreamMap)
 	return s, nil
 }
 
@@ -80,24 +134,213 @@ func (s *FFStream) addCancelFnLocked(cancelFn context.CancelFunc) {
 	}
 }
 
+// AddInput adds the input to its fallback priority and returns its number
+// within the priority. If the stream is already started, the inputs of
+// the priority are reopened.
 func (s *FFStream) AddInput(
 	ctx context.Context,
 	resource Resource,
-) (_err error) {
+) (_ret uint, _err error) {
 	logger.Debugf(ctx, "AddInput(ctx, %#+v)", resource)
-	defer func() { logger.Debugf(ctx, "/AddInput(ctx, %#+v): %v", resource, _err) }()
+	defer func() { logger.Debugf(ctx, "/AddInput(ctx, %#+v): %v, %v", resource, _ret, _err) }()
+	if resource.URL == "" {
+		return 0, fmt.Errorf("the URL is empty")
+	}
+	if _, err := resource.GetHealthPolicy(); err != nil {
+		return 0, err
+	}
+	if IsSlateURL(resource.URL) {
+		if _, err := ParseSlateURL(resource.URL); err != nil {
+			return 0, fmt.Errorf("invalid slate %q: %w", resource.URL, err)
+		}
+	}
 	s.locker.Lock()
 	defer s.locker.Unlock()
 
 	if len(s.Inputs.InputChains) != len(s.InputsInfo) {
-		return fmt.Errorf("internal error: len(s.Inputs.InputChains) != len(s.InputsInfo): %d != %d", len(s.Inputs.InputChains), len(s.InputsInfo))
+		return 0, fmt.Errorf("internal error: len(s.Inputs.InputChains) != len(s.InputsInfo): %d != %d", len(s.Inputs.InputChains), len(s.InputsInfo))
 	}
 	priority := resource.GetFallbackPriority(ctx)
-	for p := len(s.Inputs.InputChains); p <= int(priority); p++ {
-		s.Inputs.AddFactory(ctx, newInputFactory(s, uint(p)))
+	if int(priority) >= len(s.InputsInfo) {
+		return 0, s.addInputPriorityLocked(ctx, priority, Resources{resource})
+	}
+	// copy-on-write: InputFactory may be reading the old slice concurrently
+	s.InputsInfo[priority] = append(slices.Clone(s.InputsInfo[priority]), resource)
+	num := uint(len(s.InputsInfo[priority]) - 1)
+	return num, s.restartInputChainLocked(ctx, priority)
+}
+
+// addInputPriorityLocked adds the fallback priorities up to the given one,
+// which gets the given resources (the skipped priorities get no inputs).
+// The pipeline serves only the input chains it is started with, so after
+// Start the chains of the new priorities are served here.
+func (s *FFStream) addInputPriorityLocked(
+	ctx context.Context,
+	priority uint,
+	resources Resources,
+) error {
+	first := len(s.Inputs.InputChains)
+	factoryCtx := ctx
+	if s.serveCtx != nil {
+		// the chain outlives the request which adds it
+		factoryCtx = s.serveCtx
+	}
+	for p := first; p <= int(priority); p++ {
+		s.Inputs.AddFactory(factoryCtx, newInputFactory(s, uint(p)))
 		s.InputsInfo = append(s.InputsInfo, nil)
 	}
-	s.InputsInfo[priority] = append(s.InputsInfo[priority], resource)
+	s.InputsInfo[priority] = slices.Clone(resources)
+	if s.serveCtx == nil {
+		return nil
+	}
+	for p := first; p <= int(priority); p++ {
+		inputChain, err := s.getInputChain(ctx, uint(p))
+		if err != nil {
+			return err
+		}
+		if len(s.InputsInfo[p]) == 0 {
+			if err := s.setInputChainPauseReasonLocked(ctx, uint(p), inputPauseReasonNoInputs, true); err != nil {
+				return err
+			}
+		}
+		errCh := s.inputChainErrCh
+		observability.Go(s.serveCtx, func(ctx context.Context) {
+			inputChain.Serve(ctx, node.ServeConfig{}, errCh)
+		})
+	}
+	return nil
+}
+
+// RemoveInput removes the input number `num` of the given fallback priority;
+// the inputs following it are renumbered. If no inputs are left at the priority,
+// the priority is stopped (so the next priority is used) until an input is
+// added to it again.
+func (s *FFStream) RemoveInput(
+	ctx context.Context,
+	priority uint,
+	num uint,
+) (_err error) {
+	logger.Debugf(ctx, "RemoveInput(ctx, %d, %d)", priority, num)
+	defer func() { logger.Debugf(ctx, "/RemoveInput(ctx, %d, %d): %v", priority, num, _err) }()
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	if int(priority) >= len(s.InputsInfo) {
+		return fmt.Errorf("input priority %d is out of range (priorities=%d)", priority, len(s.InputsInfo))
+	}
+	resources := s.InputsInfo[priority]
+	if int(num) >= len(resources) {
+		return fmt.Errorf("input num %d is out of range (inputs=%d)", num, len(resources))
+	}
+	s.InputsInfo[priority] = slices.Delete(slices.Clone(resources), int(num), int(num)+1)
+	return s.restartInputChainLocked(ctx, priority)
+}
+
+// ReplaceInputURL changes the URL of the input number `num` of the given
+// fallback priority, keeping its options.
+func (s *FFStream) ReplaceInputURL(
+	ctx context.Context,
+	priority uint,
+	num uint,
+	url string,
+) (_err error) {
+	logger.Debugf(ctx, "ReplaceInputURL(ctx, %d, %d, <url>)", priority, num)
+	defer func() { logger.Debugf(ctx, "/ReplaceInputURL(ctx, %d, %d, <url>): %v", priority, num, _err) }()
+	if url == "" {
+		return fmt.Errorf("the URL is empty")
+	}
+	if IsSlateURL(url) {
+		if _, err := ParseSlateURL(url); err != nil {
+			return fmt.Errorf("invalid slate %q: %w", url, err)
+		}
+	}
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	if int(priority) >= len(s.InputsInfo) {
+		return fmt.Errorf("input priority %d is out of range (priorities=%d)", priority, len(s.InputsInfo))
+	}
+	resources := slices.Clone(s.InputsInfo[priority])
+	if int(num) >= len(resources) {
+		return fmt.Errorf("input num %d is out of range (inputs=%d)", num, len(resources))
+	}
+	resources[num].URL = url
+	s.InputsInfo[priority] = resources
+	return s.restartInputChainLocked(ctx, priority)
+}
+
+// restartInputChainLocked reopens the inputs of the given priority, so that
+// the updated resources are used; a priority without resources is just stopped,
+// and a priority paused for another reason (e.g. SetStopInput) is kept paused.
+// Nothing is done before Start, since the inputs are not opened yet.
+func (s *FFStream) restartInputChainLocked(
+	ctx context.Context,
+	priority uint,
+) error {
+	if s.StreamMux == nil {
+		return nil
+	}
+	if len(s.InputsInfo[priority]) == 0 {
+		logger.Infof(ctx, "no inputs left at priority %d, keeping it stopped", priority)
+		return s.setInputChainPauseReasonLocked(ctx, priority, inputPauseReasonNoInputs, true)
+	}
+	if s.inputPauseReasons[priority] != 0 {
+		// the updated resources are used once the priority is resumed
+		return s.setInputChainPauseReasonLocked(ctx, priority, inputPauseReasonNoInputs, false)
+	}
+	inputChain, err := s.getInputChain(ctx, priority)
+	if err != nil {
+		return err
+	}
+	if err := inputChain.Pause(ctx); err != nil {
+		return fmt.Errorf("unable to stop the inputs at priority %d: %w", priority, err)
+	}
+	if err := inputChain.Unpause(ctx); err != nil {
+		return fmt.Errorf("unable to restart the inputs at priority %d: %w", priority, err)
+	}
+	return nil
+}
+
+// SetStopInput stops (so the next priority is used) or resumes the inputs
+// of the given fallback priority. A priority stopped this way is kept
+// stopped until it is resumed the same way, even if its inputs are changed.
+func (s *FFStream) SetStopInput(
+	ctx context.Context,
+	priority uint,
+	stop bool,
+) (_err error) {
+	logger.Debugf(ctx, "SetStopInput(ctx, %d, %v)", priority, stop)
+	defer func() { logger.Debugf(ctx, "/SetStopInput(ctx, %d, %v): %v", priority, stop, _err) }()
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	if int(priority) >= len(s.InputsInfo) {
+		return fmt.Errorf("input priority %d is out of range (priorities=%d)", priority, len(s.InputsInfo))
+	}
+	return s.setInputChainPauseReasonLocked(ctx, priority, inputPauseReasonOperator, stop)
+}
+
+// SetInputCustomOption sets the custom option of the input number `num`
+// of the given fallback priority; it is used when the input is reopened.
+func (s *FFStream) SetInputCustomOption(
+	ctx context.Context,
+	priority uint,
+	num uint,
+	item avptypes.DictionaryItem,
+) (_err error) {
+	logger.Debugf(ctx, "SetInputCustomOption(ctx, %d, %d, %#+v)", priority, num, item)
+	defer func() { logger.Debugf(ctx, "/SetInputCustomOption(ctx, %d, %d, %#+v): %v", priority, num, item, _err) }()
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	if int(priority) >= len(s.InputsInfo) {
+		return fmt.Errorf("input priority %d is out of range (priorities=%d)", priority, len(s.InputsInfo))
+	}
+	resources := slices.Clone(s.InputsInfo[priority])
+	if int(num) >= len(resources) {
+		return fmt.Errorf("input num %d is out of range (inputs=%d)", num, len(resources))
+	}
+	resources[num].CustomOptions = slices.Clone(resources[num].CustomOptions)
+	resources[num].CustomOptions.SetFirst(item)
+	s.InputsInfo[priority] = resources
 	return nil
 }
 
@@ -109,10 +352,34 @@ func (s *FFStream) AddOutputTemplate(
 	defer func() { logger.Debugf(ctx, "/AddOutputTemplate(ctx, %#+v): %v", outputTemplate, _err) }()
 	s.locker.Lock()
 	defer s.locker.Unlock()
-	s.OutputTemplates = append(s.OutputTemplates, outputTemplate)
+	templates, err := appendOutputTemplate(s.OutputTemplates, outputTemplate)
+	if err != nil {
+		return err
+	}
+	s.OutputTemplates = templates
 	return nil
 }
 
+// appendOutputTemplate appends a primary output template, or adds
+// a fallback output template to the last primary one.
+func appendOutputTemplate(
+	templates []SenderTemplate,
+	outputTemplate SenderTemplate,
+) ([]SenderTemplate, error) {
+	if outputTemplate.FallbackPriority == 0 {
+		return append(templates, outputTemplate), nil
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("a fallback output (priority %d) requires a primary output before it", outputTemplate.FallbackPriority)
+	}
+	primary := &templates[len(templates)-1]
+	primary.Fallbacks = append(primary.Fallbacks, outputTemplate)
+	slices.SortStableFunc(primary.Fallbacks, func(a, b SenderTemplate) int {
+		return cmp.Compare(a.FallbackPriority, b.FallbackPriority)
+	})
+	return templates, nil
+}
+
 func (s *FFStream) GetTranscoderConfig(
 	ctx context.Context,
 ) (_ret streammuxtypes.TranscoderConfig) {
@@ -142,7 +409,14 @@ func (s *FFStream) SwitchOutputByProps(
 			return fmt.Errorf("resolution must be set for video codec %q", videoCfg.CodecName)
 		}
 	}
-	return s.StreamMux.SwitchToOutputByProps(ctx, props)
+	if err := s.StreamMux.SwitchToOutputByProps(ctx, props); err != nil {
+		return err
+	}
+	s.Filters.setTranscoderConfig(ctx, props.TranscoderConfig)
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.transcoderConfig = props.TranscoderConfig
+	return nil
 }
 
 func (s *FFStream) GetStats(
@@ -180,6 +454,58 @@ func (s *FFStream) GetStats(
 	return r
 }
 
+// SetFilterGraph sets the filter chain (like `-vf`/`-af`) applied to the decoded
+// frames of the given media type; it may be used both before and after Start.
+func (s *FFStream) SetFilterGraph(
+	ctx context.Context,
+	mediaType astiav.MediaType,
+	description string,
+) (_err error) {
+	logger.Debugf(ctx, "SetFilterGraph(ctx, %v, %q)", mediaType, description)
+	defer func() { logger.Debugf(ctx, "/SetFilterGraph(ctx, %v, %q): %v", mediaType, description, _err) }()
+	return s.Filters.SetFilterGraph(ctx, mediaType, description)
+}
+
+func (s *FFStream) GetFilterGraph(
+	ctx context.Context,
+	mediaType astiav.MediaType,
+) string {
+	return s.Filters.GetFilterGraph(ctx, mediaType)
+}
+
+// SetFilterComplex replaces the `-filter_complex` graph; since the graph
+// defines the stream layout, it should be initially set via OptionFilterComplex,
+// and the new graph should have the same labeled inputs and outputs.
+func (s *FFStream) SetFilterComplex(
+	ctx context.Context,
+	description string,
+) (_err error) {
+	logger.Debugf(ctx, "SetFilterComplex(ctx, %q)", description)
+	defer func() { logger.Debugf(ctx, "/SetFilterComplex(ctx, %q): %v", description, _err) }()
+	fc, err := ParseFilterComplex(description)
+	if err != nil {
+		return fmt.Errorf("unable to parse the graph: %w", err)
+	}
+	return s.Filters.SetFilterComplex(ctx, fc)
+}
+
+// SetMissingTrackFill changes whether and how a missing video or audio
+// track of the output is synthesized; it may be used both before and after Start.
+func (s *FFStream) SetMissingTrackFill(
+	ctx context.Context,
+	fill MissingTrackFill,
+) (_err error) {
+	logger.Debugf(ctx, "SetMissingTrackFill(ctx, %v)", fill)
+	defer func() { logger.Debugf(ctx, "/SetMissingTrackFill(ctx, %v): %v", fill, _err) }()
+	return s.Filters.SetMissingTrackFill(ctx, fill)
+}
+
+func (s *FFStream) GetMissingTrackFill(
+	ctx context.Context,
+) MissingTrackFill {
+	return s.Filters.GetMissingTrackFill(ctx)
+}
+
 func (s *FFStream) GetAllStats(
 	ctx context.Context,
 ) map[string]avptypes.Statistics {
@@ -201,8 +527,8 @@ func (s *FFStream) Start(
 	if s.Inputs.GetInputChainsCount(ctx) == 0 {
 		return fmt.Errorf("no inputs added")
 	}
-	if len(s.OutputTemplates) != 1 {
-		return fmt.Errorf("exactly one output template is required, got %d", len(s.OutputTemplates))
+	if len(s.OutputTemplates) == 0 {
+		return fmt.Errorf("at least one output template is required")
 	}
 
 	ctx, cancelFn := context.WithCancel(ctx)
@@ -227,8 +553,11 @@ func (s *FFStream) Start(
 		return fmt.Errorf("unable to set the auto-bitrate config %#+v: %w", autoBitRateVideo, err)
 	}
 
+	// packets go directly to the StreamMux, while decoded frames go through the filters
+	s.FilterNode = node.NewWithCustomDataFromKernel[CustomData](ctx, s.Filters)
 	s.Inputs.AddPushPacketsTo(ctx, s.StreamMux, packetfiltercondition.Function(s.onInputPacket))
-	s.Inputs.AddPushFramesTo(ctx, s.StreamMux, framefiltercondition.Function(s.onInputFrame))
+	s.Inputs.AddPushFramesTo(ctx, s.FilterNode, framefiltercondition.Function(s.onInputFrame))
+	s.FilterNode.AddPushFramesTo(ctx, s.StreamMux)
 
 	if err := s.SwitchOutputByProps(ctx, streammuxtypes.SenderProps{
 		TranscoderConfig: transcoderConfig,
@@ -286,6 +615,10 @@ func (s *FFStream) Start(
 	}
 
 	errCh := make(chan node.Error, 100)
+	inputChainErrCh := make(chan node.Error, 100)
+	s.locker.Lock()
+	s.serveCtx, s.inputChainErrCh = ctx, inputChainErrCh
+	s.locker.Unlock()
 	observability.Go(ctx, func(ctx context.Context) {
 		defer close(errCh)
 		avpipeline.Serve(ctx, avpipeline.ServeConfig{
@@ -294,15 +627,24 @@ func (s *FFStream) Start(
 	})
 
 	observability.Go(ctx, func(ctx context.Context) {
-		defer s.cancelFunc()
-		select {
-		case <-ctx.Done():
-		case err, ok := <-errCh:
-			if !ok {
-				logger.Debugf(ctx, "the error channel is closed")
+		var endErr error
+		defer func() {
+			s.publishEvent(ctx, EndOfStreamEvent{Time: time.Now(), Err: endErr})
+			s.cancelFunc()
+		}()
+		for {
+			var err node.Error
+			select {
+			case <-ctx.Done():
 				return
+			case e, ok := <-errCh:
+				if !ok {
+					logger.Debugf(ctx, "the error channel is closed")
+					return
+				}
+				err = e
+			case err = <-inputChainErrCh:
 			}
-
 			if errors.Is(err.Err, context.Canceled) {
 				logger.Debugf(ctx, "cancelled: %#+v", err)
 				return
@@ -311,11 +653,19 @@ func (s *FFStream) Start(
 				logger.Debugf(ctx, "EOF: %#+v", err)
 				return
 			}
+			if s.detachFailedDestination(ctx, err) {
+				continue
+			}
 			logger.Errorf(ctx, "stopping because received error: %v", err)
+			endErr = err.Err
+			s.publishEvent(ctx, ErrorEvent{Time: time.Now(), Err: err.Err})
 			return
 		}
 	})
 
+	observability.Go(ctx, s.watchState)
+	observability.Go(ctx, s.watchInputHealth)
+
 	err = s.StreamMux.WaitForStart(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to wait for streammux's start: %w", err)
@@ -489,7 +839,8 @@ func (s *FFStream) onInputPacket(
 	s.InputQualityMeasurer.ObservePacketOrFrame(ctx, packetorframe.InputUnion{
 		Packet: &packet.Input,
 	})
-	return true
+	// the streams consumed by the -filter_complex graph are used only as frames
+	return !s.Filters.IsFilterComplexInput(ctx, packet.Input.GetStreamIndex())
 }
 
 func (s *FFStream) onInputFrame(
@@ -502,6 +853,8 @@ func (s *FFStream) onInputFrame(
 	return true
 }
 
+// GetInputQuality returns the quality of the stream received from the inputs
+// of the active fallback priority (see GetActiveInputPriority).
 func (s *FFStream) GetInputQuality(
 	ctx context.Context,
 ) (_ret *quality.QualityAggregated, err error) {
@@ -512,6 +865,12 @@ func (s *FFStream) GetInputQuality(
 	return r.Aggregate(), nil
 }
 
+// OutputQualityDestination is the destination (the index of the output
+// template) which quality is measured, see GetOutputQuality.
+const OutputQualityDestination = 0
+
+// GetOutputQuality returns the quality of the stream sent to the destination
+// OutputQualityDestination of the active output (see GetActiveOutputID).
 func (s *FFStream) GetOutputQuality(
 	ctx context.Context,
 ) (_ret *quality.QualityAggregated, err error) {
@@ -0,0 +1,276 @@
+package ffstream
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/asticode/go-astiav"
+)
+
+// FilterComplex is a parsed `-filter_complex` graph, e.g.
+// "[0:v][1:v]overlay=W-w-10:10[out]".
+//
+// The unconnected input pads are labeled by stream selectors of the inputs
+// of the same fallback priority (see StreamSelector), and the unconnected
+// output pads are labeled by arbitrary names to be used in `-map "[name]"`.
+type FilterComplex struct {
+	Description string
+	Inputs      []FilterComplexInput
+	Outputs     []FilterComplexOutput
+}
+
+type FilterComplexInput struct {
+	Label    string
+	Selector StreamSelector
+}
+
+type FilterComplexOutput struct {
+	Label     string
+	MediaType astiav.MediaType
+}
+
+// ParseFilterComplex parses the description and validates it, see validateFilterGraph.
+func ParseFilterComplex(description string) (*FilterComplex, error) {
+	fc := &FilterComplex{Description: description}
+
+	type outputPad struct {
+		Label     string
+		MediaType astiav.MediaType
+	}
+	var produced []outputPad
+	consumed := map[string]struct{}{}
+	var consumedOrder []string
+	for _, chain := range splitFilterGraph(description, ';') {
+		filters := splitFilterGraph(chain, ',')
+		for idx, filterStr := range filters {
+			inLabels, name, outLabels, err := parseFilterGraphFilter(filterStr)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse %q: %w", filterStr, err)
+			}
+			filter := astiav.FindFilterByName(name)
+			if filter == nil {
+				return nil, fmt.Errorf("filter %q not found", name)
+			}
+			if idx == 0 && len(inLabels) == 0 && len(filter.Inputs()) > 0 {
+				return nil, fmt.Errorf("the input of filter %q is not labeled; the inputs of -filter_complex should be labeled by stream selectors, e.g. '[0:v]%s'", name, name)
+			}
+			if idx == len(filters)-1 && len(outLabels) == 0 {
+				return nil, fmt.Errorf("the output of filter %q is not labeled; the outputs of -filter_complex should be labeled, e.g. '%s[out]'", name, name)
+			}
+			for _, label := range inLabels {
+				if _, ok := consumed[label]; ok {
+					return nil, fmt.Errorf("label %q is used as an input more than once", label)
+				}
+				consumed[label] = struct{}{}
+				consumedOrder = append(consumedOrder, label)
+			}
+			for padIdx, label := range outLabels {
+				produced = append(produced, outputPad{
+					Label:     label,
+					MediaType: filterOutputMediaType(filter, name, padIdx),
+				})
+			}
+		}
+	}
+
+	isProduced := map[string]struct{}{}
+	for _, pad := range produced {
+		if _, ok := isProduced[pad.Label]; ok {
+			return nil, fmt.Errorf("label %q is used as an output more than once", pad.Label)
+		}
+		isProduced[pad.Label] = struct{}{}
+		if _, ok := consumed[pad.Label]; ok {
+			continue
+		}
+		switch pad.MediaType {
+		case astiav.MediaTypeVideo, astiav.MediaTypeAudio:
+		default:
+			return nil, fmt.Errorf("output %q: only video and audio outputs are supported, got %v", pad.Label, pad.MediaType)
+		}
+		fc.Outputs = append(fc.Outputs, FilterComplexOutput{
+			Label:     pad.Label,
+			MediaType: pad.MediaType,
+		})
+	}
+	for _, label := range consumedOrder {
+		if _, ok := isProduced[label]; ok {
+			continue
+		}
+		sel, err := ParseStreamSelector(label)
+		if err != nil {
+			return nil, fmt.Errorf("input %q is neither an output of another filter nor a stream selector: %w", label, err)
+		}
+		if sel.Negative || sel.Optional {
+			return nil, fmt.Errorf("input %q: negative and optional selectors are not allowed in -filter_complex", label)
+		}
+		switch sel.MediaType {
+		case astiav.MediaTypeVideo, astiav.MediaTypeAudio:
+		default:
+			return nil, fmt.Errorf("input %q: a video or audio media type is required, e.g. '[%d:v]'", label, sel.InputNum)
+		}
+		fc.Inputs = append(fc.Inputs, FilterComplexInput{
+			Label:    label,
+			Selector: sel,
+		})
+	}
+	if len(fc.Inputs) == 0 {
+		return nil, fmt.Errorf("the graph has no inputs")
+	}
+	if len(fc.Outputs) == 0 {
+		return nil, fmt.Errorf("the graph has no outputs")
+	}
+
+	sources := map[string]astiav.MediaType{}
+	for _, in := range fc.Inputs {
+		sources[in.Label] = in.Selector.MediaType
+	}
+	if err := validateFilterGraph(description, sources, fc.sinks()); err != nil {
+		return nil, fmt.Errorf("invalid filter graph: %w", err)
+	}
+	return fc, nil
+}
+
+// CheckMatched returns an error if an input pad of the graph matches none
+// of the given streams (all the streams of all the inputs of a fallback priority).
+func (fc *FilterComplex) CheckMatched(streams []StreamInfo) error {
+	for _, in := range fc.Inputs {
+		if !slices.ContainsFunc(streams, in.Selector.Matches) {
+			return fmt.Errorf("the stream selector [%s] of -filter_complex matches no streams", in.Label)
+		}
+	}
+	return nil
+}
+
+func (fc *FilterComplex) sinks() map[string]astiav.MediaType {
+	sinks := map[string]astiav.MediaType{}
+	for _, out := range fc.Outputs {
+		sinks[out.Label] = out.MediaType
+	}
+	return sinks
+}
+
+// Output returns the output pad with the given label.
+func (fc *FilterComplex) Output(label string) (FilterComplexOutput, bool) {
+	for _, out := range fc.Outputs {
+		if out.Label == label {
+			return out, true
+		}
+	}
+	return FilterComplexOutput{}, false
+}
+
+// HasSamePads returns true if the graphs have the same labeled inputs and outputs,
+// that is if one of them could be replaced by the other without changing
+// the stream layout.
+func (fc *FilterComplex) HasSamePads(other *FilterComplex) bool {
+	if len(fc.Inputs) != len(other.Inputs) || len(fc.Outputs) != len(other.Outputs) {
+		return false
+	}
+	for idx := range fc.Inputs {
+		if fc.Inputs[idx] != other.Inputs[idx] {
+			return false
+		}
+	}
+	for idx := range fc.Outputs {
+		if fc.Outputs[idx] != other.Outputs[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// filterOutputMediaType returns the media type of the given output pad; filters
+// with dynamic outputs (e.g. "split", "asplit") are assumed to output the media
+// type of their input.
+func filterOutputMediaType(
+	filter *astiav.Filter,
+	name string,
+	padIdx int,
+) astiav.MediaType {
+	if pads := filter.Outputs(); padIdx < len(pads) {
+		return pads[padIdx].MediaType()
+	}
+	if pads := filter.Inputs(); len(pads) > 0 {
+		return pads[0].MediaType()
+	}
+	if strings.HasPrefix(name, "a") {
+		return astiav.MediaTypeAudio
+	}
+	return astiav.MediaTypeVideo
+}
+
+// splitFilterGraph splits a filter graph description by the separator
+// (';' between chains, ',' between filters) respecting quoting, escaping
+// and link labels.
+func splitFilterGraph(s string, sep byte) []string {
+	var (
+		result   []string
+		start    int
+		inQuote  bool
+		inLabel  bool
+		isEscape bool
+	)
+	for idx := 0; idx < len(s); idx++ {
+		c := s[idx]
+		switch {
+		case isEscape:
+			isEscape = false
+		case c == '\\':
+			isEscape = true
+		case c == '\'':
+			inQuote = !inQuote
+		case inQuote:
+		case c == '[':
+			inLabel = true
+		case c == ']':
+			inLabel = false
+		case inLabel:
+		case c == sep:
+			result = append(result, s[start:idx])
+			start = idx + 1
+		}
+	}
+	result = append(result, s[start:])
+
+	filtered := result[:0]
+	for _, part := range result {
+		if strings.TrimSpace(part) != "" {
+			filtered = append(filtered, part)
+		}
+	}
+	return filtered
+}
+
+// parseFilterGraphFilter splits "[in0][in1]name@id=args[out0]" into its parts.
+func parseFilterGraphFilter(s string) (inLabels []string, name string, outLabels []string, _err error) {
+	s = strings.TrimSpace(s)
+	for strings.HasPrefix(s, "[") {
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return nil, "", nil, fmt.Errorf("unterminated label")
+		}
+		inLabels = append(inLabels, s[1:end])
+		s = strings.TrimSpace(s[end+1:])
+	}
+	for strings.HasSuffix(s, "]") {
+		begin := strings.LastIndexByte(s, '[')
+		if begin < 0 {
+			return nil, "", nil, fmt.Errorf("unterminated label")
+		}
+		outLabels = append([]string{s[begin+1 : len(s)-1]}, outLabels...)
+		s = strings.TrimSpace(s[:begin])
+	}
+	name, _, _ = strings.Cut(s, "=")
+	name, _, _ = strings.Cut(name, "@")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", nil, fmt.Errorf("filter name is empty")
+	}
+	for _, label := range append(inLabels, outLabels...) {
+		if label == "" {
+			return nil, "", nil, fmt.Errorf("empty label")
+		}
+	}
+	return inLabels, name, outLabels, nil
+}
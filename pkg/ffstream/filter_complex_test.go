@@ -0,0 +1,48 @@
+package ffstream
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFilterGraphFilter(t *testing.T) {
+	chains := splitFilterGraph("[0:v]scale=320:-1[pip];[1:v][pip]overlay@pip=W-w-10:10,drawtext=text='a,b;c'[out]", ';')
+	if len(chains) != 2 {
+		t.Fatalf("expected 2 chains, got %q", chains)
+	}
+	filters := splitFilterGraph(chains[1], ',')
+	if len(filters) != 2 {
+		t.Fatalf("expected 2 filters, got %q", filters)
+	}
+
+	for _, tc := range []struct {
+		in        string
+		wantIn    []string
+		wantName  string
+		wantOut   []string
+		wantError bool
+	}{
+		{in: chains[0], wantIn: []string{"0:v"}, wantName: "scale", wantOut: []string{"pip"}},
+		{in: filters[0], wantIn: []string{"1:v", "pip"}, wantName: "overlay"},
+		{in: filters[1], wantName: "drawtext", wantOut: []string{"out"}},
+		{in: "[0:a][1:a]amix=inputs=2[a0][a1]", wantIn: []string{"0:a", "1:a"}, wantName: "amix", wantOut: []string{"a0", "a1"}},
+		{in: "[0:v]", wantError: true},
+		{in: "[]scale=1:1", wantError: true},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			inLabels, name, outLabels, err := parseFilterGraphFilter(tc.in)
+			if tc.wantError {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(inLabels, tc.wantIn) || name != tc.wantName || !reflect.DeepEqual(outLabels, tc.wantOut) {
+				t.Fatalf("expected %q %q %q, got %q %q %q", tc.wantIn, tc.wantName, tc.wantOut, inLabels, name, outLabels)
+			}
+		})
+	}
+}
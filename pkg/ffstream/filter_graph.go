@@ -0,0 +1,347 @@
+package ffstream
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/asticode/go-astiav"
+)
+
+// filterGraphSourceParams are the properties of the frames fed into
+// a buffer source; if they change, the graph needs to be rebuilt.
+type filterGraphSourceParams struct {
+	MediaType         astiav.MediaType
+	TimeBase          astiav.Rational
+	Width             int
+	Height            int
+	PixelFormat       astiav.PixelFormat
+	SampleAspectRatio astiav.Rational
+	SampleRate        int
+	SampleFormat      astiav.SampleFormat
+	ChannelLayout     astiav.ChannelLayout
+}
+
+func filterGraphSourceParamsFromFrame(
+	mediaType astiav.MediaType,
+	f *astiav.Frame,
+	timeBase astiav.Rational,
+) filterGraphSourceParams {
+	p := filterGraphSourceParams{
+		MediaType: mediaType,
+		TimeBase:  timeBase,
+	}
+	switch mediaType {
+	case astiav.MediaTypeVideo:
+		p.Width = f.Width()
+		p.Height = f.Height()
+		p.PixelFormat = f.PixelFormat()
+		p.SampleAspectRatio = f.SampleAspectRatio()
+	case astiav.MediaTypeAudio:
+		p.SampleRate = f.SampleRate()
+		p.SampleFormat = f.SampleFormat()
+		p.ChannelLayout = f.ChannelLayout()
+	}
+	return p
+}
+
+// dummyFilterGraphSourceParams are used to parse a filter graph
+// description before any frame is received, see validateFilterGraph.
+func dummyFilterGraphSourceParams(mediaType astiav.MediaType) filterGraphSourceParams {
+	switch mediaType {
+	case astiav.MediaTypeVideo:
+		return filterGraphSourceParams{
+			MediaType:         mediaType,
+			TimeBase:          astiav.NewRational(1, 1000),
+			Width:             640,
+			Height:            360,
+			PixelFormat:       astiav.PixelFormatYuv420P,
+			SampleAspectRatio: astiav.NewRational(1, 1),
+		}
+	case astiav.MediaTypeAudio:
+		return filterGraphSourceParams{
+			MediaType:     mediaType,
+			TimeBase:      astiav.NewRational(1, 48000),
+			SampleRate:    48000,
+			SampleFormat:  astiav.SampleFormatFltp,
+			ChannelLayout: astiav.ChannelLayoutStereo,
+		}
+	default:
+		return filterGraphSourceParams{MediaType: mediaType}
+	}
+}
+
+func (p filterGraphSourceParams) Equal(other filterGraphSourceParams) bool {
+	if p.MediaType != other.MediaType || p.TimeBase != other.TimeBase {
+		return false
+	}
+	switch p.MediaType {
+	case astiav.MediaTypeVideo:
+		return p.Width == other.Width &&
+			p.Height == other.Height &&
+			p.PixelFormat == other.PixelFormat &&
+			p.SampleAspectRatio == other.SampleAspectRatio
+	case astiav.MediaTypeAudio:
+		return p.SampleRate == other.SampleRate &&
+			p.SampleFormat == other.SampleFormat &&
+			p.ChannelLayout.Equal(other.ChannelLayout)
+	}
+	return true
+}
+
+type filterGraphSource struct {
+	*astiav.BuffersrcFilterContext
+	Params filterGraphSourceParams
+}
+
+type filterGraphSink struct {
+	*astiav.BuffersinkFilterContext
+	MediaType astiav.MediaType
+}
+
+// filterGraph is a configured libavfilter graph, fed by buffer sources
+// and drained by buffer sinks; both are addressed by the link labels
+// used in the graph description.
+type filterGraph struct {
+	Description string
+	Graph       *astiav.FilterGraph
+	Sources     map[string]*filterGraphSource
+	Sinks       map[string]*filterGraphSink
+}
+
+// newFilterGraph builds and configures the graph for the given parameters
+// of the frames of the sources.
+func newFilterGraph(
+	description string,
+	sources map[string]filterGraphSourceParams,
+	sinks map[string]astiav.MediaType,
+) (*filterGraph, error) {
+	g, err := parseFilterGraph(description, sources, sinks)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.Graph.Configure(); err != nil {
+		g.Close()
+		return nil, fmt.Errorf("unable to configure the filter graph %q: %w", description, err)
+	}
+	return g, nil
+}
+
+// parseFilterGraph builds the graph without configuring it, that is without
+// checking the filters against the parameters of the frames.
+func parseFilterGraph(
+	description string,
+	sources map[string]filterGraphSourceParams,
+	sinks map[string]astiav.MediaType,
+) (_ret *filterGraph, _err error) {
+	g := &filterGraph{
+		Description: description,
+		Graph:       astiav.AllocFilterGraph(),
+		Sources:     map[string]*filterGraphSource{},
+		Sinks:       map[string]*filterGraphSink{},
+	}
+	if g.Graph == nil {
+		return nil, fmt.Errorf("unable to allocate a filter graph")
+	}
+	defer func() {
+		if _err != nil {
+			g.Close()
+		}
+	}()
+
+	// "outputs" of the parsed graph are the buffer sources, and
+	// "inputs" are the buffer sinks (see avfilter_graph_parse_ptr).
+	var outputs, inputs *astiav.FilterInOut
+	defer func() {
+		if outputs != nil {
+			outputs.Free()
+		}
+		if inputs != nil {
+			inputs.Free()
+		}
+	}()
+
+	for label, params := range sources {
+		src, err := g.newSource(label, params)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create the source %q: %w", label, err)
+		}
+		g.Sources[label] = src
+
+		inOut := astiav.AllocFilterInOut()
+		if inOut == nil {
+			return nil, fmt.Errorf("unable to allocate a filter in/out")
+		}
+		inOut.SetName(label)
+		inOut.SetFilterContext(src.FilterContext())
+		inOut.SetPadIdx(0)
+		inOut.SetNext(outputs)
+		outputs = inOut
+	}
+
+	for label, mediaType := range sinks {
+		sink, err := g.newSink(label, mediaType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create the sink %q: %w", label, err)
+		}
+		g.Sinks[label] = sink
+
+		inOut := astiav.AllocFilterInOut()
+		if inOut == nil {
+			return nil, fmt.Errorf("unable to allocate a filter in/out")
+		}
+		inOut.SetName(label)
+		inOut.SetFilterContext(sink.FilterContext())
+		inOut.SetPadIdx(0)
+		inOut.SetNext(inputs)
+		inputs = inOut
+	}
+
+	if err := g.Graph.Parse(description, inputs, outputs); err != nil {
+		return nil, fmt.Errorf("unable to parse the filter graph %q: %w", description, err)
+	}
+	return g, nil
+}
+
+func (g *filterGraph) newSource(
+	label string,
+	params filterGraphSourceParams,
+) (*filterGraphSource, error) {
+	var filterName string
+	switch params.MediaType {
+	case astiav.MediaTypeVideo:
+		filterName = "buffer"
+	case astiav.MediaTypeAudio:
+		filterName = "abuffer"
+	default:
+		return nil, fmt.Errorf("unsupported media type: %v", params.MediaType)
+	}
+	filter := astiav.FindFilterByName(filterName)
+	if filter == nil {
+		return nil, fmt.Errorf("filter %q not found", filterName)
+	}
+	ctx, err := g.Graph.NewBuffersrcFilterContext(filter, "in_"+label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the filter context: %w", err)
+	}
+
+	p := astiav.AllocBuffersrcFilterContextParameters()
+	defer p.Free()
+	p.SetTimeBase(params.TimeBase)
+	switch params.MediaType {
+	case astiav.MediaTypeVideo:
+		p.SetWidth(params.Width)
+		p.SetHeight(params.Height)
+		p.SetPixelFormat(params.PixelFormat)
+		p.SetSampleAspectRatio(params.SampleAspectRatio)
+	case astiav.MediaTypeAudio:
+		p.SetSampleRate(params.SampleRate)
+		p.SetSampleFormat(params.SampleFormat)
+		p.SetChannelLayout(params.ChannelLayout)
+	}
+	if err := ctx.SetParameters(p); err != nil {
+		return nil, fmt.Errorf("unable to set the parameters: %w", err)
+	}
+	if err := ctx.Initialize(nil); err != nil {
+		return nil, fmt.Errorf("unable to initialize: %w", err)
+	}
+	return &filterGraphSource{
+		BuffersrcFilterContext: ctx,
+		Params:                 params,
+	}, nil
+}
+
+func (g *filterGraph) newSink(
+	label string,
+	mediaType astiav.MediaType,
+) (*filterGraphSink, error) {
+	var filterName string
+	switch mediaType {
+	case astiav.MediaTypeVideo:
+		filterName = "buffersink"
+	case astiav.MediaTypeAudio:
+		filterName = "abuffersink"
+	default:
+		return nil, fmt.Errorf("unsupported media type: %v", mediaType)
+	}
+	filter := astiav.FindFilterByName(filterName)
+	if filter == nil {
+		return nil, fmt.Errorf("filter %q not found", filterName)
+	}
+	ctx, err := g.Graph.NewBuffersinkFilterContext(filter, "out_"+label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the filter context: %w", err)
+	}
+	return &filterGraphSink{
+		BuffersinkFilterContext: ctx,
+		MediaType:               mediaType,
+	}, nil
+}
+
+// SendFrame feeds a frame into the source with the given label;
+// the frame is referenced, so the caller keeps the ownership.
+func (g *filterGraph) SendFrame(label string, f *astiav.Frame) error {
+	src, ok := g.Sources[label]
+	if !ok {
+		return fmt.Errorf("source %q not found", label)
+	}
+	if err := src.AddFrame(f, astiav.NewBuffersrcFlags(astiav.BuffersrcFlagKeepRef)); err != nil {
+		return fmt.Errorf("unable to add a frame to source %q: %w", label, err)
+	}
+	return nil
+}
+
+// ReceiveFrames calls the callback for each frame currently available
+// in the sink with the given label; the callback takes the ownership of the frame.
+func (g *filterGraph) ReceiveFrames(
+	label string,
+	callback func(f *astiav.Frame, timeBase astiav.Rational) error,
+) error {
+	sink, ok := g.Sinks[label]
+	if !ok {
+		return fmt.Errorf("sink %q not found", label)
+	}
+	for {
+		f := astiav.AllocFrame()
+		err := sink.GetFrame(f, astiav.NewBuffersinkFlags())
+		if err != nil {
+			f.Free()
+			if errors.Is(err, astiav.ErrEagain) || errors.Is(err, astiav.ErrEof) {
+				return nil
+			}
+			return fmt.Errorf("unable to get a frame from sink %q: %w", label, err)
+		}
+		if err := callback(f, sink.TimeBase()); err != nil {
+			return err
+		}
+	}
+}
+
+func (g *filterGraph) Close() {
+	if g == nil || g.Graph == nil {
+		return
+	}
+	g.Graph.Free()
+	g.Graph = nil
+}
+
+// validateFilterGraph checks the syntax of the description, the names and
+// the options of the filters and the links between them. Whether the filters
+// accept the frames (e.g. a crop within the frame size) depends on
+// the parameters of the frames, so it is checked when the graph is built
+// for the actual frames (see FilterKernel).
+func validateFilterGraph(
+	description string,
+	sources map[string]astiav.MediaType,
+	sinks map[string]astiav.MediaType,
+) error {
+	sourcesParams := map[string]filterGraphSourceParams{}
+	for label, mediaType := range sources {
+		sourcesParams[label] = dummyFilterGraphSourceParams(mediaType)
+	}
+	g, err := parseFilterGraph(description, sourcesParams, sinks)
+	if err != nil {
+		return err
+	}
+	g.Close()
+	return nil
+}
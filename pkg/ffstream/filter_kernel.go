@@ -0,0 +1,530 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	codectypes "github.com/xaionaro-go/avpipeline/codec/types"
+	"github.com/xaionaro-go/avpipeline/frame"
+	"github.com/xaionaro-go/avpipeline/kernel"
+	"github.com/xaionaro-go/avpipeline/packetorframe"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	filterGraphSimpleSourceLabel = "in"
+	filterGraphSimpleSinkLabel   = "out"
+)
+
+// FilterKernel applies libavfilter graphs to decoded frames. It is placed
+// between the inputs and the StreamMux, that is between the decoders and
+// the encoders, so it has effect only on transcoded tracks.
+//
+// Each stream gets its own instance of the graph of its media type
+// (the `-vf`/`-af` chains); the instance is rebuilt if the graph is replaced
+// or if the frame parameters change (e.g. on an input fallback).
+//
+// Additionally, there may be a single `-filter_complex` graph: it consumes
+// the streams assigned to its input pads (see StreamMap.FilterComplexInputStreamIndex)
+// and produces the streams of the output tracks its outputs are mapped to.
+//
+// A graph that cannot be built for the actual frames (e.g. a crop exceeding
+// the frame size) is reported to onGraphError, and the frames it should
+// have filtered are dropped until the graph or the frame parameters change.
+//
+// Finally, the frames of a missing video or audio track may be synthesized,
+// see MissingTrackFill.
+type FilterKernel struct {
+	Locker xsync.Mutex
+
+	descriptions map[astiav.MediaType]string
+	graphs       map[int]*filterGraph
+	failedGraphs map[int]failedFilterGraph
+
+	// sourceParams are the parameters of the last frame of each media type
+	// passed through the `-vf`/`-af` graphs, to validate a new graph with.
+	sourceParams map[astiav.MediaType]filterGraphSourceParams
+
+	complex            *FilterComplex
+	complexInputs      map[int]string
+	complexOutputs     map[string]int
+	complexParams      map[string]filterGraphSourceParams
+	complexStreamInfos map[string]frame.StreamInfo
+	complexGraph       *filterGraph
+	complexGraphFailed bool
+
+	streamMap     StreamMap
+	missingTracks *missingTracksFiller
+
+	onGraphError func(ctx context.Context, err error)
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+// failedFilterGraph is a graph which could not be built for the frames
+// with the given parameters.
+type failedFilterGraph struct {
+	Description string
+	Params      filterGraphSourceParams
+}
+
+var _ kernel.Abstract = (*FilterKernel)(nil)
+
+func newFilterKernel() *FilterKernel {
+	return &FilterKernel{
+		descriptions:  map[astiav.MediaType]string{},
+		graphs:        map[int]*filterGraph{},
+		failedGraphs:  map[int]failedFilterGraph{},
+		sourceParams:  map[astiav.MediaType]filterGraphSourceParams{},
+		missingTracks: newMissingTracksFiller(MissingTrackFillNone, nil),
+		closeChan:     make(chan struct{}),
+	}
+}
+
+// initMissingTracks sets the filling of the missing tracks of the stream
+// layout defined by the stream map.
+func (k *FilterKernel) initMissingTracks(
+	fill MissingTrackFill,
+	streamMap StreamMap,
+) {
+	k.streamMap = streamMap
+	k.missingTracks = newMissingTracksFiller(fill, streamMap)
+}
+
+// SetMissingTrackFill changes how the missing tracks are filled.
+func (k *FilterKernel) SetMissingTrackFill(
+	ctx context.Context,
+	fill MissingTrackFill,
+) error {
+	if fill <= UndefinedMissingTrackFill || fill >= EndOfMissingTrackFill {
+		return fmt.Errorf("unknown missing track fill: %v", fill)
+	}
+	k.Locker.Do(ctx, func() {
+		if k.missingTracks.Fill == fill {
+			return
+		}
+		defaults := k.missingTracks.Defaults
+		k.missingTracks.free()
+		k.missingTracks = newMissingTracksFiller(fill, k.streamMap)
+		k.missingTracks.Defaults = defaults
+	})
+	return nil
+}
+
+func (k *FilterKernel) GetMissingTrackFill(
+	ctx context.Context,
+) MissingTrackFill {
+	return xsync.DoR1(ctx, &k.Locker, func() MissingTrackFill {
+		return k.missingTracks.Fill
+	})
+}
+
+// setTranscoderConfig sets the parameters of the encoders, which
+// the synthesized frames of the missing tracks are based on.
+func (k *FilterKernel) setTranscoderConfig(
+	ctx context.Context,
+	cfg streammuxtypes.TranscoderConfig,
+) {
+	k.Locker.Do(ctx, func() {
+		k.missingTracks.setDefaults(missingTrackDefaultsFromTranscoderConfig(cfg))
+	})
+}
+
+// isMissingTrackFilled returns true if an output with the given encoders
+// always gets both the video and the audio tracks, see MissingTrackFill.
+func (k *FilterKernel) isMissingTrackFilled(
+	ctx context.Context,
+	videoCodec codectypes.Name,
+	audioCodec codectypes.Name,
+) bool {
+	return xsync.DoR1(ctx, &k.Locker, func() bool {
+		return k.missingTracks.isFilled(videoCodec, audioCodec)
+	})
+}
+
+// initFilterComplex sets the `-filter_complex` graph and the stream layout
+// around it; the layout cannot be changed afterwards.
+func (k *FilterKernel) initFilterComplex(
+	fc *FilterComplex,
+	streamMap StreamMap,
+) {
+	k.complex = fc
+	k.complexInputs = map[int]string{}
+	k.complexOutputs = map[string]int{}
+	k.complexParams = map[string]filterGraphSourceParams{}
+	k.complexStreamInfos = map[string]frame.StreamInfo{}
+	for padIdx, in := range fc.Inputs {
+		k.complexInputs[streamMap.FilterComplexInputStreamIndex(padIdx)] = in.Label
+	}
+	for _, out := range fc.Outputs {
+		if trackID, ok := streamMap.TrackIDByLabel(out.Label); ok {
+			k.complexOutputs[out.Label] = trackID
+		}
+	}
+}
+
+// SetFilterComplex replaces the `-filter_complex` graph; the new graph
+// must have the same labeled inputs and outputs. If the frames of all
+// the inputs of the graph are already received, the new graph is checked
+// to be buildable for them.
+func (k *FilterKernel) SetFilterComplex(
+	ctx context.Context,
+	fc *FilterComplex,
+) error {
+	return xsync.DoR1(ctx, &k.Locker, func() error {
+		if k.complex == nil {
+			return fmt.Errorf("there is no -filter_complex graph to replace; the graph should be set on start to define the stream layout")
+		}
+		if !k.complex.HasSamePads(fc) {
+			return fmt.Errorf("the new graph should have the same labeled inputs and outputs as the current one")
+		}
+		if len(k.complexParams) == len(fc.Inputs) {
+			g, err := newFilterGraph(fc.Description, k.complexParams, fc.sinks())
+			if err != nil {
+				return fmt.Errorf("the graph cannot be used for the current frames: %w", err)
+			}
+			g.Close()
+		}
+		k.complex = fc
+		k.complexGraphFailed = false
+		return nil
+	})
+}
+
+func (k *FilterKernel) GetFilterComplex(
+	ctx context.Context,
+) *FilterComplex {
+	return xsync.DoR1(ctx, &k.Locker, func() *FilterComplex {
+		return k.complex
+	})
+}
+
+// IsFilterComplexInput returns true if the stream is consumed by
+// the `-filter_complex` graph.
+func (k *FilterKernel) IsFilterComplexInput(
+	ctx context.Context,
+	streamIdx int,
+) bool {
+	return xsync.DoR1(ctx, &k.Locker, func() bool {
+		_, ok := k.complexInputs[streamIdx]
+		return ok
+	})
+}
+
+func (k *FilterKernel) String() string {
+	return "ffstream:FilterKernel"
+}
+
+// SetFilterGraph sets the filter chain for all the streams of the given media type;
+// an empty description disables the filtering. If frames of the media type
+// are already received, the chain is checked to be buildable for them.
+func (k *FilterKernel) SetFilterGraph(
+	ctx context.Context,
+	mediaType astiav.MediaType,
+	description string,
+) error {
+	switch mediaType {
+	case astiav.MediaTypeVideo, astiav.MediaTypeAudio:
+	default:
+		return fmt.Errorf("filters are supported only for video and audio, got %v", mediaType)
+	}
+	if description != "" {
+		err := validateFilterGraph(
+			description,
+			map[string]astiav.MediaType{filterGraphSimpleSourceLabel: mediaType},
+			map[string]astiav.MediaType{filterGraphSimpleSinkLabel: mediaType},
+		)
+		if err != nil {
+			return fmt.Errorf("invalid filter graph: %w", err)
+		}
+	}
+	return xsync.DoR1(ctx, &k.Locker, func() error {
+		if params, ok := k.sourceParams[mediaType]; ok && description != "" {
+			g, err := newFilterGraph(
+				description,
+				map[string]filterGraphSourceParams{filterGraphSimpleSourceLabel: params},
+				map[string]astiav.MediaType{filterGraphSimpleSinkLabel: mediaType},
+			)
+			if err != nil {
+				return fmt.Errorf("the filter graph cannot be used for the current frames: %w", err)
+			}
+			g.Close()
+		}
+		k.descriptions[mediaType] = description
+		return nil
+	})
+}
+
+func (k *FilterKernel) GetFilterGraph(
+	ctx context.Context,
+	mediaType astiav.MediaType,
+) string {
+	return xsync.DoR1(ctx, &k.Locker, func() string {
+		return k.descriptions[mediaType]
+	})
+}
+
+func (k *FilterKernel) SendInput(
+	ctx context.Context,
+	input packetorframe.InputUnion,
+	outputCh chan<- packetorframe.OutputUnion,
+) error {
+	if input.Frame == nil {
+		return fmt.Errorf("only frames are supported by %s", k)
+	}
+	outputs, err := xsync.DoR2(ctx, &k.Locker, func() ([]packetorframe.OutputUnion, error) {
+		outputs, err := k.sendInputFrameLocked(ctx, input.Frame)
+		if err != nil {
+			return nil, err
+		}
+		return k.fillMissingTracksLocked(ctx, outputs), nil
+	})
+	if err != nil {
+		return err
+	}
+	for idx, out := range outputs {
+		select {
+		case <-ctx.Done():
+			for _, out := range outputs[idx:] {
+				out.Frame.Frame.Free()
+			}
+			return ctx.Err()
+		case outputCh <- out:
+		}
+	}
+	return nil
+}
+
+func (k *FilterKernel) sendInputFrameLocked(
+	ctx context.Context,
+	in *frame.Input,
+) ([]packetorframe.OutputUnion, error) {
+	streamIdx := in.GetStreamIndex()
+	if label, ok := k.complexInputs[streamIdx]; ok {
+		return k.sendFilterComplexFrameLocked(ctx, label, in)
+	}
+	mediaType := in.GetMediaType()
+	description := k.descriptions[mediaType]
+
+	g := k.graphs[streamIdx]
+	params := filterGraphSourceParamsFromFrame(mediaType, in.Frame, in.GetTimeBase())
+	k.sourceParams[mediaType] = params
+	if g != nil && (g.Description != description || !g.Sources[filterGraphSimpleSourceLabel].Params.Equal(params)) {
+		logger.Debugf(ctx, "the filter graph of stream %d needs to be rebuilt", streamIdx)
+		g.Close()
+		delete(k.graphs, streamIdx)
+		g = nil
+	}
+	if description == "" {
+		return k.passthroughLocked(in)
+	}
+	if g == nil {
+		failed := failedFilterGraph{Description: description, Params: params}
+		if prev, ok := k.failedGraphs[streamIdx]; ok && prev.Description == failed.Description && prev.Params.Equal(failed.Params) {
+			logger.Tracef(ctx, "dropping a frame of stream %d: the filter graph is not built", streamIdx)
+			return nil, nil
+		}
+		var err error
+		g, err = newFilterGraph(
+			description,
+			map[string]filterGraphSourceParams{filterGraphSimpleSourceLabel: params},
+			map[string]astiav.MediaType{filterGraphSimpleSinkLabel: mediaType},
+		)
+		if err != nil {
+			k.failedGraphs[streamIdx] = failed
+			k.reportGraphErrorLocked(ctx, fmt.Errorf("unable to build the filter graph for stream %d, dropping its frames until the graph or the frame parameters change: %w", streamIdx, err))
+			return nil, nil
+		}
+		delete(k.failedGraphs, streamIdx)
+		k.graphs[streamIdx] = g
+	}
+
+	if err := g.SendFrame(filterGraphSimpleSourceLabel, in.Frame); err != nil {
+		return nil, fmt.Errorf("stream %d: %w", streamIdx, err)
+	}
+	var outputs []packetorframe.OutputUnion
+	err := g.ReceiveFrames(filterGraphSimpleSinkLabel, func(f *astiav.Frame, timeBase astiav.Rational) error {
+		outputs = append(outputs, filteredFrameOutput(in, f, timeBase))
+		return nil
+	})
+	if err != nil {
+		for _, out := range outputs {
+			out.Frame.Frame.Free()
+		}
+		return nil, fmt.Errorf("stream %d: %w", streamIdx, err)
+	}
+	return outputs, nil
+}
+
+func (k *FilterKernel) sendFilterComplexFrameLocked(
+	ctx context.Context,
+	label string,
+	in *frame.Input,
+) ([]packetorframe.OutputUnion, error) {
+	params := filterGraphSourceParamsFromFrame(in.GetMediaType(), in.Frame, in.GetTimeBase())
+	k.complexStreamInfos[label] = *in.StreamInfo
+	if oldParams, ok := k.complexParams[label]; !ok || !oldParams.Equal(params) {
+		k.complexParams[label] = params
+		k.complexGraphFailed = false
+	}
+
+	g := k.complexGraph
+	if g != nil && (g.Description != k.complex.Description || !g.Sources[label].Params.Equal(params)) {
+		logger.Debugf(ctx, "the -filter_complex graph needs to be rebuilt")
+		g.Close()
+		k.complexGraph = nil
+		g = nil
+	}
+	if g == nil {
+		if k.complexGraphFailed || len(k.complexParams) < len(k.complex.Inputs) {
+			// waiting for the first frames of the other inputs (or for
+			// a change that could fix the graph)
+			logger.Tracef(ctx, "dropping a frame of %q: the -filter_complex graph is not built", label)
+			return nil, nil
+		}
+		var err error
+		g, err = newFilterGraph(k.complex.Description, k.complexParams, k.complex.sinks())
+		if err != nil {
+			k.complexGraphFailed = true
+			k.reportGraphErrorLocked(ctx, fmt.Errorf("unable to build the -filter_complex graph, dropping its frames until the graph or the frame parameters change: %w", err))
+			return nil, nil
+		}
+		k.complexGraph = g
+	}
+
+	if err := g.SendFrame(label, in.Frame); err != nil {
+		return nil, fmt.Errorf("-filter_complex input %q: %w", label, err)
+	}
+	var outputs []packetorframe.OutputUnion
+	for _, out := range k.complex.Outputs {
+		trackID, isMapped := k.complexOutputs[out.Label]
+		streamInfo, timeBase := k.filterComplexOutputStreamInfoLocked(out.MediaType)
+		err := g.ReceiveFrames(out.Label, func(f *astiav.Frame, sinkTimeBase astiav.Rational) error {
+			if !isMapped {
+				f.Free()
+				return nil
+			}
+			if sinkTimeBase != timeBase {
+				f.SetPts(astiav.RescaleQ(f.Pts(), sinkTimeBase, timeBase))
+				f.SetDuration(astiav.RescaleQ(f.Duration(), sinkTimeBase, timeBase))
+			}
+			streamInfo := streamInfo
+			streamInfo.StreamIndex = trackID
+			o := frame.BuildOutput(f, &streamInfo)
+			outputs = append(outputs, packetorframe.OutputUnion{Frame: &o})
+			return nil
+		})
+		if err != nil {
+			for _, out := range outputs {
+				out.Frame.Frame.Free()
+			}
+			return nil, fmt.Errorf("-filter_complex output %q: %w", out.Label, err)
+		}
+	}
+	return outputs, nil
+}
+
+// filterComplexOutputStreamInfoLocked returns the stream info (and its time base)
+// the frames of a `-filter_complex` output are based on: the one of the first
+// input of the same media type, or just of the first input.
+func (k *FilterKernel) filterComplexOutputStreamInfoLocked(
+	mediaType astiav.MediaType,
+) (frame.StreamInfo, astiav.Rational) {
+	for _, in := range k.complex.Inputs {
+		if in.Selector.MediaType == mediaType {
+			return k.complexStreamInfos[in.Label], k.complexParams[in.Label].TimeBase
+		}
+	}
+	in := k.complex.Inputs[0]
+	return k.complexStreamInfos[in.Label], k.complexParams[in.Label].TimeBase
+}
+
+// reportGraphErrorLocked reports a graph that cannot be built for the actual frames.
+func (k *FilterKernel) reportGraphErrorLocked(
+	ctx context.Context,
+	err error,
+) {
+	logger.Errorf(ctx, "%v", err)
+	if k.onGraphError != nil {
+		k.onGraphError(ctx, err)
+	}
+}
+
+// fillMissingTracksLocked appends the synthesized frames of the missing
+// tracks (if enabled) to the outputs.
+func (k *FilterKernel) fillMissingTracksLocked(
+	ctx context.Context,
+	outputs []packetorframe.OutputUnion,
+) []packetorframe.OutputUnion {
+	switch k.missingTracks.Fill {
+	case MissingTrackFillBlack, MissingTrackFillFreeze:
+	default:
+		return outputs
+	}
+	outputs = k.missingTracks.observe(ctx, outputs)
+	filled, err := k.missingTracks.generate(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "%v", err)
+		return outputs
+	}
+	return append(outputs, filled...)
+}
+
+func (k *FilterKernel) passthroughLocked(
+	in *frame.Input,
+) ([]packetorframe.OutputUnion, error) {
+	f := astiav.AllocFrame()
+	if err := f.Ref(in.Frame); err != nil {
+		f.Free()
+		return nil, fmt.Errorf("unable to reference the frame: %w", err)
+	}
+	return []packetorframe.OutputUnion{filteredFrameOutput(in, f, in.GetTimeBase())}, nil
+}
+
+// filteredFrameOutput wraps a filtered frame into an output keeping the stream
+// info of the input frame, so the timestamps are converted back to its time base.
+func filteredFrameOutput(
+	in *frame.Input,
+	f *astiav.Frame,
+	timeBase astiav.Rational,
+) packetorframe.OutputUnion {
+	inTimeBase := in.GetTimeBase()
+	if timeBase != inTimeBase {
+		f.SetPts(astiav.RescaleQ(f.Pts(), timeBase, inTimeBase))
+		f.SetDuration(astiav.RescaleQ(f.Duration(), timeBase, inTimeBase))
+	}
+	out := frame.BuildOutput(f, in.StreamInfo)
+	return packetorframe.OutputUnion{Frame: &out}
+}
+
+func (k *FilterKernel) Generate(
+	ctx context.Context,
+	outputCh chan<- packetorframe.OutputUnion,
+) error {
+	return nil
+}
+
+func (k *FilterKernel) Close(ctx context.Context) error {
+	k.closeOnce.Do(func() {
+		k.Locker.Do(ctx, func() {
+			for idx, g := range k.graphs {
+				g.Close()
+				delete(k.graphs, idx)
+			}
+			k.complexGraph.Close()
+			k.complexGraph = nil
+			k.missingTracks.free()
+		})
+		close(k.closeChan)
+	})
+	return nil
+}
+
+func (k *FilterKernel) CloseChan() <-chan struct{} {
+	return k.closeChan
+}
@@ -0,0 +1,187 @@
+package ffstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/asticode/go-astiav"
+	"github.com/xaionaro-go/avpipeline/codec"
+	"github.com/xaionaro-go/avpipeline/frame"
+	"github.com/xaionaro-go/avpipeline/packetorframe"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+)
+
+func TestFilterKernelValidatesByActualFrames(t *testing.T) {
+	ctx := context.Background()
+
+	// larger than the frames the graphs are parsed with
+	const description = "crop=1280:720:0:0"
+	k := newFilterKernel()
+	if err := k.SetFilterGraph(ctx, astiav.MediaTypeVideo, description); err != nil {
+		t.Fatalf("unexpected error before any frame is received: %v", err)
+	}
+	if err := k.SetFilterGraph(ctx, astiav.MediaTypeVideo, "crop=w=oops"); err == nil {
+		t.Fatalf("expected an error for an invalid option")
+	}
+
+	params := dummyFilterGraphSourceParams(astiav.MediaTypeVideo)
+	params.Width, params.Height = 1920, 1080
+	k.sourceParams[astiav.MediaTypeVideo] = params
+	if err := k.SetFilterGraph(ctx, astiav.MediaTypeVideo, description); err != nil {
+		t.Fatalf("unexpected error for 1920x1080 frames: %v", err)
+	}
+	params.Width, params.Height = 640, 360
+	k.sourceParams[astiav.MediaTypeVideo] = params
+	if err := k.SetFilterGraph(ctx, astiav.MediaTypeVideo, "crop=1280:720:10:10"); err == nil {
+		t.Fatalf("expected an error for 640x360 frames")
+	}
+	if got := k.GetFilterGraph(ctx, astiav.MediaTypeVideo); got != description {
+		t.Fatalf("expected the graph to be kept, got %q", got)
+	}
+
+	fc, err := ParseFilterComplex("[0:v]crop=1280:720:0:0[out]")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	streamMap, err := ParseStreamMap(nil, fc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	k.initFilterComplex(fc, streamMap)
+	k.complexParams["0:v"] = params
+	fc2, err := ParseFilterComplex("[0:v]crop=1280:720:10:10[out]")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := k.SetFilterComplex(ctx, fc2); err == nil {
+		t.Fatalf("expected an error for 640x360 frames")
+	}
+}
+
+func TestFilterKernelFillsMissingAudio(t *testing.T) {
+	ctx := context.Background()
+
+	k := newFilterKernel()
+	defer k.Close(ctx)
+	k.initMissingTracks(MissingTrackFillBlack, nil)
+	cfg, err := StreamMap(nil).TranscoderConfig(
+		streammuxtypes.OutputVideoTrackConfig{CodecName: "libx264", Resolution: codec.Resolution{Width: 320, Height: 240}},
+		streammuxtypes.OutputAudioTrackConfig{CodecName: "aac", SampleRate: 48000},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	k.setTranscoderConfig(ctx, cfg)
+	if !k.isMissingTrackFilled(ctx, "libx264", "aac") || k.isMissingTrackFilled(ctx, "libx264", "copy") {
+		t.Fatalf("expected only the transcoded tracks to be filled")
+	}
+
+	videoTimeBase := astiav.NewRational(1, 30)
+	videoCodecParameters := astiav.AllocCodecParameters()
+	defer videoCodecParameters.Free()
+	videoCodecParameters.SetMediaType(astiav.MediaTypeVideo)
+	videoStreamInfo := &frame.StreamInfo{
+		StreamIndex:     0,
+		CodecParameters: videoCodecParameters,
+		TimeBase:        videoTimeBase,
+	}
+	videoFrame := astiav.AllocFrame()
+	defer videoFrame.Free()
+	videoFrame.SetWidth(320)
+	videoFrame.SetHeight(240)
+	videoFrame.SetPixelFormat(astiav.PixelFormatYuv420P)
+	if err := videoFrame.AllocBuffer(0); err != nil {
+		t.Fatalf("unable to allocate the frame: %v", err)
+	}
+
+	var (
+		videoFrames int
+		audioEnd    time.Duration
+		audioFrames []packetorframe.OutputUnion
+	)
+	checkOutputs := func(outputCh chan packetorframe.OutputUnion) {
+		t.Helper()
+		for len(outputCh) > 0 {
+			out := <-outputCh
+			fr := out.Frame.Frame
+			timeBase := out.Frame.GetTimeBase()
+			switch frameMediaType(fr) {
+			case astiav.MediaTypeVideo:
+				videoFrames++
+			case astiav.MediaTypeAudio:
+				if out.Frame.GetStreamIndex() != 1 {
+					t.Fatalf("expected the audio at track 1, got %d", out.Frame.GetStreamIndex())
+				}
+				start := ptsToDuration(fr.Pts(), timeBase)
+				if len(audioFrames) > 0 && start < audioEnd {
+					t.Fatalf("the audio timestamps went backwards: the frame #%d starts at %v, before the end of the previous one at %v", len(audioFrames), start, audioEnd)
+				}
+				audioEnd = start + ptsToDuration(fr.Duration(), timeBase)
+				audioFrames = append(audioFrames, out)
+				continue
+			}
+			fr.Free()
+		}
+	}
+
+	// 3 seconds of the video without any audio
+	outputCh := make(chan packetorframe.OutputUnion, 1000)
+	for pts := int64(0); pts < 90; pts++ {
+		videoFrame.SetPts(pts)
+		videoFrame.SetDuration(1)
+		in := frame.BuildInput(videoFrame, videoStreamInfo)
+		if err := k.SendInput(ctx, packetorframe.InputUnion{Frame: &in}, outputCh); err != nil {
+			t.Fatalf("unable to send the frame: %v", err)
+		}
+		checkOutputs(outputCh)
+	}
+	if videoFrames != 90 {
+		t.Fatalf("expected all the 90 video frames to be passed, got %d", videoFrames)
+	}
+	if len(audioFrames) == 0 {
+		t.Fatalf("expected the audio track to be synthesized")
+	}
+	if audioEnd < 2900*time.Millisecond {
+		t.Fatalf("expected the audio to follow the video up to 3s, got %v", audioEnd)
+	}
+	synthesized := len(audioFrames)
+
+	// the audio appears, a bit behind the synthesized audio
+	audioTimeBase := astiav.NewRational(1, 48000)
+	audioCodecParameters := astiav.AllocCodecParameters()
+	defer audioCodecParameters.Free()
+	audioCodecParameters.SetMediaType(astiav.MediaTypeAudio)
+	audioStreamInfo := &frame.StreamInfo{
+		StreamIndex:     1,
+		CodecParameters: audioCodecParameters,
+		TimeBase:        audioTimeBase,
+	}
+	audioFrame := astiav.AllocFrame()
+	defer audioFrame.Free()
+	audioFrame.SetSampleRate(48000)
+	audioFrame.SetSampleFormat(astiav.SampleFormatFltp)
+	audioFrame.SetChannelLayout(astiav.ChannelLayoutStereo)
+	audioFrame.SetNbSamples(missingTrackAudioFrameSamples)
+	if err := audioFrame.AllocBuffer(0); err != nil {
+		t.Fatalf("unable to allocate the frame: %v", err)
+	}
+	for pts := durationToPTS(audioEnd-100*time.Millisecond, audioTimeBase); pts < 4*48000; pts += missingTrackAudioFrameSamples {
+		audioFrame.SetPts(pts)
+		audioFrame.SetDuration(missingTrackAudioFrameSamples)
+		in := frame.BuildInput(audioFrame, audioStreamInfo)
+		if err := k.SendInput(ctx, packetorframe.InputUnion{Frame: &in}, outputCh); err != nil {
+			t.Fatalf("unable to send the frame: %v", err)
+		}
+		checkOutputs(outputCh)
+	}
+	if len(audioFrames) <= synthesized {
+		t.Fatalf("expected the real audio frames to be passed")
+	}
+	if audioEnd < 3900*time.Millisecond {
+		t.Fatalf("expected the real audio up to 4s, got %v", audioEnd)
+	}
+	for _, out := range audioFrames {
+		out.Frame.Frame.Free()
+	}
+}
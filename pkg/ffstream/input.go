@@ -0,0 +1,213 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/xaionaro-go/avpipeline/kernel"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+func (s *FFStream) getInputChain(
+	ctx context.Context,
+	priority uint,
+) (*InputChain, error) {
+	return xsync.DoR2(ctx, &s.Inputs.InputChainsLocker, func() (*InputChain, error) {
+		if int(priority) >= len(s.Inputs.InputChains) {
+			return nil, fmt.Errorf("input priority %d is out of range (input chains=%d)", priority, len(s.Inputs.InputChains))
+		}
+		return s.Inputs.InputChains[priority], nil
+	})
+}
+
+// inputPauseReason is a set of reasons to keep the inputs of a fallback
+// priority paused; the priority is resumed only when no reason is left.
+type inputPauseReason uint
+
+const (
+	// inputPauseReasonOperator is a stop via SetStopInput.
+	inputPauseReasonOperator = inputPauseReason(1 << iota)
+
+	// inputPauseReasonNoInputs is that no inputs are left at the priority.
+	inputPauseReasonNoInputs
+
+	// inputPauseReasonUnhealthy is a demotion by InputHealthPolicy.
+	inputPauseReasonUnhealthy
+)
+
+// setInputChainPauseReason is setInputChainPauseReasonLocked which takes the lock.
+func (s *FFStream) setInputChainPauseReason(
+	ctx context.Context,
+	priority uint,
+	reason inputPauseReason,
+	isSet bool,
+) error {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	return s.setInputChainPauseReasonLocked(ctx, priority, reason, isSet)
+}
+
+// setInputChainPauseReasonLocked sets or clears the reason to keep the inputs
+// of the given priority paused, and pauses or resumes them accordingly.
+func (s *FFStream) setInputChainPauseReasonLocked(
+	ctx context.Context,
+	priority uint,
+	reason inputPauseReason,
+	isSet bool,
+) error {
+	oldReasons := s.inputPauseReasons[priority]
+	newReasons := oldReasons &^ reason
+	if isSet {
+		newReasons |= reason
+	}
+	if (oldReasons == 0) != (newReasons == 0) {
+		inputChain, err := s.getInputChain(ctx, priority)
+		if err != nil {
+			return err
+		}
+		if newReasons != 0 {
+			if err := inputChain.Pause(ctx); err != nil {
+				return fmt.Errorf("unable to stop the inputs at priority %d: %w", priority, err)
+			}
+		} else {
+			if err := inputChain.Unpause(ctx); err != nil {
+				return fmt.Errorf("unable to resume the inputs at priority %d: %w", priority, err)
+			}
+		}
+	}
+	if newReasons == 0 {
+		delete(s.inputPauseReasons, priority)
+	} else {
+		s.inputPauseReasons[priority] = newReasons
+	}
+	return nil
+}
+
+// getInputResources returns the inputs of the given fallback priority.
+func (s *FFStream) getInputResources(priority uint) Resources {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	if int(priority) >= len(s.InputsInfo) {
+		return nil
+	}
+	return slices.Clone(s.InputsInfo[priority])
+}
+
+// GetInputKernel returns the opened input number `num` of the given fallback priority.
+func (s *FFStream) GetInputKernel(
+	ctx context.Context,
+	priority uint,
+	num uint,
+) (*kernel.Input, error) {
+	inputs, err := s.GetInputKernels(ctx, priority)
+	if err != nil {
+		return nil, err
+	}
+	if int(num) >= len(inputs) {
+		return nil, fmt.Errorf("input num %d is out of range (inputs=%d)", num, len(inputs))
+	}
+	return inputs[num], nil
+}
+
+// GetInputKernels returns the opened inputs of the given fallback priority.
+func (s *FFStream) GetInputKernels(
+	ctx context.Context,
+	priority uint,
+) ([]*kernel.Input, error) {
+	inputChain, err := s.getInputChain(ctx, priority)
+	if err != nil {
+		return nil, err
+	}
+	k := inputChain.Input.Processor.Kernel
+	// the kernel is locked while the inputs are being opened (which may take
+	// a while, e.g. an SRT listener waits for the caller), so not waiting here
+	if !k.KernelLocker.ManualTryLock(ctx) {
+		return nil, fmt.Errorf("the inputs at priority %d are being opened", priority)
+	}
+	defer k.KernelLocker.ManualUnlock()
+	if k.Kernel == nil {
+		return nil, fmt.Errorf("the inputs at priority %d are not opened", priority)
+	}
+	return slices.Clone(k.Kernel.Kernel0), nil
+}
+
+// IsInputChainOpened returns true if the inputs of the chain are opened;
+// the inputs which are being opened are reported as not opened.
+func IsInputChainOpened(
+	ctx context.Context,
+	inputChain *InputChain,
+) bool {
+	k := inputChain.Input.Processor.Kernel
+	// see GetInputKernels on why not waiting here
+	if !k.KernelLocker.ManualTryLock(ctx) {
+		return false
+	}
+	defer k.KernelLocker.ManualUnlock()
+	return k.KernelIsSet
+}
+
+// isInputChainPaused returns true if the inputs of the given priority
+// are paused for any reason (see inputPauseReason).
+func (s *FFStream) isInputChainPaused(priority uint) bool {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	return s.inputPauseReasons[priority] != 0
+}
+
+// GetActiveInputPriority returns the priority the stream is received from,
+// that is the best (lowest) fallback priority which inputs are opened
+// and not paused.
+func (s *FFStream) GetActiveInputPriority(
+	ctx context.Context,
+) (uint, bool) {
+	var inputChains []*InputChain
+	s.Inputs.InputChainsLocker.Do(ctx, func() {
+		inputChains = append(inputChains, s.Inputs.InputChains...)
+	})
+	for priority, inputChain := range inputChains {
+		if s.isInputChainPaused(uint(priority)) {
+			continue
+		}
+		if IsInputChainOpened(ctx, inputChain) {
+			return uint(priority), true
+		}
+	}
+	return 0, false
+}
+
+// FindInputByID returns the fallback priority and the number of the opened
+// input with the given object ID (see InputInfo.id in the control API).
+func (s *FFStream) FindInputByID(
+	ctx context.Context,
+	id avptypes.ObjectID,
+) (_priority uint, _num uint, _err error) {
+	var inputChains []*InputChain
+	s.Inputs.InputChainsLocker.Do(ctx, func() {
+		inputChains = append(inputChains, s.Inputs.InputChains...)
+	})
+	for priority, inputChain := range inputChains {
+		k := inputChain.Input.Processor.Kernel
+		if !k.KernelLocker.ManualTryLock(ctx) {
+			continue
+		}
+		var (
+			num   int
+			found bool
+		)
+		if k.Kernel != nil {
+			for idx, in := range k.Kernel.Kernel0 {
+				if in.GetObjectID() == id {
+					num, found = idx, true
+					break
+				}
+			}
+		}
+		k.KernelLocker.ManualUnlock()
+		if found {
+			return uint(priority), uint(num), nil
+		}
+	}
+	return 0, 0, fmt.Errorf("input with ID %d not found", id)
+}
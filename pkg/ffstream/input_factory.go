@@ -3,7 +3,9 @@ package ffstream
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/avpipeline/kernel"
@@ -24,6 +26,14 @@ type InputFactory struct {
 	streamIndexNext int
 	streamIndexMap  map[streamIndexKey]int
 	sourceIndex     map[packetorframe.AbstractSource]int
+	inputs          kernel.Tee[*kernel.Input]
+
+	mappedStreamIndexes map[streamIndexKey][]int
+	takenTracks         map[int]struct{}
+	takenPads           map[int]struct{}
+
+	isOpenedBefore bool
+	retry          retryState
 }
 
 type streamIndexKey struct {
@@ -76,6 +86,9 @@ func (f *InputFactory) streamIndexAssignLocked(
 	}
 
 	srcIdx := f.sourceIndex[src]
+	if streamMap := f.FFStream.Config.StreamMap; len(streamMap) > 0 {
+		return f.streamIndexAssignByMapLocked(streamMap, src, srcIdx, streamIdx)
+	}
 	if srcIdx == 0 && streamIdx == 0 {
 		// there are protocols where the order of streams is important,
 		// so we are doing our best to make sure we won't break that,
@@ -94,6 +107,98 @@ func (f *InputFactory) streamIndexAssignLocked(
 	return []int{out}, nil
 }
 
+// streamIndexAssignByMapLocked assigns the output track ID selected by `-map`
+// as the stream index; in addition, a stream used by the `-filter_complex` graph
+// gets the internal stream index of the input pad. Streams selected by neither
+// are dropped.
+func (f *InputFactory) streamIndexAssignByMapLocked(
+	streamMap StreamMap,
+	src packetorframe.AbstractSource,
+	srcIdx int,
+	streamIdx int,
+) ([]int, error) {
+	key := streamIndexKey{Source: src, Index: streamIdx}
+	if out, ok := f.mappedStreamIndexes[key]; ok {
+		return out, nil
+	}
+
+	stream, err := f.getStreamInfoLocked(srcIdx, streamIdx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the info of stream %d of input #%d: %w", streamIdx, srcIdx, err)
+	}
+	out := []int{}
+	trackID, ok := streamMap.FindTrack(stream, func(trackID int) bool {
+		_, ok := f.takenTracks[trackID]
+		return ok
+	})
+	if ok {
+		f.takenTracks[trackID] = struct{}{}
+		out = append(out, trackID)
+	}
+	if filterComplex := f.FFStream.Config.FilterComplex; filterComplex != nil {
+		for padIdx, pad := range filterComplex.Inputs {
+			if _, ok := f.takenPads[padIdx]; ok {
+				continue
+			}
+			if !pad.Selector.Matches(stream) {
+				continue
+			}
+			f.takenPads[padIdx] = struct{}{}
+			out = append(out, streamMap.FilterComplexInputStreamIndex(padIdx))
+		}
+	}
+	f.mappedStreamIndexes[key] = out
+	return out, nil
+}
+
+// getStreamInfoLocked describes the stream for matching it against the selectors.
+func (f *InputFactory) getStreamInfoLocked(
+	srcIdx int,
+	streamIdx int,
+) (StreamInfo, error) {
+	if srcIdx >= len(f.inputs) {
+		return StreamInfo{}, fmt.Errorf("input #%d is out of range (inputs=%d)", srcIdx, len(f.inputs))
+	}
+	fmtCtx := f.inputs[srcIdx].FormatContext
+	if fmtCtx == nil {
+		return StreamInfo{}, fmt.Errorf("input #%d is not opened", srcIdx)
+	}
+	for _, stream := range NewStreamInfos(srcIdx, fmtCtx.Streams()) {
+		if stream.AbsIndex == streamIdx {
+			return stream, nil
+		}
+	}
+	return StreamInfo{}, fmt.Errorf("stream %d not found", streamIdx)
+}
+
+// checkStreamsMatched returns an error if a selector of `-map` or an input
+// pad of `-filter_complex` matches no streams of the given opened inputs.
+func (f *InputFactory) checkStreamsMatched(
+	inputs kernel.Tee[*kernel.Input],
+) error {
+	streamMap := f.FFStream.Config.StreamMap
+	filterComplex := f.FFStream.Config.FilterComplex
+	if len(streamMap) == 0 && filterComplex == nil {
+		return nil
+	}
+	var streams []StreamInfo
+	for idx, in := range inputs {
+		if in.FormatContext == nil {
+			return fmt.Errorf("input #%d is not opened", idx)
+		}
+		streams = append(streams, NewStreamInfos(idx, in.FormatContext.Streams())...)
+	}
+	if err := streamMap.CheckMatched(streams); err != nil {
+		return err
+	}
+	if filterComplex != nil {
+		if err := filterComplex.CheckMatched(streams); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (f *InputFactory) GetResources(
 	ctx context.Context,
 ) (_ret Resources, _err error) {
@@ -105,11 +210,13 @@ func (f *InputFactory) GetResources(
 	if f.FFStream == nil {
 		return nil, fmt.Errorf("FFStream is nil")
 	}
+	f.FFStream.locker.Lock()
+	defer f.FFStream.locker.Unlock()
 	if int(f.FallbackPriority) >= len(f.FFStream.InputsInfo) {
 		return nil, fmt.Errorf("priority %d is out of range (inputs=%d)", f.FallbackPriority, len(f.FFStream.InputsInfo))
 	}
 
-	return f.FFStream.InputsInfo[f.FallbackPriority], nil
+	return slices.Clone(f.FFStream.InputsInfo[f.FallbackPriority]), nil
 }
 
 func (f *InputFactory) NewInput(
@@ -126,7 +233,54 @@ func (f *InputFactory) NewInput(
 		return nil, err
 	}
 	logger.Debugf(ctx, "inputFactory.NewInput(priority=%d): %d resources", f.FallbackPriority, len(resources))
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("no inputs at priority %d", f.FallbackPriority)
+	}
+	if err := f.waitBeforeRetry(ctx); err != nil {
+		return nil, err
+	}
+	f.FFStream.InputRetryCounters.Attempts.Add(1)
+
+	inputs, err := f.FFStream.newInputKernels(ctx, resources)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if _err != nil {
+			for _, in := range inputs {
+				_ = in.Close(ctx)
+			}
+		}
+	}()
+	if err := f.checkStreamsMatched(inputs); err != nil {
+		return nil, fmt.Errorf("the inputs at priority %d: %w", f.FallbackPriority, err)
+	}
+
+	f.Locker.Do(ctx, func() {
+		f.streamIndexNext = 1
+		f.streamIndexMap = make(map[streamIndexKey]int)
+		f.mappedStreamIndexes = make(map[streamIndexKey][]int)
+		f.takenTracks = make(map[int]struct{})
+		f.takenPads = make(map[int]struct{})
+		f.inputs = inputs
+		for k := range f.sourceIndex {
+			delete(f.sourceIndex, k)
+		}
+		for idx, in := range inputs {
+			f.sourceIndex[packetorframe.AbstractSource(in)] = idx
+		}
+		f.retry.reset()
+	})
+	f.FFStream.InputRetryCounters.Successes.Add(1)
+
+	return kernel.NewChainOfTwo(inputs, kernel.NewMapStreamIndices(ctx, f)), nil
+}
 
+// newInputKernels opens the inputs of the given resources.
+func (s *FFStream) newInputKernels(
+	ctx context.Context,
+	resources Resources,
+) (_ret kernel.Tee[*kernel.Input], _err error) {
 	var inputs kernel.Tee[*kernel.Input]
 	defer func() {
 		if _err != nil {
@@ -136,6 +290,13 @@ func (f *InputFactory) NewInput(
 		}
 	}()
 	for _, res := range resources {
+		if IsSlateURL(res.URL) {
+			var err error
+			res, err = s.slateResource(ctx, res)
+			if err != nil {
+				return nil, err
+			}
+		}
 		cfg := kernel.InputConfig{
 			CustomOptions: res.CustomOptions,
 		}
@@ -171,19 +332,57 @@ func (f *InputFactory) NewInput(
 		}
 		inputs = append(inputs, in)
 	}
+	return inputs, nil
+}
 
+// waitBeforeRetry delays reopening the inputs according to
+// Config.InputRetryPolicy (the first opening is not delayed).
+func (f *InputFactory) waitBeforeRetry(
+	ctx context.Context,
+) error {
+	policy, err := f.FFStream.GetRetryPolicy(ctx, RetryTargetInput)
+	if err != nil {
+		return err
+	}
+	var (
+		delay     time.Duration
+		attempt   uint
+		isReopen  bool
+		isAllowed = true
+	)
 	f.Locker.Do(ctx, func() {
-		f.streamIndexNext = 1
-		f.streamIndexMap = make(map[streamIndexKey]int)
-		for k := range f.sourceIndex {
-			delete(f.sourceIndex, k)
+		if !f.isOpenedBefore {
+			f.isOpenedBefore = true
+			return
 		}
-		for idx, in := range inputs {
-			f.sourceIndex[packetorframe.AbstractSource(in)] = idx
+		isReopen = true
+		if policy == nil {
+			return
+		}
+		delay, isAllowed = f.retry.next(*policy, time.Now(), &f.FFStream.InputRetryCounters)
+		attempt = f.retry.attempt
+		if !isAllowed {
+			f.retry.reset()
 		}
 	})
-
-	return kernel.NewChainOfTwo(inputs, kernel.NewMapStreamIndices(ctx, f)), nil
+	if !isAllowed {
+		logger.Errorf(ctx, "the retries of the inputs at priority %d are exhausted (%v)", f.FallbackPriority, policy)
+		return f.FFStream.onRetriesExhausted(ctx, RetryTargetInput, *policy, fmt.Errorf("unable to open the inputs at priority %d", f.FallbackPriority))
+	}
+	if !isReopen {
+		return nil
+	}
+	f.FFStream.publishEvent(ctx, InputReconnectingEvent{
+		Time:     time.Now(),
+		Priority: f.FallbackPriority,
+		Attempt:  attempt,
+		Delay:    delay,
+	})
+	if delay == 0 {
+		return nil
+	}
+	logger.Debugf(ctx, "reopening the inputs at priority %d in %v", f.FallbackPriority, delay)
+	return sleepCtx(ctx, delay)
 }
 
 func (f *InputFactory) NewDecoderFactory(
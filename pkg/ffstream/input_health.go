@@ -0,0 +1,520 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	qualitytypes "github.com/xaionaro-go/avpipeline/packetorframe/filter/quality/types"
+)
+
+// InputHealthCheckInterval is how often the quality of the active inputs
+// is checked against their InputHealthPolicy.
+const InputHealthCheckInterval = time.Second
+
+// inputHealthMaxHoldMultiplier limits how many times the hold of
+// a priority is doubled, see inputHealthState.
+const inputHealthMaxHoldMultiplier = 16
+
+// InputHealthPolicy defines when the inputs of a fallback priority are
+// considered degraded (even though they do not fail), so that the stream
+// falls back to the next priority.
+//
+// The thresholds are checked against the input quality measurements
+// (see GetInputQuality); a zero threshold is not checked.
+type InputHealthPolicy struct {
+	// MinContinuity is the minimal continuity (in range [0, 1]) of the video
+	// track and of the audio track (if it is present).
+	MinContinuity float64
+
+	// MinFrameRate is the minimal frame rate of the video track.
+	MinFrameRate float64
+
+	// MaxInvalidDTS is the maximal amount of invalid DTS of all tracks.
+	MaxInvalidDTS uint
+
+	// MaxSRTLoss is the maximal fraction (in range [0, 1]) of the packets
+	// lost by the SRT inputs since the previous check.
+	MaxSRTLoss float64
+
+	// MaxSRTRTT is the maximal round-trip time of the SRT inputs.
+	MaxSRTRTT time.Duration
+
+	// Window is for how long the thresholds should be violated to demote
+	// the priority. The same time after the priority is activated is not
+	// judged, since the measurements still cover the previous input.
+	Window time.Duration
+
+	// Hysteresis is for how long the inputs of a demoted priority should
+	// be healthy (being measured in the background, while the priority is
+	// stopped) to promote the priority back; it is doubled (up to 16 times)
+	// each time the promoted priority is demoted again before it stays
+	// healthy for Hysteresis.
+	Hysteresis time.Duration
+}
+
+func DefaultInputHealthPolicy() InputHealthPolicy {
+	return InputHealthPolicy{
+		Window:     5 * time.Second,
+		Hysteresis: 30 * time.Second,
+	}
+}
+
+func (p InputHealthPolicy) Validate() error {
+	if p.MinContinuity < 0 || p.MinContinuity > 1 {
+		return fmt.Errorf("the min continuity should be in range [0, 1], but it is %v", p.MinContinuity)
+	}
+	if p.MinFrameRate < 0 {
+		return fmt.Errorf("the min frame rate is negative: %v", p.MinFrameRate)
+	}
+	if p.MaxSRTLoss < 0 || p.MaxSRTLoss > 1 {
+		return fmt.Errorf("the max SRT loss should be in range [0, 1], but it is %v", p.MaxSRTLoss)
+	}
+	if p.MaxSRTRTT < 0 {
+		return fmt.Errorf("the max SRT round-trip time is negative: %v", p.MaxSRTRTT)
+	}
+	if p.MinContinuity == 0 && p.MinFrameRate == 0 && p.MaxInvalidDTS == 0 && p.MaxSRTLoss == 0 && p.MaxSRTRTT == 0 {
+		return fmt.Errorf("no thresholds are set")
+	}
+	if p.Window <= 0 {
+		return fmt.Errorf("the window should be positive, but it is %v", p.Window)
+	}
+	if p.Hysteresis < 0 {
+		return fmt.Errorf("the hysteresis is negative: %v", p.Hysteresis)
+	}
+	return nil
+}
+
+// String returns the policy in the format accepted by ParseInputHealthPolicy.
+func (p InputHealthPolicy) String() string {
+	return fmt.Sprintf(
+		"min_continuity=%v,min_fps=%v,max_invalid_dts=%d,max_srt_loss=%v,max_srt_rtt=%v,window=%v,hysteresis=%v",
+		p.MinContinuity, p.MinFrameRate, p.MaxInvalidDTS, p.MaxSRTLoss, p.MaxSRTRTT, p.Window, p.Hysteresis,
+	)
+}
+
+// ParseInputHealthPolicy parses a comma-separated list of key=value pairs,
+// e.g. "min_continuity=0.9,min_fps=20,max_invalid_dts=10,max_srt_loss=0.05,max_srt_rtt=500ms,window=5s,hysteresis=30s";
+// the omitted keys are taken from DefaultInputHealthPolicy.
+func ParseInputHealthPolicy(s string) (InputHealthPolicy, error) {
+	p := DefaultInputHealthPolicy()
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return InputHealthPolicy{}, fmt.Errorf("expected key=value, got %q", kv)
+		}
+		var err error
+		switch k {
+		case "min_continuity":
+			p.MinContinuity, err = strconv.ParseFloat(v, 64)
+		case "min_fps":
+			p.MinFrameRate, err = strconv.ParseFloat(v, 64)
+		case "max_invalid_dts":
+			var maxInvalidDTS uint64
+			maxInvalidDTS, err = strconv.ParseUint(v, 10, 0)
+			p.MaxInvalidDTS = uint(maxInvalidDTS)
+		case "max_srt_loss":
+			p.MaxSRTLoss, err = strconv.ParseFloat(v, 64)
+		case "max_srt_rtt":
+			p.MaxSRTRTT, err = time.ParseDuration(v)
+		case "window":
+			p.Window, err = time.ParseDuration(v)
+		case "hysteresis":
+			p.Hysteresis, err = time.ParseDuration(v)
+		default:
+			return InputHealthPolicy{}, fmt.Errorf("unknown key %q", k)
+		}
+		if err != nil {
+			return InputHealthPolicy{}, fmt.Errorf("unable to parse the value of %q: %w", k, err)
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return InputHealthPolicy{}, err
+	}
+	return p, nil
+}
+
+// Check returns the violated thresholds, or nil if the quality is healthy.
+func (p InputHealthPolicy) Check(
+	video qualitytypes.StreamQuality,
+	audio qualitytypes.StreamQuality,
+) error {
+	var errs []error
+	if p.MinFrameRate > 0 && video.FrameRate < p.MinFrameRate {
+		errs = append(errs, fmt.Errorf("the video frame rate %.2f is below %v", video.FrameRate, p.MinFrameRate))
+	}
+	if p.MinContinuity > 0 {
+		if video.Continuity < p.MinContinuity {
+			errs = append(errs, fmt.Errorf("the video continuity %.3f is below %v", video.Continuity, p.MinContinuity))
+		}
+		if audio.FrameRate > 0 && audio.Continuity < p.MinContinuity {
+			errs = append(errs, fmt.Errorf("the audio continuity %.3f is below %v", audio.Continuity, p.MinContinuity))
+		}
+	}
+	if invalidDTS := video.InvalidDTS + audio.InvalidDTS; p.MaxInvalidDTS > 0 && invalidDTS > p.MaxInvalidDTS {
+		errs = append(errs, fmt.Errorf("the amount of invalid DTS %d is above %d", invalidDTS, p.MaxInvalidDTS))
+	}
+	return errors.Join(errs...)
+}
+
+// InputSRTStats are the link statistics of the SRT inputs of a fallback
+// priority between two health checks.
+type InputSRTStats struct {
+	PacketsReceived uint64
+	PacketsLost     uint64
+
+	// RTT is the highest round-trip time of the inputs.
+	RTT time.Duration
+}
+
+// CheckSRT returns the violated SRT thresholds, or nil if the link is healthy.
+func (p InputHealthPolicy) CheckSRT(stats InputSRTStats) error {
+	var errs []error
+	if total := stats.PacketsReceived + stats.PacketsLost; p.MaxSRTLoss > 0 && total > 0 {
+		if loss := float64(stats.PacketsLost) / float64(total); loss > p.MaxSRTLoss {
+			errs = append(errs, fmt.Errorf("the SRT packet loss %.3f is above %v", loss, p.MaxSRTLoss))
+		}
+	}
+	if p.MaxSRTRTT > 0 && stats.RTT > p.MaxSRTRTT {
+		errs = append(errs, fmt.Errorf("the SRT round-trip time %v is above %v", stats.RTT, p.MaxSRTRTT))
+	}
+	return errors.Join(errs...)
+}
+
+// inputSRTTotals are the total counters of the SRT inputs of a fallback
+// priority, see getInputSRTTotals.
+type inputSRTTotals struct {
+	Priority        uint
+	PacketsReceived uint64
+	PacketsLost     uint64
+	RTT             time.Duration
+}
+
+// since returns the statistics since the previous counters (prev may be nil).
+func (totals inputSRTTotals) since(prev *inputSRTTotals) InputSRTStats {
+	stats := InputSRTStats{RTT: totals.RTT}
+	if prev != nil && prev.Priority == totals.Priority &&
+		totals.PacketsReceived >= prev.PacketsReceived && totals.PacketsLost >= prev.PacketsLost {
+		// otherwise the inputs are reopened, so the loss is judged on the next check
+		stats.PacketsReceived = totals.PacketsReceived - prev.PacketsReceived
+		stats.PacketsLost = totals.PacketsLost - prev.PacketsLost
+	}
+	return stats
+}
+
+// GetHealthPolicy returns the health policy of the input (option
+// "health_policy", see ParseInputHealthPolicy), or nil if it is not set.
+func (r Resource) GetHealthPolicy() (*InputHealthPolicy, error) {
+	for _, item := range r.CustomOptions {
+		if item.Key != "health_policy" {
+			continue
+		}
+		p, err := ParseInputHealthPolicy(item.Value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the health policy %q: %w", item.Value, err)
+		}
+		return &p, nil
+	}
+	return nil, nil
+}
+
+// getInputHealthPolicy returns the health policy of the fallback priority:
+// the one of the first input of the priority that has it.
+func (s *FFStream) getInputHealthPolicy(
+	ctx context.Context,
+	priority uint,
+) *InputHealthPolicy {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	if int(priority) >= len(s.InputsInfo) {
+		return nil
+	}
+	for _, res := range s.InputsInfo[priority] {
+		p, err := res.GetHealthPolicy()
+		if err != nil {
+			logger.Errorf(ctx, "%v", err)
+			continue
+		}
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// inputHealthState is the state of the quality-based failover of the inputs,
+// see watchInputHealth.
+type inputHealthState struct {
+	// priority is the active priority since activatedAt.
+	priority    uint
+	isActive    bool
+	activatedAt time.Time
+
+	// unhealthySince and healthySince are the beginnings of the current
+	// unhealthy or healthy streak of the active priority.
+	unhealthySince time.Time
+	healthySince   time.Time
+
+	// demoted are the demoted priorities.
+	demoted map[uint]*inputDemotion
+
+	// demotions counts the consecutive demotions of each priority, it is reset
+	// when the priority stays healthy for InputHealthPolicy.Hysteresis.
+	demotions map[uint]uint
+
+	// srtTotals are the SRT counters of the previous check.
+	srtTotals *inputSRTTotals
+}
+
+// inputDemotion is a demoted priority, which inputs are measured
+// in the background (see inputHealthProbe) while the priority is stopped.
+type inputDemotion struct {
+	// hold is for how long the inputs should be healthy to promote the priority.
+	hold time.Duration
+
+	// healthySince is the beginning of the current healthy streak of the inputs.
+	healthySince time.Time
+
+	probe *inputHealthProbe
+}
+
+func newInputHealthState() *inputHealthState {
+	return &inputHealthState{
+		demoted:   map[uint]*inputDemotion{},
+		demotions: map[uint]uint{},
+	}
+}
+
+// observe is called on each check of the active priority with the result of
+// InputHealthPolicy.Check; it returns true if the priority should be demoted.
+func (st *inputHealthState) observe(
+	now time.Time,
+	priority uint,
+	policy InputHealthPolicy,
+	unhealthy error,
+) bool {
+	if !st.isActive || st.priority != priority {
+		st.priority = priority
+		st.isActive = true
+		st.activatedAt = now
+		st.unhealthySince = time.Time{}
+		st.healthySince = time.Time{}
+		return false
+	}
+	if now.Sub(st.activatedAt) < policy.Window {
+		return false
+	}
+	if unhealthy == nil {
+		st.unhealthySince = time.Time{}
+		if st.healthySince.IsZero() {
+			st.healthySince = now
+		}
+		if now.Sub(st.healthySince) >= policy.Hysteresis {
+			delete(st.demotions, priority)
+		}
+		return false
+	}
+	st.healthySince = time.Time{}
+	if st.unhealthySince.IsZero() {
+		st.unhealthySince = now
+	}
+	return now.Sub(st.unhealthySince) >= policy.Window
+}
+
+// noActivePriority is called when no priority is active.
+func (st *inputHealthState) noActivePriority() {
+	st.isActive = false
+}
+
+// demote records the demotion of the priority and returns for how long
+// its inputs should be healthy to promote it.
+func (st *inputHealthState) demote(
+	priority uint,
+	policy InputHealthPolicy,
+	probe *inputHealthProbe,
+) time.Duration {
+	multiplier := time.Duration(1)
+	for i := uint(0); i < st.demotions[priority] && multiplier < inputHealthMaxHoldMultiplier; i++ {
+		multiplier *= 2
+	}
+	st.demotions[priority]++
+	hold := policy.Hysteresis * multiplier
+	st.demoted[priority] = &inputDemotion{
+		hold:  hold,
+		probe: probe,
+	}
+	st.isActive = false
+	return hold
+}
+
+// observeDemoted is called on each check of a demoted priority with
+// the result of the check of its inputs measured in the background;
+// it returns true if the priority should be promoted.
+func (st *inputHealthState) observeDemoted(
+	now time.Time,
+	priority uint,
+	unhealthy error,
+) bool {
+	d, ok := st.demoted[priority]
+	if !ok {
+		return false
+	}
+	if unhealthy != nil {
+		d.healthySince = time.Time{}
+		return false
+	}
+	if d.healthySince.IsZero() {
+		d.healthySince = now
+	}
+	if now.Sub(d.healthySince) < d.hold {
+		return false
+	}
+	delete(st.demoted, priority)
+	return true
+}
+
+// demotedPriorities returns the demoted priorities in ascending order.
+func (st *inputHealthState) demotedPriorities() []uint {
+	return slices.Sorted(maps.Keys(st.demoted))
+}
+
+// watchInputHealth demotes the active priority of inputs if its quality violates
+// its InputHealthPolicy, and promotes it back once its inputs measured in
+// the background stay healthy for the hysteresis, until ctx is cancelled.
+func (s *FFStream) watchInputHealth(ctx context.Context) {
+	t := time.NewTicker(InputHealthCheckInterval)
+	defer t.Stop()
+	st := newInputHealthState()
+	defer func() {
+		for _, d := range st.demoted {
+			d.probe.Close()
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		s.checkInputHealth(ctx, st, time.Now())
+	}
+}
+
+func (s *FFStream) checkInputHealth(
+	ctx context.Context,
+	st *inputHealthState,
+	now time.Time,
+) {
+	for _, priority := range st.demotedPriorities() {
+		s.checkDemotedInputHealth(ctx, st, priority, now)
+	}
+
+	priority, ok := s.GetActiveInputPriority(ctx)
+	if !ok {
+		st.noActivePriority()
+		return
+	}
+	policy := s.getInputHealthPolicy(ctx, priority)
+	if policy == nil {
+		st.noActivePriority()
+		return
+	}
+	if s.Inputs.GetInputChainsCount(ctx) <= int(priority)+1 {
+		// there is nothing to fall back to
+		st.noActivePriority()
+		return
+	}
+
+	q, err := s.GetInputQuality(ctx)
+	if err != nil {
+		logger.Debugf(ctx, "unable to check the health of the inputs at priority %d: %v", priority, err)
+		return
+	}
+	unhealthy := errors.Join(
+		policy.Check(q.Video, q.Audio),
+		s.checkInputSRTHealth(ctx, st, priority, *policy),
+	)
+	if !st.observe(now, priority, *policy, unhealthy) {
+		return
+	}
+
+	if err := s.setInputChainPauseReason(ctx, priority, inputPauseReasonUnhealthy, true); err != nil {
+		logger.Errorf(ctx, "unable to demote the inputs at priority %d: %v", priority, err)
+		return
+	}
+	// the inputs are opened by the probe only after the chain releases them
+	// (e.g. the port of a listener)
+	hold := st.demote(priority, *policy, s.newInputHealthProbe(ctx, priority))
+	logger.Warnf(ctx, "demoted the inputs at priority %d until they are healthy for %v: %v", priority, hold, unhealthy)
+	s.publishEvent(ctx, InputDemotedEvent{
+		Time:     now,
+		Priority: priority,
+		Hold:     hold,
+		Reason:   unhealthy,
+	})
+}
+
+// checkInputSRTHealth checks the SRT links of the inputs of the active
+// priority since the previous check against the policy.
+func (s *FFStream) checkInputSRTHealth(
+	ctx context.Context,
+	st *inputHealthState,
+	priority uint,
+	policy InputHealthPolicy,
+) error {
+	if policy.MaxSRTLoss == 0 && policy.MaxSRTRTT == 0 {
+		st.srtTotals = nil
+		return nil
+	}
+	totals, ok := s.getInputSRTTotals(ctx, priority)
+	if !ok {
+		st.srtTotals = nil
+		return nil
+	}
+	prev := st.srtTotals
+	st.srtTotals = &totals
+	return policy.CheckSRT(totals.since(prev))
+}
+
+// checkDemotedInputHealth checks the inputs of the demoted priority measured
+// in the background, and promotes the priority if they stay healthy long enough.
+func (s *FFStream) checkDemotedInputHealth(
+	ctx context.Context,
+	st *inputHealthState,
+	priority uint,
+	now time.Time,
+) {
+	d := st.demoted[priority]
+	var unhealthy error
+	if policy := s.getInputHealthPolicy(ctx, priority); policy != nil {
+		var isJudged bool
+		isJudged, unhealthy = d.probe.Check(ctx, now, *policy)
+		if !isJudged {
+			return
+		}
+	}
+	if unhealthy != nil {
+		logger.Debugf(ctx, "the demoted inputs at priority %d are still unhealthy: %v", priority, unhealthy)
+	}
+	if !st.observeDemoted(now, priority, unhealthy) {
+		return
+	}
+
+	d.probe.Close()
+	logger.Infof(ctx, "promoting the demoted inputs at priority %d, since they are healthy for %v", priority, d.hold)
+	if err := s.setInputChainPauseReason(ctx, priority, inputPauseReasonUnhealthy, false); err != nil {
+		logger.Errorf(ctx, "unable to promote the inputs at priority %d: %v", priority, err)
+		return
+	}
+	s.publishEvent(ctx, InputPromotedEvent{Time: now, Priority: priority})
+}
@@ -0,0 +1,199 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/packet"
+	"github.com/xaionaro-go/avpipeline/packetorframe"
+	"github.com/xaionaro-go/avpipeline/packetorframe/filter/quality"
+	"github.com/xaionaro-go/observability"
+)
+
+const (
+	// inputHealthProbeRetryInterval is the delay before reopening
+	// the inputs of a probe after they failed.
+	inputHealthProbeRetryInterval = 5 * time.Second
+
+	// inputHealthProbeQueueSize is the size of the queue of the packets read by a probe.
+	inputHealthProbeQueueSize = 64
+)
+
+// inputHealthProbe measures the inputs of a demoted fallback priority in
+// the background, while the priority itself is stopped: it opens the same
+// resources and observes their packets by its own quality measurements.
+type inputHealthProbe struct {
+	Priority uint
+
+	cancelFn context.CancelFunc
+	done     chan struct{}
+
+	locker       sync.Mutex
+	measurements *quality.Measurements
+	startedAt    time.Time
+	err          error
+
+	// getSRTTotals returns the SRT counters of the opened inputs (nil
+	// while they are not opened), and srtTotals are the ones of the previous check.
+	getSRTTotals func(ctx context.Context) (inputSRTTotals, bool)
+	srtTotals    *inputSRTTotals
+}
+
+func (s *FFStream) newInputHealthProbe(
+	ctx context.Context,
+	priority uint,
+) *inputHealthProbe {
+	ctx, cancelFn := context.WithCancel(ctx)
+	p := &inputHealthProbe{
+		Priority: priority,
+		cancelFn: cancelFn,
+		done:     make(chan struct{}),
+	}
+	observability.Go(ctx, func(ctx context.Context) {
+		defer close(p.done)
+		s.runInputHealthProbe(ctx, p)
+	})
+	return p
+}
+
+// Close stops the probe and waits until its inputs are closed.
+func (p *inputHealthProbe) Close() {
+	p.cancelFn()
+	<-p.done
+}
+
+// Check checks the measurements and the SRT links of the inputs against
+// the policy; it returns false if the inputs are not judged yet, that is
+// they are not opened yet or measured for less than InputHealthPolicy.Window.
+func (p *inputHealthProbe) Check(
+	ctx context.Context,
+	now time.Time,
+	policy InputHealthPolicy,
+) (bool, error) {
+	p.locker.Lock()
+	measurements, startedAt, err := p.measurements, p.startedAt, p.err
+	// the inputs are not closed while the lock is held
+	srtUnhealthy := p.checkSRTLocked(ctx, policy)
+	p.locker.Unlock()
+	if err != nil {
+		return true, fmt.Errorf("unable to measure the inputs: %w", err)
+	}
+	if measurements == nil || now.Sub(startedAt) < policy.Window {
+		return false, nil
+	}
+	if srtUnhealthy != nil {
+		return true, srtUnhealthy
+	}
+	q, err := measurements.GetQuality(ctx)
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the quality of the probed inputs at priority %d: %v", p.Priority, err)
+		return false, nil
+	}
+	agg := q.Aggregate()
+	return true, policy.Check(agg.Video, agg.Audio)
+}
+
+// checkSRTLocked checks the SRT links of the inputs since the previous
+// check against the policy, the same way as FFStream.checkInputSRTHealth.
+func (p *inputHealthProbe) checkSRTLocked(
+	ctx context.Context,
+	policy InputHealthPolicy,
+) error {
+	if (policy.MaxSRTLoss == 0 && policy.MaxSRTRTT == 0) || p.getSRTTotals == nil {
+		p.srtTotals = nil
+		return nil
+	}
+	totals, ok := p.getSRTTotals(ctx)
+	if !ok {
+		p.srtTotals = nil
+		return nil
+	}
+	prev := p.srtTotals
+	p.srtTotals = &totals
+	return policy.CheckSRT(totals.since(prev))
+}
+
+func (s *FFStream) runInputHealthProbe(
+	ctx context.Context,
+	p *inputHealthProbe,
+) {
+	for {
+		err := s.runInputHealthProbeOnce(ctx, p)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Debugf(ctx, "the probe of the inputs at priority %d is interrupted: %v", p.Priority, err)
+		p.locker.Lock()
+		p.err = err
+		p.locker.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(inputHealthProbeRetryInterval):
+		}
+	}
+}
+
+// runInputHealthProbeOnce opens the inputs and measures them until
+// they fail or ctx is cancelled.
+func (s *FFStream) runInputHealthProbeOnce(
+	ctx context.Context,
+	p *inputHealthProbe,
+) error {
+	resources := s.getInputResources(p.Priority)
+	if len(resources) == 0 {
+		return fmt.Errorf("no inputs at priority %d", p.Priority)
+	}
+	inputs, err := s.newInputKernels(ctx, resources)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		p.locker.Lock()
+		p.getSRTTotals = nil
+		p.locker.Unlock()
+		for _, in := range inputs {
+			if err := in.Close(ctx); err != nil {
+				logger.Debugf(ctx, "unable to close the probed input %s: %v", in, err)
+			}
+		}
+	}()
+
+	measurements := quality.NewMeasurements()
+	p.locker.Lock()
+	p.measurements, p.startedAt, p.err = measurements, time.Now(), nil
+	p.getSRTTotals = func(ctx context.Context) (inputSRTTotals, bool) {
+		return getInputKernelsSRTTotals(ctx, p.Priority, inputs)
+	}
+	p.locker.Unlock()
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+	outputCh := make(chan packetorframe.OutputUnion, inputHealthProbeQueueSize)
+	errCh := make(chan error, len(inputs))
+	for _, in := range inputs {
+		observability.Go(ctx, func(ctx context.Context) {
+			errCh <- in.Generate(ctx, outputCh)
+		})
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errCh:
+			if err != nil {
+				return fmt.Errorf("unable to read the inputs: %w", err)
+			}
+		case out := <-outputCh:
+			if out.Packet == nil {
+				continue
+			}
+			in := packet.BuildInput(out.Packet.Packet, out.Packet.StreamInfo)
+			measurements.ObservePacketOrFrame(ctx, packetorframe.InputUnion{Packet: &in})
+			out.Packet.Packet.Free()
+		}
+	}
+}
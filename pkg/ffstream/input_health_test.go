@@ -0,0 +1,250 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/avpipeline/packetorframe/filter/quality"
+	qualitytypes "github.com/xaionaro-go/avpipeline/packetorframe/filter/quality/types"
+)
+
+func TestParseInputHealthPolicy(t *testing.T) {
+	p, err := ParseInputHealthPolicy("min_continuity=0.9,min_fps=20,max_invalid_dts=10,max_srt_loss=0.05,max_srt_rtt=500ms,window=3s,hysteresis=1m")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := InputHealthPolicy{
+		MinContinuity: 0.9,
+		MinFrameRate:  20,
+		MaxInvalidDTS: 10,
+		MaxSRTLoss:    0.05,
+		MaxSRTRTT:     500 * time.Millisecond,
+		Window:        3 * time.Second,
+		Hysteresis:    time.Minute,
+	}
+	if p != want {
+		t.Fatalf("expected %#v, got %#v", want, p)
+	}
+	p2, err := ParseInputHealthPolicy(p.String())
+	if err != nil {
+		t.Fatalf("unable to parse the result of String(): %v", err)
+	}
+	if p2 != p {
+		t.Fatalf("expected %#v, got %#v", p, p2)
+	}
+
+	p, err = ParseInputHealthPolicy("min_fps=15")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = DefaultInputHealthPolicy()
+	want.MinFrameRate = 15
+	if p != want {
+		t.Fatalf("expected %#v, got %#v", want, p)
+	}
+
+	for _, in := range []string{"", "x", "min_fps=x", "foo=1", "min_continuity=1.5", "min_fps=-1", "min_fps=10,window=0s", "min_fps=10,hysteresis=-1s", "max_srt_loss=1.5", "max_srt_rtt=-1s"} {
+		t.Run("invalid_"+in, func(t *testing.T) {
+			if _, err := ParseInputHealthPolicy(in); err == nil {
+				t.Fatalf("expected an error for %q", in)
+			}
+		})
+	}
+}
+
+func TestInputHealthPolicyCheck(t *testing.T) {
+	p := InputHealthPolicy{
+		MinContinuity: 0.9,
+		MinFrameRate:  20,
+		MaxInvalidDTS: 10,
+		Window:        time.Second,
+	}
+	healthy := qualitytypes.StreamQuality{Continuity: 1, FrameRate: 30}
+	for _, tc := range []struct {
+		name        string
+		video       qualitytypes.StreamQuality
+		audio       qualitytypes.StreamQuality
+		isUnhealthy bool
+	}{
+		{"healthy", healthy, healthy, false},
+		{"no_audio", healthy, qualitytypes.StreamQuality{}, false},
+		{"low_fps", qualitytypes.StreamQuality{Continuity: 1, FrameRate: 3}, healthy, true},
+		{"video_gaps", qualitytypes.StreamQuality{Continuity: 0.5, FrameRate: 30}, healthy, true},
+		{"audio_gaps", healthy, qualitytypes.StreamQuality{Continuity: 0.5, FrameRate: 50}, true},
+		{"invalid_dts", qualitytypes.StreamQuality{Continuity: 1, FrameRate: 30, InvalidDTS: 6}, qualitytypes.StreamQuality{Continuity: 1, FrameRate: 50, InvalidDTS: 5}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := p.Check(tc.video, tc.audio)
+			if (err != nil) != tc.isUnhealthy {
+				t.Fatalf("expected unhealthy=%v, got %v", tc.isUnhealthy, err)
+			}
+		})
+	}
+}
+
+func TestInputHealthPolicyCheckSRT(t *testing.T) {
+	p := InputHealthPolicy{
+		MaxSRTLoss: 0.1,
+		MaxSRTRTT:  time.Second,
+		Window:     time.Second,
+	}
+	for _, tc := range []struct {
+		name        string
+		stats       InputSRTStats
+		isUnhealthy bool
+	}{
+		{"healthy", InputSRTStats{PacketsReceived: 1000, PacketsLost: 10, RTT: 100 * time.Millisecond}, false},
+		{"no_packets", InputSRTStats{}, false},
+		{"lossy", InputSRTStats{PacketsReceived: 800, PacketsLost: 200}, true},
+		{"slow", InputSRTStats{PacketsReceived: 1000, RTT: 2 * time.Second}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := p.CheckSRT(tc.stats)
+			if (err != nil) != tc.isUnhealthy {
+				t.Fatalf("expected unhealthy=%v, got %v", tc.isUnhealthy, err)
+			}
+		})
+	}
+}
+
+func TestInputHealthState(t *testing.T) {
+	p := InputHealthPolicy{
+		MinFrameRate: 20,
+		Window:       2 * time.Second,
+		Hysteresis:   10 * time.Second,
+	}
+	unhealthy := errors.New("unhealthy")
+	start := time.Unix(1000, 0)
+	at := func(sec int) time.Time {
+		return start.Add(time.Duration(sec) * time.Second)
+	}
+
+	st := newInputHealthState()
+	for sec := 0; sec <= 3; sec++ {
+		// the activation (at 0s), the grace window, and the beginning of the unhealthy streak
+		if st.observe(at(sec), 0, p, unhealthy) {
+			t.Fatalf("unexpected demotion at %ds", sec)
+		}
+	}
+	if st.observe(at(4), 0, p, nil) {
+		t.Fatalf("unexpected demotion of a healthy priority")
+	}
+	for sec := 5; sec <= 6; sec++ {
+		if st.observe(at(sec), 0, p, unhealthy) {
+			t.Fatalf("unexpected demotion at %ds", sec)
+		}
+	}
+	if !st.observe(at(7), 0, p, unhealthy) {
+		t.Fatalf("expected a demotion after the window")
+	}
+	if hold := st.demote(0, p, nil); hold != p.Hysteresis {
+		t.Fatalf("expected the hold %v, got %v", p.Hysteresis, hold)
+	}
+	if got := st.demotedPriorities(); !slices.Equal(got, []uint{0}) {
+		t.Fatalf("expected priority 0 to be demoted, got %v", got)
+	}
+
+	// the demoted priority is promoted only after it is measured healthy for the hold
+	if st.observeDemoted(at(8), 0, nil) || st.observeDemoted(at(12), 0, unhealthy) {
+		t.Fatalf("unexpected promotion of an unhealthy priority")
+	}
+	for sec := 13; sec < 23; sec++ {
+		if st.observeDemoted(at(sec), 0, nil) {
+			t.Fatalf("unexpected promotion at %ds", sec)
+		}
+	}
+	if !st.observeDemoted(at(23), 0, nil) {
+		t.Fatalf("expected a promotion after the hold")
+	}
+	if got := st.demotedPriorities(); len(got) != 0 {
+		t.Fatalf("expected no demoted priorities, got %v", got)
+	}
+
+	// the promoted priority is still unhealthy: the hold is doubled
+	st.observe(at(23), 1, p, nil)
+	st.observe(at(24), 0, p, unhealthy)
+	for sec := 25; sec <= 27; sec++ {
+		st.observe(at(sec), 0, p, unhealthy)
+	}
+	if !st.observe(at(28), 0, p, unhealthy) {
+		t.Fatalf("expected a demotion of the promoted priority")
+	}
+	if hold := st.demote(0, p, nil); hold != 2*p.Hysteresis {
+		t.Fatalf("expected the hold %v, got %v", 2*p.Hysteresis, hold)
+	}
+	for sec := 29; sec < 49; sec++ {
+		if st.observeDemoted(at(sec), 0, nil) {
+			t.Fatalf("unexpected promotion at %ds", sec)
+		}
+	}
+	if !st.observeDemoted(at(49), 0, nil) {
+		t.Fatalf("expected a promotion after the doubled hold")
+	}
+
+	// the promoted priority stays healthy for the hysteresis: the hold is reset
+	for sec := 49; sec <= 70; sec++ {
+		if st.observe(at(sec), 0, p, nil) {
+			t.Fatalf("unexpected demotion at %ds", sec)
+		}
+	}
+	if hold := st.demote(0, p, nil); hold != p.Hysteresis {
+		t.Fatalf("expected the hold %v, got %v", p.Hysteresis, hold)
+	}
+
+	for i := 0; i < 10; i++ {
+		st.demote(1, p, nil)
+	}
+	if hold := st.demote(1, p, nil); hold != inputHealthMaxHoldMultiplier*p.Hysteresis {
+		t.Fatalf("expected the hold %v, got %v", inputHealthMaxHoldMultiplier*p.Hysteresis, hold)
+	}
+}
+
+func TestInputHealthProbeCheckSRT(t *testing.T) {
+	ctx := context.Background()
+	p := InputHealthPolicy{
+		MaxSRTLoss: 0.1,
+		Window:     time.Second,
+		Hysteresis: 10 * time.Second,
+	}
+	start := time.Unix(1000, 0)
+	at := func(sec int) time.Time {
+		return start.Add(time.Duration(sec) * time.Second)
+	}
+
+	totals := inputSRTTotals{}
+	probe := &inputHealthProbe{
+		measurements: quality.NewMeasurements(),
+		startedAt:    start,
+		getSRTTotals: func(ctx context.Context) (inputSRTTotals, bool) {
+			return totals, true
+		},
+	}
+	st := newInputHealthState()
+	st.demote(0, p, probe)
+
+	// the link of the demoted inputs keeps losing 20% of the packets
+	for sec := 1; sec <= 30; sec++ {
+		totals.PacketsReceived += 800
+		totals.PacketsLost += 200
+		isJudged, unhealthy := probe.Check(ctx, at(sec), p)
+		if sec == 1 {
+			// no previous counters to judge the loss by
+			continue
+		}
+		if !isJudged || unhealthy == nil {
+			t.Fatalf("expected the lossy link to be judged unhealthy at %ds, got %v and %v", sec, isJudged, unhealthy)
+		}
+		if st.observeDemoted(at(sec), 0, unhealthy) {
+			t.Fatalf("unexpected promotion of the lossy inputs at %ds", sec)
+		}
+	}
+
+	// the inputs are reopened: the counters are reset, the loss is judged on the next check
+	totals = inputSRTTotals{PacketsReceived: 1000}
+	if isJudged, unhealthy := probe.Check(ctx, at(31), p); isJudged && unhealthy != nil {
+		t.Fatalf("unexpected unhealthy link after the counters are reset: %v", unhealthy)
+	}
+}
@@ -0,0 +1,679 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/codec"
+	codectypes "github.com/xaionaro-go/avpipeline/codec/types"
+	"github.com/xaionaro-go/avpipeline/frame"
+	"github.com/xaionaro-go/avpipeline/packetorframe"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+)
+
+// MissingTrackThreshold is for how long (in the stream time) a track may
+// have no frames while the other track has them, before the track is
+// considered missing and is filled, see MissingTrackFill.
+const MissingTrackThreshold = time.Second
+
+// missingTrackMaxGap limits how far behind the other track a filled track
+// may be; a larger gap (e.g. a jump of the timestamps) is skipped instead
+// of being filled by a burst of frames.
+const missingTrackMaxGap = 10 * time.Second
+
+// missingTrackAudioFrameSamples is the amount of samples per frame of
+// a synthesized audio track.
+const missingTrackAudioFrameSamples = 1024
+
+var nanosecondTimeBase = astiav.NewRational(1, int(time.Second))
+
+// MissingTrackFill defines whether and how the missing video or audio
+// track of the output is synthesized, since many ingest servers refuse
+// (or drop) a stream without both tracks.
+//
+// A track is missing if the active inputs have no such track at all
+// (e.g. a screen capture without audio), or if it disappeared (e.g. after
+// a fallback switch). The synthesized frames continue the timestamps
+// of the track and follow the timestamps of the other track; when the track
+// comes back, its real frames overlapping the synthesized ones are dropped.
+// The filling has effect only on transcoded tracks, and the synthesized frames
+// are not passed through the `-vf`/`-af` filters.
+//
+// If both the tracks are transcoded, an output does not write its header
+// until it has both of them, so a track missing from the start appears
+// in the output (with the start delayed by MissingTrackThreshold).
+type MissingTrackFill int
+
+const (
+	UndefinedMissingTrackFill = MissingTrackFill(iota)
+
+	// MissingTrackFillNone means the missing tracks are not filled.
+	MissingTrackFillNone
+
+	// MissingTrackFillBlack fills a missing audio track with silence
+	// and a missing video track with black frames.
+	MissingTrackFillBlack
+
+	// MissingTrackFillFreeze is the same as MissingTrackFillBlack, but
+	// a video track is filled with its last frame (if there was any).
+	MissingTrackFillFreeze
+
+	EndOfMissingTrackFill
+)
+
+func (f MissingTrackFill) String() string {
+	switch f {
+	case UndefinedMissingTrackFill:
+		return "<undefined>"
+	case MissingTrackFillNone:
+		return "none"
+	case MissingTrackFillBlack:
+		return "black"
+	case MissingTrackFillFreeze:
+		return "freeze"
+	default:
+		return fmt.Sprintf("<unknown_%d>", int(f))
+	}
+}
+
+// MissingTrackFillFromString is the reverse of MissingTrackFill.String;
+// it returns UndefinedMissingTrackFill if the string is not recognized.
+func MissingTrackFillFromString(s string) MissingTrackFill {
+	for f := UndefinedMissingTrackFill + 1; f < EndOfMissingTrackFill; f++ {
+		if f.String() == s {
+			return f
+		}
+	}
+	return UndefinedMissingTrackFill
+}
+
+// trackTimeline follows the timestamps (in the stream time) of a track to
+// detect when it falls behind the other track, see missingTracksFiller.
+type trackTimeline struct {
+	// isStarted is true if the track has any frames, real or synthesized.
+	isStarted bool
+
+	// realEnd is the end of the last real frame, if isSeen.
+	isSeen  bool
+	realEnd time.Duration
+
+	// end is the end of the last frame, real or synthesized.
+	end time.Duration
+
+	// isFilling is true if the track is being synthesized.
+	isFilling bool
+
+	// isSynthesizedLast is true if the last frame (ending at end) is
+	// synthesized: the real frames starting before it are dropped.
+	isSynthesizedLast bool
+}
+
+// observe is called on each real frame of the track; it returns false
+// if the frame overlaps the synthesized frames (and should be dropped),
+// and whether the track was being synthesized till now.
+func (tl *trackTimeline) observe(start, end time.Duration) (isPassed, wasFilling bool) {
+	if end < start {
+		end = start
+	}
+	if !tl.isSeen || end > tl.realEnd {
+		tl.realEnd = end
+	}
+	tl.isSeen = true
+	wasFilling = tl.isFilling
+	tl.isFilling = false
+	if tl.isSynthesizedLast && start < tl.end {
+		return false, wasFilling
+	}
+	tl.isSynthesizedLast = false
+	if !tl.isStarted || end > tl.end {
+		tl.end = end
+	}
+	tl.isStarted = true
+	return true, wasFilling
+}
+
+// fill returns the timestamps of the frames (of the given duration) to be
+// synthesized for the track to catch up with `now`, the end of the latest
+// frame of the other track; a track without any frames yet starts at `origin`,
+// the beginning of the first frame of the other track.
+func (tl *trackTimeline) fill(
+	origin time.Duration,
+	now time.Duration,
+	frameDuration time.Duration,
+) []time.Duration {
+	if frameDuration <= 0 {
+		return nil
+	}
+	lastReal := origin
+	if tl.isSeen {
+		lastReal = tl.realEnd
+	}
+	if !tl.isFilling && now-lastReal < MissingTrackThreshold {
+		return nil
+	}
+	if !tl.isStarted {
+		tl.end = origin
+		tl.isStarted = true
+	}
+	if now-tl.end > missingTrackMaxGap {
+		tl.end = now - MissingTrackThreshold
+	}
+	tl.isFilling = true
+	var result []time.Duration
+	for ; tl.end < now; tl.end += frameDuration {
+		result = append(result, tl.end)
+	}
+	if len(result) > 0 {
+		tl.isSynthesizedLast = true
+	}
+	return result
+}
+
+// missingTrackDefaults are the parameters of the synthesized frames of
+// a track that had no real frames, taken from the transcoder config.
+type missingTrackDefaults struct {
+	Width      uint32
+	Height     uint32
+	FrameRate  avptypes.Rational
+	SampleRate uint32
+
+	// IsVideoTranscoded and IsAudioTranscoded are false for the tracks
+	// that are copied: there are no frames of them to follow.
+	IsVideoTranscoded bool
+	IsAudioTranscoded bool
+}
+
+func missingTrackDefaultsFromTranscoderConfig(
+	cfg streammuxtypes.TranscoderConfig,
+) missingTrackDefaults {
+	width, height, frameRate, sampleRate := encoderParams(cfg)
+	d := missingTrackDefaults{
+		Width:      width,
+		Height:     height,
+		FrameRate:  frameRate,
+		SampleRate: sampleRate,
+	}
+	if len(cfg.Output.VideoTrackConfigs) > 0 {
+		d.IsVideoTranscoded = cfg.Output.VideoTrackConfigs[0].CodecName != codectypes.Name(codec.NameCopy)
+	}
+	if len(cfg.Output.AudioTrackConfigs) > 0 {
+		d.IsAudioTranscoded = cfg.Output.AudioTrackConfigs[0].CodecName != codectypes.Name(codec.NameCopy)
+	}
+	return d
+}
+
+// withDefaults returns the defaults with the unset parameters set
+// the same way as of a Slate.
+func (d missingTrackDefaults) withDefaults() missingTrackDefaults {
+	sl := Slate{}.withDefaults(d.Width, d.Height, d.FrameRate, d.SampleRate)
+	d.Width, d.Height, d.FrameRate, d.SampleRate = sl.Width, sl.Height, sl.FrameRate, sl.SampleRate
+	return d
+}
+
+// missingTrack is an output track that may be filled.
+type missingTrack struct {
+	MediaType astiav.MediaType
+
+	// StreamIndex is the stream index of the track: it is either defined
+	// by the StreamMap (isIndexFixed), or it is the one of the last real
+	// frame (or the output track ID of the default layout).
+	StreamIndex  int
+	isIndexFixed bool
+
+	timeline trackTimeline
+
+	// streamInfo and timeBase are of the last real frame.
+	streamInfo *frame.StreamInfo
+	timeBase   astiav.Rational
+
+	// frameParams are of the last real frame, if it is not a hardware frame.
+	frameParams *filterGraphSourceParams
+
+	// lastFrame is the last real frame (video only, for MissingTrackFillFreeze).
+	lastFrame *astiav.Frame
+
+	// fillFrame is the frame repeated while the track is filled.
+	fillFrame *astiav.Frame
+
+	// codecParameters describe the synthesized frames if the stream info
+	// is borrowed from the other track.
+	codecParameters *astiav.CodecParameters
+}
+
+func (t *missingTrack) resetFill() {
+	if t.fillFrame != nil {
+		t.fillFrame.Free()
+		t.fillFrame = nil
+	}
+}
+
+func (t *missingTrack) free() {
+	t.resetFill()
+	if t.lastFrame != nil {
+		t.lastFrame.Free()
+		t.lastFrame = nil
+	}
+	if t.codecParameters != nil {
+		t.codecParameters.Free()
+		t.codecParameters = nil
+	}
+}
+
+// missingTracksFiller synthesizes the frames of the missing video or audio
+// track (see MissingTrackFill), following the frames sent by FilterKernel.
+type missingTracksFiller struct {
+	Fill     MissingTrackFill
+	Defaults missingTrackDefaults
+
+	// video and audio are nil if there is no such track in the stream layout.
+	video *missingTrack
+	audio *missingTrack
+
+	// origin is the beginning of the first frame, and now is the end
+	// of the latest frame of any track.
+	isStarted bool
+	origin    time.Duration
+	now       time.Duration
+}
+
+// newMissingTracksFiller returns the filler of the first video and audio
+// tracks of the stream map (or of the tracks 0 and 1 of the default layout).
+func newMissingTracksFiller(
+	fill MissingTrackFill,
+	streamMap StreamMap,
+) *missingTracksFiller {
+	f := &missingTracksFiller{Fill: fill}
+	if len(streamMap) == 0 {
+		f.video = &missingTrack{MediaType: astiav.MediaTypeVideo, StreamIndex: 0}
+		f.audio = &missingTrack{MediaType: astiav.MediaTypeAudio, StreamIndex: 1}
+		return f
+	}
+	tracks, err := streamMap.Tracks()
+	if err != nil {
+		// the map is validated before; nothing to fill
+		return f
+	}
+	for trackID, track := range tracks {
+		t := &missingTrack{MediaType: track.MediaType, StreamIndex: trackID, isIndexFixed: true}
+		switch {
+		case track.MediaType == astiav.MediaTypeVideo && f.video == nil:
+			f.video = t
+		case track.MediaType == astiav.MediaTypeAudio && f.audio == nil:
+			f.audio = t
+		}
+	}
+	return f
+}
+
+// setDefaults changes the defaults (e.g. on a change of the resolution by
+// the auto bitrate); the synthesized frames based on them are rebuilt.
+func (f *missingTracksFiller) setDefaults(d missingTrackDefaults) {
+	if d == f.Defaults {
+		return
+	}
+	f.Defaults = d
+	for _, t := range []*missingTrack{f.video, f.audio} {
+		if t == nil || t.frameParams != nil {
+			continue
+		}
+		t.resetFill()
+		if t.codecParameters != nil {
+			// the frames sent already may refer to the parameters
+			f.setCodecParameters(t.codecParameters, t)
+		}
+	}
+}
+
+// isFilled returns true if the output of the encoders is guaranteed to have
+// both the filled tracks as soon as it has any of them.
+func (f *missingTracksFiller) isFilled(
+	videoCodec codectypes.Name,
+	audioCodec codectypes.Name,
+) bool {
+	switch f.Fill {
+	case MissingTrackFillBlack, MissingTrackFillFreeze:
+	default:
+		return false
+	}
+	if f.video == nil || f.audio == nil {
+		return false
+	}
+	for _, name := range []codectypes.Name{videoCodec, audioCodec} {
+		if name == "" || name == codectypes.Name(codec.NameCopy) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *missingTracksFiller) free() {
+	for _, t := range []*missingTrack{f.video, f.audio} {
+		if t != nil {
+			t.free()
+		}
+	}
+}
+
+// track returns the track the real frame belongs to, if it is filled.
+func (f *missingTracksFiller) track(
+	mediaType astiav.MediaType,
+	streamIdx int,
+) *missingTrack {
+	var t *missingTrack
+	switch mediaType {
+	case astiav.MediaTypeVideo:
+		if !f.Defaults.IsVideoTranscoded {
+			return nil
+		}
+		t = f.video
+	case astiav.MediaTypeAudio:
+		if !f.Defaults.IsAudioTranscoded {
+			return nil
+		}
+		t = f.audio
+	}
+	if t == nil || (t.isIndexFixed && t.StreamIndex != streamIdx) {
+		return nil
+	}
+	return t
+}
+
+func (f *missingTracksFiller) other(t *missingTrack) *missingTrack {
+	if t == f.video {
+		return f.audio
+	}
+	return f.video
+}
+
+// frameMediaType returns the media type of a decoded frame; it does not rely
+// on the stream info, since the outputs of the `-filter_complex` graph may
+// have the stream info of an input of another media type.
+func frameMediaType(f *astiav.Frame) astiav.MediaType {
+	switch {
+	case f.SampleRate() > 0:
+		return astiav.MediaTypeAudio
+	case f.Width() > 0:
+		return astiav.MediaTypeVideo
+	default:
+		return astiav.MediaTypeUnknown
+	}
+}
+
+func ptsToDuration(pts int64, timeBase astiav.Rational) time.Duration {
+	return time.Duration(astiav.RescaleQ(pts, timeBase, nanosecondTimeBase))
+}
+
+func durationToPTS(d time.Duration, timeBase astiav.Rational) int64 {
+	return astiav.RescaleQ(int64(d), nanosecondTimeBase, timeBase)
+}
+
+// observe registers the real frames being sent; it returns the frames
+// to be sent, that is without the ones overlapping the synthesized frames
+// (these are freed).
+func (f *missingTracksFiller) observe(
+	ctx context.Context,
+	outputs []packetorframe.OutputUnion,
+) []packetorframe.OutputUnion {
+	result := outputs[:0]
+	for _, out := range outputs {
+		fr := out.Frame.Frame
+		if fr.Pts() == astiav.NoPtsValue {
+			result = append(result, out)
+			continue
+		}
+		mediaType := frameMediaType(fr)
+		t := f.track(mediaType, out.Frame.GetStreamIndex())
+		if t == nil {
+			result = append(result, out)
+			continue
+		}
+		timeBase := out.Frame.GetTimeBase()
+		start := ptsToDuration(fr.Pts(), timeBase)
+		end := start + ptsToDuration(fr.Duration(), timeBase)
+		if !f.isStarted || start < f.origin {
+			f.origin = start
+		}
+		if !f.isStarted || end > f.now {
+			f.now = end
+		}
+		f.isStarted = true
+
+		isPassed, wasFilling := t.timeline.observe(start, end)
+		if wasFilling {
+			logger.Infof(ctx, "the %s track is back, stopped filling it", mediaType)
+			t.resetFill()
+		}
+		if !t.isIndexFixed {
+			t.StreamIndex = out.Frame.GetStreamIndex()
+		}
+		streamInfo := *out.Frame.StreamInfo
+		t.streamInfo = &streamInfo
+		t.timeBase = timeBase
+		t.frameParams = nil
+		if fr.HardwareFramesContext() == nil {
+			params := filterGraphSourceParamsFromFrame(mediaType, fr, timeBase)
+			t.frameParams = &params
+		}
+		if mediaType == astiav.MediaTypeVideo && f.Fill == MissingTrackFillFreeze {
+			if t.lastFrame != nil {
+				t.lastFrame.Free()
+			}
+			t.lastFrame = fr.Clone()
+		}
+		if !isPassed {
+			logger.Tracef(ctx, "dropping a frame of the %s track: it overlaps the synthesized frames", mediaType)
+			fr.Free()
+			continue
+		}
+		result = append(result, out)
+	}
+	return result
+}
+
+// generate returns the synthesized frames of the missing tracks up to
+// the latest real frame.
+func (f *missingTracksFiller) generate(
+	ctx context.Context,
+) ([]packetorframe.OutputUnion, error) {
+	if !f.isStarted {
+		return nil, nil
+	}
+	var outputs []packetorframe.OutputUnion
+	for _, t := range []*missingTrack{f.video, f.audio} {
+		if t == nil || f.track(t.MediaType, t.StreamIndex) == nil {
+			continue
+		}
+		out, err := f.generateTrack(ctx, t)
+		outputs = append(outputs, out...)
+		if err != nil {
+			for _, out := range outputs {
+				out.Frame.Frame.Free()
+			}
+			return nil, fmt.Errorf("unable to fill the %s track: %w", t.MediaType, err)
+		}
+	}
+	return outputs, nil
+}
+
+func (f *missingTracksFiller) generateTrack(
+	ctx context.Context,
+	t *missingTrack,
+) ([]packetorframe.OutputUnion, error) {
+	streamInfo, timeBase, ok := f.streamInfo(t)
+	if !ok {
+		// neither of the tracks has real frames yet
+		return nil, nil
+	}
+	wasFilling := t.timeline.isFilling
+	timestamps := t.timeline.fill(f.origin, f.now, f.frameDuration(t))
+	if len(timestamps) == 0 {
+		return nil, nil
+	}
+	if !wasFilling {
+		logger.Warnf(ctx, "the %s track is missing, filling it (%s)", t.MediaType, f.Fill)
+	}
+	if t.fillFrame == nil {
+		fillFrame, err := f.newFillFrame(t)
+		if err != nil {
+			return nil, err
+		}
+		t.fillFrame = fillFrame
+	}
+
+	outputs := make([]packetorframe.OutputUnion, 0, len(timestamps))
+	frameDuration := durationToPTS(f.frameDuration(t), timeBase)
+	for _, ts := range timestamps {
+		fr := t.fillFrame.Clone()
+		if fr == nil {
+			return outputs, fmt.Errorf("unable to clone the frame")
+		}
+		fr.SetPts(durationToPTS(ts, timeBase))
+		fr.SetDuration(frameDuration)
+		o := frame.BuildOutput(fr, streamInfo)
+		outputs = append(outputs, packetorframe.OutputUnion{Frame: &o})
+	}
+	logger.Tracef(ctx, "synthesized %d frames of the %s track", len(outputs), t.MediaType)
+	return outputs, nil
+}
+
+// streamInfo returns the stream info of the synthesized frames: the one of
+// the last real frame of the track, or the one of the other track with
+// the stream index and the codec parameters of the track (and with the time
+// base of the sample rate for audio, since the one of video is too coarse).
+func (f *missingTracksFiller) streamInfo(
+	t *missingTrack,
+) (*frame.StreamInfo, astiav.Rational, bool) {
+	if t.streamInfo != nil {
+		return t.streamInfo, t.timeBase, true
+	}
+	other := f.other(t)
+	if other == nil || other.streamInfo == nil {
+		return nil, astiav.Rational{}, false
+	}
+	if !t.isIndexFixed && t.StreamIndex == other.StreamIndex {
+		// the output track ID of the default layout is taken by the real stream
+		t.StreamIndex = other.StreamIndex + 1
+	}
+	if t.codecParameters == nil {
+		t.codecParameters = f.newCodecParameters(t)
+	}
+	timeBase := other.timeBase
+	if t.MediaType == astiav.MediaTypeAudio {
+		timeBase = astiav.NewRational(1, f.frameParams(t).SampleRate)
+	}
+	streamInfo := *other.streamInfo
+	streamInfo.StreamIndex = t.StreamIndex
+	streamInfo.CodecParameters = t.codecParameters
+	streamInfo.TimeBase = timeBase
+	return &streamInfo, timeBase, true
+}
+
+func (f *missingTracksFiller) frameParams(t *missingTrack) filterGraphSourceParams {
+	if t.frameParams != nil {
+		return *t.frameParams
+	}
+	d := f.Defaults.withDefaults()
+	switch t.MediaType {
+	case astiav.MediaTypeVideo:
+		return filterGraphSourceParams{
+			MediaType:         t.MediaType,
+			Width:             int(d.Width),
+			Height:            int(d.Height),
+			PixelFormat:       astiav.PixelFormatYuv420P,
+			SampleAspectRatio: astiav.NewRational(1, 1),
+		}
+	default:
+		return filterGraphSourceParams{
+			MediaType:     t.MediaType,
+			SampleRate:    int(d.SampleRate),
+			SampleFormat:  astiav.SampleFormatFltp,
+			ChannelLayout: astiav.ChannelLayoutStereo,
+		}
+	}
+}
+
+func (f *missingTracksFiller) frameDuration(t *missingTrack) time.Duration {
+	switch t.MediaType {
+	case astiav.MediaTypeVideo:
+		frameRate := f.Defaults.withDefaults().FrameRate
+		return time.Duration(int64(time.Second) * int64(frameRate.Den) / int64(frameRate.Num))
+	default:
+		sampleRate := f.frameParams(t).SampleRate
+		if sampleRate <= 0 {
+			return 0
+		}
+		return missingTrackAudioFrameSamples * time.Second / time.Duration(sampleRate)
+	}
+}
+
+func (f *missingTracksFiller) newCodecParameters(t *missingTrack) *astiav.CodecParameters {
+	cp := astiav.AllocCodecParameters()
+	f.setCodecParameters(cp, t)
+	return cp
+}
+
+func (f *missingTracksFiller) setCodecParameters(
+	cp *astiav.CodecParameters,
+	t *missingTrack,
+) {
+	p := f.frameParams(t)
+	cp.SetMediaType(t.MediaType)
+	switch t.MediaType {
+	case astiav.MediaTypeVideo:
+		cp.SetWidth(p.Width)
+		cp.SetHeight(p.Height)
+		cp.SetPixelFormat(p.PixelFormat)
+		cp.SetSampleAspectRatio(p.SampleAspectRatio)
+	case astiav.MediaTypeAudio:
+		cp.SetSampleRate(p.SampleRate)
+		cp.SetSampleFormat(p.SampleFormat)
+		cp.SetChannelLayout(p.ChannelLayout)
+	}
+}
+
+// newFillFrame returns the frame to be repeated: the last real frame for
+// MissingTrackFillFreeze, otherwise a black or a silent frame.
+func (f *missingTracksFiller) newFillFrame(t *missingTrack) (*astiav.Frame, error) {
+	if t.lastFrame != nil && f.Fill == MissingTrackFillFreeze {
+		fr := t.lastFrame.Clone()
+		if fr == nil {
+			return nil, fmt.Errorf("unable to clone the last frame")
+		}
+		return fr, nil
+	}
+
+	p := f.frameParams(t)
+	fr := astiav.AllocFrame()
+	switch t.MediaType {
+	case astiav.MediaTypeVideo:
+		fr.SetWidth(p.Width)
+		fr.SetHeight(p.Height)
+		fr.SetPixelFormat(p.PixelFormat)
+		fr.SetSampleAspectRatio(p.SampleAspectRatio)
+	case astiav.MediaTypeAudio:
+		fr.SetSampleRate(p.SampleRate)
+		fr.SetSampleFormat(p.SampleFormat)
+		fr.SetChannelLayout(p.ChannelLayout)
+		fr.SetNbSamples(missingTrackAudioFrameSamples)
+	}
+	if err := fr.AllocBuffer(0); err != nil {
+		fr.Free()
+		return nil, fmt.Errorf("unable to allocate the frame buffer: %w", err)
+	}
+	var err error
+	switch t.MediaType {
+	case astiav.MediaTypeVideo:
+		err = fr.ImageFillBlack()
+	case astiav.MediaTypeAudio:
+		err = fr.SamplesFillSilence()
+	}
+	if err != nil {
+		fr.Free()
+		return nil, fmt.Errorf("unable to fill the frame: %w", err)
+	}
+	return fr, nil
+}
@@ -0,0 +1,114 @@
+package ffstream
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/asticode/go-astiav"
+)
+
+func TestMissingTrackFillFromString(t *testing.T) {
+	for f := UndefinedMissingTrackFill + 1; f < EndOfMissingTrackFill; f++ {
+		if got := MissingTrackFillFromString(f.String()); got != f {
+			t.Fatalf("expected %v, got %v", f, got)
+		}
+	}
+	for _, in := range []string{"", "grey", "<undefined>"} {
+		if got := MissingTrackFillFromString(in); got != UndefinedMissingTrackFill {
+			t.Fatalf("expected no value for %q, got %v", in, got)
+		}
+	}
+}
+
+func TestTrackTimeline(t *testing.T) {
+	ms := func(v int) time.Duration {
+		return time.Duration(v) * time.Millisecond
+	}
+	frameDuration := ms(20)
+
+	// the track has no frames at all
+	var tl trackTimeline
+	if got := tl.fill(0, ms(500), frameDuration); len(got) != 0 {
+		t.Fatalf("unexpected filling before the threshold: %v", got)
+	}
+	got := tl.fill(0, ms(1000), frameDuration)
+	if len(got) != 50 || got[0] != 0 || got[49] != ms(980) {
+		t.Fatalf("expected the frames from the origin up to 1s, got %v", got)
+	}
+	if got := tl.fill(0, ms(1040), frameDuration); !slices.Equal(got, []time.Duration{ms(1000), ms(1020)}) {
+		t.Fatalf("expected the filling to follow the other track, got %v", got)
+	}
+
+	// the track appears
+	if isPassed, wasFilling := tl.observe(ms(1040), ms(1060)); !isPassed || !wasFilling {
+		t.Fatalf("expected the frame to be passed and the track to be reported as filled till now, got %v and %v", isPassed, wasFilling)
+	}
+	if isPassed, wasFilling := tl.observe(ms(1060), ms(1080)); !isPassed || wasFilling {
+		t.Fatalf("expected the frame to be passed and the track to be not filled anymore, got %v and %v", isPassed, wasFilling)
+	}
+	if got := tl.fill(0, ms(2000), frameDuration); len(got) != 0 {
+		t.Fatalf("unexpected filling before the threshold: %v", got)
+	}
+
+	// the track disappears: the filling continues its timestamps
+	got = tl.fill(0, ms(2100), frameDuration)
+	if len(got) != 51 || got[0] != ms(1080) || got[50] != ms(2080) {
+		t.Fatalf("expected the frames from 1.08s up to 2.1s, got %v", got)
+	}
+
+	// the track comes back: the frames overlapping the synthesized ones
+	// (ending at 2.1s) are dropped
+	if isPassed, wasFilling := tl.observe(ms(2060), ms(2080)); isPassed || !wasFilling {
+		t.Fatalf("expected the overlapping frame to be dropped, got %v and %v", isPassed, wasFilling)
+	}
+	if isPassed, _ := tl.observe(ms(2080), ms(2100)); isPassed {
+		t.Fatalf("expected the overlapping frame to be dropped")
+	}
+	if got := tl.fill(0, ms(2120), frameDuration); len(got) != 0 {
+		t.Fatalf("unexpected filling of the track being back: %v", got)
+	}
+	if isPassed, _ := tl.observe(ms(2100), ms(2120)); !isPassed {
+		t.Fatalf("expected the frame after the synthesized ones to be passed")
+	}
+	if isPassed, _ := tl.observe(ms(2110), ms(2130)); !isPassed {
+		t.Fatalf("only the frames overlapping the synthesized ones should be dropped")
+	}
+
+	// the timestamps of the other track jump forward: the gap is skipped
+	got = tl.fill(0, ms(60_000), frameDuration)
+	if len(got) != 50 || got[0] != ms(59_000) {
+		t.Fatalf("expected the frames of the last second before 60s, got %v", got)
+	}
+}
+
+func TestNewMissingTracksFiller(t *testing.T) {
+	f := newMissingTracksFiller(MissingTrackFillBlack, nil)
+	f.Defaults = missingTrackDefaults{IsVideoTranscoded: true, IsAudioTranscoded: true}
+	if f.track(astiav.MediaTypeVideo, 5) != f.video || f.track(astiav.MediaTypeAudio, 5) != f.audio {
+		t.Fatalf("expected the tracks of the default layout to accept any stream index")
+	}
+	if f.video.StreamIndex != 0 || f.audio.StreamIndex != 1 {
+		t.Fatalf("expected the output track IDs of the default layout, got %d and %d", f.video.StreamIndex, f.audio.StreamIndex)
+	}
+	f.Defaults.IsAudioTranscoded = false
+	if f.track(astiav.MediaTypeAudio, 1) != nil {
+		t.Fatalf("a copied track should not be filled")
+	}
+
+	streamMap, err := ParseStreamMap([]string{"0:a:0", "1:v:0", "0:v:0"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f = newMissingTracksFiller(MissingTrackFillFreeze, streamMap)
+	f.Defaults = missingTrackDefaults{IsVideoTranscoded: true, IsAudioTranscoded: true}
+	if f.audio.StreamIndex != 0 || f.video.StreamIndex != 1 {
+		t.Fatalf("expected the first mapped tracks, got audio %d and video %d", f.audio.StreamIndex, f.video.StreamIndex)
+	}
+	if f.track(astiav.MediaTypeVideo, 2) != nil {
+		t.Fatalf("only the first video track should be filled")
+	}
+	if f.track(astiav.MediaTypeVideo, 1) != f.video {
+		t.Fatalf("expected the frames of track 1 to be of the filled video track")
+	}
+}
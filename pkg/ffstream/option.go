@@ -8,11 +8,35 @@ type Config struct {
 	// InputRetryInterval is a delay between input reconnect attempts.
 	// Zero means: use the internal/default retry interval.
 	InputRetryInterval time.Duration
+
+	// StreamMap selects which input streams are used for which output tracks.
+	// Empty means: the default layout (see StreamMap.TranscoderConfig).
+	StreamMap StreamMap
+
+	// InputRetryPolicy defines how the inputs are reconnected on failures,
+	// on top of InputRetryInterval. Nil means: reconnect every InputRetryInterval.
+	InputRetryPolicy *RetryPolicy
+
+	// OutputRetryPolicy defines how the outputs are reconnected on failures
+	// (unless overridden by SenderTemplate.RetryPolicy). Nil means: reconnect
+	// only the outputs with RetryOutputTimeoutOnFailure set,
+	// using DefaultRetryPolicy.
+	OutputRetryPolicy *RetryPolicy
+
+	// FilterComplex is the `-filter_complex` graph fed by the streams of
+	// the inputs of the same fallback priority; its outputs are used
+	// via the "[label]" selectors of StreamMap. Nil means: no such graph.
+	FilterComplex *FilterComplex
+
+	// MissingTrackFill defines whether and how a missing video or audio
+	// track of the output is synthesized, see MissingTrackFill.
+	MissingTrackFill MissingTrackFill
 }
 
 func DefaultConfig() Config {
 	return Config{
 		InputRetryInterval: -1,
+		MissingTrackFill:   MissingTrackFillNone,
 	}
 }
 
@@ -44,3 +68,35 @@ func (o OptionInputRetryIntervalValue) apply(cfg *Config) {
 func OptionInputRetryInterval(interval time.Duration) OptionInputRetryIntervalValue {
 	return OptionInputRetryIntervalValue(interval)
 }
+
+type OptionStreamMap StreamMap
+
+func (o OptionStreamMap) apply(cfg *Config) {
+	cfg.StreamMap = StreamMap(o)
+}
+
+type OptionFilterComplex struct {
+	*FilterComplex
+}
+
+func (o OptionFilterComplex) apply(cfg *Config) {
+	cfg.FilterComplex = o.FilterComplex
+}
+
+type OptionInputRetryPolicy RetryPolicy
+
+func (o OptionInputRetryPolicy) apply(cfg *Config) {
+	cfg.InputRetryPolicy = ptr(RetryPolicy(o))
+}
+
+type OptionOutputRetryPolicy RetryPolicy
+
+func (o OptionOutputRetryPolicy) apply(cfg *Config) {
+	cfg.OutputRetryPolicy = ptr(RetryPolicy(o))
+}
+
+type OptionMissingTrackFill MissingTrackFill
+
+func (o OptionMissingTrackFill) apply(cfg *Config) {
+	cfg.MissingTrackFill = MissingTrackFill(o)
+}
@@ -0,0 +1,191 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+	"github.com/xaionaro-go/xsync"
+)
+
+// OutputInfo describes an output of the StreamMux.
+type OutputInfo struct {
+	ID        streammux.OutputID
+	SenderKey streammux.SenderKey
+	URLs      []string
+
+	// IsActive is true for the output the stream is currently sent to;
+	// the other outputs are on standby.
+	IsActive bool
+
+	// Destinations are the destinations of the output, one per output
+	// template (see nodeFanOutWrapper).
+	Destinations []OutputDestinationInfo
+
+	Output *streammux.Output[CustomData]
+}
+
+// OutputDestinationInfo describes a destination of an output.
+type OutputDestinationInfo struct {
+	// Index is the index of the output template.
+	Index int
+	URLs  []string
+
+	// Err is the error the destination failed with; the failed destination
+	// is detached and the stream is sent to the rest of destinations.
+	Err error
+
+	SendingNode SendingNodeAbstract
+}
+
+func (s *FFStream) ListOutputs(
+	ctx context.Context,
+) (_ret []OutputInfo, _err error) {
+	logger.Debugf(ctx, "ListOutputs")
+	defer func() { logger.Debugf(ctx, "/ListOutputs: %d, %v", len(_ret), _err) }()
+	if s.StreamMux == nil {
+		return nil, fmt.Errorf("it is allowed to use ListOutputs only after Start is invoked")
+	}
+
+	var activeID *streammux.OutputID
+	if activeOutput := s.StreamMux.GetActiveOutput(ctx); activeOutput != nil {
+		activeID = &activeOutput.ID
+	}
+
+	var result []OutputInfo
+	s.StreamMux.Outputs.Range(func(id streammux.OutputID, output *streammux.Output[CustomData]) bool {
+		info := OutputInfo{
+			ID:        id,
+			SenderKey: output.GetKey(),
+			IsActive:  activeID != nil && *activeID == id,
+			Output:    output,
+		}
+		if sendingNode, ok := output.SendingNode.(SendingNodeAbstract); ok {
+			info.URLs = sendingNode.GetURLs()
+			fanOut, isFanOut := sendingNode.(nodeFanOutWrapper)
+			for idx, dst := range getDestinations(sendingNode) {
+				dstInfo := OutputDestinationInfo{
+					Index:       idx,
+					URLs:        dst.GetURLs(),
+					SendingNode: dst,
+				}
+				if isFanOut {
+					dstInfo.Err = fanOut.getDestinationErr(ctx, idx)
+				}
+				info.Destinations = append(info.Destinations, dstInfo)
+			}
+		}
+		result = append(result, info)
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+// CreateOutput creates (if not created yet) a standby output for the given
+// sender key, so that a later switch to it does not need to wait for
+// the connection to be established.
+func (s *FFStream) CreateOutput(
+	ctx context.Context,
+	senderKey streammux.SenderKey,
+) (_ret streammux.OutputID, _err error) {
+	logger.Debugf(ctx, "CreateOutput(ctx, %#+v)", senderKey)
+	defer func() { logger.Debugf(ctx, "/CreateOutput(ctx, %#+v): %v, %v", senderKey, _ret, _err) }()
+	if s.StreamMux == nil {
+		return 0, fmt.Errorf("it is allowed to use CreateOutput only after Start is invoked")
+	}
+	output, _, err := s.StreamMux.GetOrCreateOutput(ctx, senderKey)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create an output for %#+v: %w", senderKey, err)
+	}
+	return output.ID, nil
+}
+
+// RemoveOutput closes the output and forgets it. If dropOnClose is true,
+// the data not sent yet is dropped (the connection is closed abruptly),
+// otherwise the output tries to flush it before closing.
+//
+// The active output cannot be removed, switch to another output first.
+func (s *FFStream) RemoveOutput(
+	ctx context.Context,
+	outputID streammux.OutputID,
+	dropOnClose bool,
+) (_err error) {
+	logger.Debugf(ctx, "RemoveOutput(ctx, %d, %v)", outputID, dropOnClose)
+	defer func() { logger.Debugf(ctx, "/RemoveOutput(ctx, %d, %v): %v", outputID, dropOnClose, _err) }()
+	if s.StreamMux == nil {
+		return fmt.Errorf("it is allowed to use RemoveOutput only after Start is invoked")
+	}
+
+	// GetActiveOutput takes StreamMux.Locker itself (and the lock is not
+	// reentrant), so the active output is checked outside of the lock:
+	// before the removal and once again after it, since the output could be
+	// switched to in between (a switch started after the removal cannot
+	// pick the output, it is not in Outputs anymore).
+	if isActiveOutput(ctx, s.StreamMux, outputID) {
+		return fmt.Errorf("output %d is active, switch to another output first", outputID)
+	}
+	output, err := xsync.DoR2(ctx, &s.StreamMux.Locker, func() (*streammux.Output[CustomData], error) {
+		output, _ := s.StreamMux.Outputs.LoadAndDelete(outputID)
+		if output == nil {
+			return nil, fmt.Errorf("output %d not found", outputID)
+		}
+		return output, nil
+	})
+	if err != nil {
+		return err
+	}
+	if isActiveOutput(ctx, s.StreamMux, outputID) {
+		s.StreamMux.Outputs.Store(outputID, output)
+		return fmt.Errorf("output %d is active, switch to another output first", outputID)
+	}
+
+	if sendingNode, ok := output.SendingNode.(streammux.SetDropOnCloser); ok {
+		if err := sendingNode.SetDropOnClose(ctx, dropOnClose); err != nil {
+			logger.Errorf(ctx, "unable to set drop-on-close to %v for output %d: %v", dropOnClose, outputID, err)
+		}
+	}
+	if err := output.Close(ctx); err != nil {
+		return fmt.Errorf("unable to close output %d: %w", outputID, err)
+	}
+	return nil
+}
+
+func isActiveOutput(
+	ctx context.Context,
+	streamMux *streammux.StreamMux[CustomData],
+	outputID streammux.OutputID,
+) bool {
+	activeOutput := streamMux.GetActiveOutput(ctx)
+	return activeOutput != nil && activeOutput.ID == outputID
+}
+
+// GetMaxBitRate returns the maximal bit rate (in bits per second) allowed by
+// the automatic bit rate control, or zero if it is disabled.
+func (s *FFStream) GetMaxBitRate(
+	ctx context.Context,
+) uint64 {
+	cfg, err := s.GetAutoBitRateVideoConfig(ctx)
+	if err != nil || cfg == nil {
+		return 0
+	}
+	return uint64(cfg.MaxBitRate.ToBps())
+}
+
+// GetActiveOutputID returns the ID of the output the stream is currently sent to.
+func (s *FFStream) GetActiveOutputID(
+	ctx context.Context,
+) (streammux.OutputID, bool) {
+	if s.StreamMux == nil {
+		return 0, false
+	}
+	output := s.StreamMux.GetActiveOutput(ctx)
+	if output == nil {
+		return 0, false
+	}
+	return output.ID, true
+}
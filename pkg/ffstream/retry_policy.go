@@ -0,0 +1,401 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/xsync"
+)
+
+// ErrRetriesExhausted is returned when an input or an output failed and
+// its RetryPolicy does not allow to retry anymore.
+var ErrRetriesExhausted = errors.New("retries exhausted")
+
+// RetryTarget selects which RetryPolicy (inputs or outputs) is used.
+type RetryTarget int
+
+const (
+	UndefinedRetryTarget = RetryTarget(iota)
+	RetryTargetInput
+	RetryTargetOutput
+	EndOfRetryTarget
+)
+
+func (t RetryTarget) String() string {
+	switch t {
+	case UndefinedRetryTarget:
+		return "<undefined>"
+	case RetryTargetInput:
+		return "input"
+	case RetryTargetOutput:
+		return "output"
+	default:
+		return fmt.Sprintf("<unknown_%d>", int(t))
+	}
+}
+
+// RetryExhaustedAction defines what happens when the retries are exhausted.
+type RetryExhaustedAction int
+
+const (
+	UndefinedRetryExhaustedAction = RetryExhaustedAction(iota)
+
+	// RetryExhaustedActionStop stops the whole stream.
+	RetryExhaustedActionStop
+
+	// RetryExhaustedActionFallback gives up on the input or output: inputs
+	// fall back to the next fallback priority, outputs switch to the next
+	// URL of their fallback chain (or are closed if there is none).
+	RetryExhaustedActionFallback
+
+	// RetryExhaustedActionContinue keeps retrying every MaxInterval.
+	RetryExhaustedActionContinue
+
+	EndOfRetryExhaustedAction
+)
+
+func (a RetryExhaustedAction) String() string {
+	switch a {
+	case UndefinedRetryExhaustedAction:
+		return "<undefined>"
+	case RetryExhaustedActionStop:
+		return "stop"
+	case RetryExhaustedActionFallback:
+		return "fallback"
+	case RetryExhaustedActionContinue:
+		return "continue"
+	default:
+		return fmt.Sprintf("<unknown_%d>", int(a))
+	}
+}
+
+func RetryExhaustedActionFromString(s string) RetryExhaustedAction {
+	for a := UndefinedRetryExhaustedAction + 1; a < EndOfRetryExhaustedAction; a++ {
+		if a.String() == s {
+			return a
+		}
+	}
+	return UndefinedRetryExhaustedAction
+}
+
+// RetryPolicy defines how a failed input or output is reconnected:
+// the delay starts with InitialInterval and is multiplied by Multiplier
+// after each failed attempt (up to MaxInterval), randomized by Jitter.
+type RetryPolicy struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+
+	// Jitter is the maximal relative deviation of a delay, e.g. 0.2 means ±20%.
+	Jitter float64
+
+	// MaxAttempts is the maximal amount of reconnect attempts after a failure;
+	// zero means no limit.
+	MaxAttempts uint
+
+	// MaxElapsedTime is the maximal time to retry since the first failure;
+	// zero means no limit.
+	MaxElapsedTime time.Duration
+
+	OnExhausted RetryExhaustedAction
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		OnExhausted:     RetryExhaustedActionFallback,
+	}
+}
+
+func (p RetryPolicy) Validate() error {
+	if p.InitialInterval <= 0 {
+		return fmt.Errorf("the initial interval should be positive, but it is %v", p.InitialInterval)
+	}
+	if p.MaxInterval < p.InitialInterval {
+		return fmt.Errorf("the max interval (%v) is less than the initial interval (%v)", p.MaxInterval, p.InitialInterval)
+	}
+	if p.Multiplier < 1 {
+		return fmt.Errorf("the multiplier should be at least 1, but it is %v", p.Multiplier)
+	}
+	if p.Jitter < 0 || p.Jitter >= 1 {
+		return fmt.Errorf("the jitter should be in range [0, 1), but it is %v", p.Jitter)
+	}
+	if p.MaxElapsedTime < 0 {
+		return fmt.Errorf("the max elapsed time is negative: %v", p.MaxElapsedTime)
+	}
+	if p.OnExhausted <= UndefinedRetryExhaustedAction || p.OnExhausted >= EndOfRetryExhaustedAction {
+		return fmt.Errorf("unknown on-exhausted action: %v", p.OnExhausted)
+	}
+	return nil
+}
+
+// Backoff returns the delay before the attempt-th reconnect attempt
+// (starting with 1).
+func (p RetryPolicy) Backoff(attempt uint) time.Duration {
+	return p.backoff(attempt, rand.Float64())
+}
+
+// backoff is Backoff with the random value r in range [0, 1) provided
+// explicitly; r == 0.5 means no deviation.
+func (p RetryPolicy) backoff(attempt uint, r float64) time.Duration {
+	if attempt == 0 {
+		attempt = 1
+	}
+	d := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(attempt-1))
+	return p.withJitter(min(d, float64(p.MaxInterval)), r)
+}
+
+func (p RetryPolicy) withJitter(d float64, r float64) time.Duration {
+	return time.Duration(d * (1 + p.Jitter*(2*r-1)))
+}
+
+// IsLimited returns true if the retries may be exhausted, that is if
+// MaxAttempts or MaxElapsedTime is set.
+func (p RetryPolicy) IsLimited() bool {
+	return p.MaxAttempts > 0 || p.MaxElapsedTime > 0
+}
+
+// IsExhausted returns true if the attempt-th reconnect attempt (starting
+// with 1) is not allowed, given the time elapsed since the first failure.
+func (p RetryPolicy) IsExhausted(attempt uint, elapsed time.Duration) bool {
+	if p.MaxAttempts > 0 && attempt > p.MaxAttempts {
+		return true
+	}
+	if p.MaxElapsedTime > 0 && elapsed > p.MaxElapsedTime {
+		return true
+	}
+	return false
+}
+
+// String returns the policy in the format accepted by ParseRetryPolicy.
+func (p RetryPolicy) String() string {
+	return fmt.Sprintf(
+		"initial=%v,max=%v,multiplier=%v,jitter=%v,attempts=%d,elapsed=%v,exhausted=%v",
+		p.InitialInterval, p.MaxInterval, p.Multiplier, p.Jitter, p.MaxAttempts, p.MaxElapsedTime, p.OnExhausted,
+	)
+}
+
+// ParseRetryPolicy parses a comma-separated list of key=value pairs,
+// e.g. "initial=1s,max=1m,multiplier=2,jitter=0.2,attempts=10,elapsed=10m,exhausted=stop";
+// the omitted keys are taken from DefaultRetryPolicy.
+func ParseRetryPolicy(s string) (RetryPolicy, error) {
+	p := DefaultRetryPolicy()
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return RetryPolicy{}, fmt.Errorf("expected key=value, got %q", kv)
+		}
+		var err error
+		switch k {
+		case "initial":
+			p.InitialInterval, err = time.ParseDuration(v)
+		case "max":
+			p.MaxInterval, err = time.ParseDuration(v)
+		case "multiplier":
+			p.Multiplier, err = strconv.ParseFloat(v, 64)
+		case "jitter":
+			p.Jitter, err = strconv.ParseFloat(v, 64)
+		case "attempts":
+			var attempts uint64
+			attempts, err = strconv.ParseUint(v, 10, 0)
+			p.MaxAttempts = uint(attempts)
+		case "elapsed":
+			p.MaxElapsedTime, err = time.ParseDuration(v)
+		case "exhausted":
+			p.OnExhausted = RetryExhaustedActionFromString(v)
+			if p.OnExhausted == UndefinedRetryExhaustedAction {
+				err = fmt.Errorf("expected one of: stop, fallback, continue")
+			}
+		default:
+			return RetryPolicy{}, fmt.Errorf("unknown key %q", k)
+		}
+		if err != nil {
+			return RetryPolicy{}, fmt.Errorf("unable to parse the value of %q: %w", k, err)
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return RetryPolicy{}, err
+	}
+	return p, nil
+}
+
+// RetryCounters counts the connection attempts of inputs or outputs.
+type RetryCounters struct {
+	Attempts  atomic.Uint64
+	Successes atomic.Uint64
+	Exhausted atomic.Uint64
+}
+
+// retryState is the state of reconnecting a single input or output.
+type retryState struct {
+	attempt     uint
+	startedAt   time.Time
+	isExhausted bool
+}
+
+// next is called on a failure; it returns the delay before the next attempt,
+// or false if the retries are exhausted (and the action is not "continue").
+func (st *retryState) next(
+	p RetryPolicy,
+	now time.Time,
+	counters *RetryCounters,
+) (time.Duration, bool) {
+	if st.startedAt.IsZero() {
+		st.startedAt = now
+	}
+	st.attempt++
+	if !p.IsExhausted(st.attempt, now.Sub(st.startedAt)) {
+		return p.Backoff(st.attempt), true
+	}
+	if !st.isExhausted {
+		st.isExhausted = true
+		counters.Exhausted.Add(1)
+	}
+	if p.OnExhausted != RetryExhaustedActionContinue {
+		return 0, false
+	}
+	return p.withJitter(float64(p.MaxInterval), rand.Float64()), true
+}
+
+func (st *retryState) reset() {
+	*st = retryState{}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// GetRetryPolicy returns the current retry policy of the target; nil means
+// the legacy behavior (see Config.InputRetryPolicy and Config.OutputRetryPolicy).
+func (s *FFStream) GetRetryPolicy(
+	ctx context.Context,
+	target RetryTarget,
+) (*RetryPolicy, error) {
+	return xsync.DoR2(ctx, &s.retryLocker, func() (*RetryPolicy, error) {
+		switch target {
+		case RetryTargetInput:
+			return s.inputRetryPolicy, nil
+		case RetryTargetOutput:
+			return s.outputRetryPolicy, nil
+		default:
+			return nil, fmt.Errorf("unknown retry target: %v", target)
+		}
+	})
+}
+
+// SetRetryPolicy sets the retry policy of the target; it is applied
+// starting from the next failure.
+func (s *FFStream) SetRetryPolicy(
+	ctx context.Context,
+	target RetryTarget,
+	policy *RetryPolicy,
+) (_err error) {
+	logger.Debugf(ctx, "SetRetryPolicy(ctx, %v, %v)", target, policy)
+	defer func() { logger.Debugf(ctx, "/SetRetryPolicy(ctx, %v, %v): %v", target, policy, _err) }()
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid retry policy: %w", err)
+		}
+		policy = ptr(*policy)
+	}
+	return xsync.DoR1(ctx, &s.retryLocker, func() error {
+		switch target {
+		case RetryTargetInput:
+			s.inputRetryPolicy = policy
+		case RetryTargetOutput:
+			s.outputRetryPolicy = policy
+		default:
+			return fmt.Errorf("unknown retry target: %v", target)
+		}
+		return nil
+	})
+}
+
+// GetRetryCounters returns the connection attempt counters of the target.
+func (s *FFStream) GetRetryCounters(target RetryTarget) *RetryCounters {
+	switch target {
+	case RetryTargetInput:
+		return &s.InputRetryCounters
+	case RetryTargetOutput:
+		return &s.OutputRetryCounters
+	default:
+		return nil
+	}
+}
+
+// getOutputRetryPolicy returns the retry policy of the output, or false if
+// the output should not be retried at all.
+func (s *FFStream) getOutputRetryPolicy(
+	ctx context.Context,
+	t SenderTemplate,
+) (RetryPolicy, bool) {
+	policy := t.RetryPolicy
+	if policy == nil {
+		policy, _ = s.GetRetryPolicy(ctx, RetryTargetOutput)
+	}
+	switch {
+	case policy != nil:
+		p := *policy
+		if t.RetryOutputTimeoutOnFailure > 0 {
+			p.MaxElapsedTime = t.RetryOutputTimeoutOnFailure
+		}
+		return p, true
+	case t.RetryOutputTimeoutOnFailure != 0:
+		p := DefaultRetryPolicy()
+		p.MaxElapsedTime = t.RetryOutputTimeoutOnFailure
+		return p, true
+	default:
+		return RetryPolicy{}, false
+	}
+}
+
+// onRetriesExhausted reports the exhaustion, applies RetryExhaustedActionStop
+// (the other actions are handled by the callers) and returns the error
+// to be returned.
+func (s *FFStream) onRetriesExhausted(
+	ctx context.Context,
+	target RetryTarget,
+	p RetryPolicy,
+	err error,
+) error {
+	err = fmt.Errorf("%w: %w", ErrRetriesExhausted, err)
+	s.publishEvent(ctx, RetriesExhaustedEvent{
+		Time:        time.Now(),
+		Target:      target,
+		OnExhausted: p.OnExhausted,
+		Err:         err,
+	})
+	if p.OnExhausted != RetryExhaustedActionStop {
+		return err
+	}
+	logger.Errorf(ctx, "stopping the stream: %v", err)
+	s.locker.Lock()
+	cancelFn := s.cancelFunc
+	s.locker.Unlock()
+	if cancelFn != nil {
+		cancelFn()
+	}
+	return err
+}
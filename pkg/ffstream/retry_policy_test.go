@@ -0,0 +1,116 @@
+package ffstream
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRetryPolicy(t *testing.T) {
+	p, err := ParseRetryPolicy("initial=1s,max=1m,multiplier=3,jitter=0.1,attempts=5,elapsed=10m,exhausted=stop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := RetryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     time.Minute,
+		Multiplier:      3,
+		Jitter:          0.1,
+		MaxAttempts:     5,
+		MaxElapsedTime:  10 * time.Minute,
+		OnExhausted:     RetryExhaustedActionStop,
+	}
+	if p != want {
+		t.Fatalf("expected %#v, got %#v", want, p)
+	}
+	p2, err := ParseRetryPolicy(p.String())
+	if err != nil {
+		t.Fatalf("unable to parse the result of String(): %v", err)
+	}
+	if p2 != p {
+		t.Fatalf("expected %#v, got %#v", p, p2)
+	}
+
+	p, err = ParseRetryPolicy("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p != DefaultRetryPolicy() {
+		t.Fatalf("expected the default policy, got %#v", p)
+	}
+
+	for _, in := range []string{"x", "initial=x", "foo=1", "exhausted=never", "initial=0s", "initial=1m,max=1s", "multiplier=0.5", "jitter=1"} {
+		t.Run("invalid_"+in, func(t *testing.T) {
+			if _, err := ParseRetryPolicy(in); err == nil {
+				t.Fatalf("expected an error for %q", in)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     time.Second,
+		Multiplier:      2,
+		Jitter:          0.5,
+	}
+	for _, tc := range []struct {
+		attempt uint
+		r       float64
+		want    time.Duration
+	}{
+		{1, 0.5, 100 * time.Millisecond},
+		{2, 0.5, 200 * time.Millisecond},
+		{4, 0.5, 800 * time.Millisecond},
+		{5, 0.5, time.Second},
+		{100, 0.5, time.Second},
+		{1, 0, 50 * time.Millisecond},
+		{5, 0, 500 * time.Millisecond},
+	} {
+		if got := p.backoff(tc.attempt, tc.r); got != tc.want {
+			t.Errorf("backoff(%d, %v): expected %v, got %v", tc.attempt, tc.r, tc.want, got)
+		}
+	}
+}
+
+func TestRetryStateNext(t *testing.T) {
+	p := RetryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     time.Second,
+		Multiplier:      1,
+		MaxAttempts:     2,
+		OnExhausted:     RetryExhaustedActionFallback,
+	}
+	var counters RetryCounters
+	var st retryState
+	now := time.Unix(0, 0)
+	for i := 0; i < 2; i++ {
+		if _, ok := st.next(p, now, &counters); !ok {
+			t.Fatalf("attempt %d: unexpectedly exhausted", i+1)
+		}
+	}
+	if _, ok := st.next(p, now, &counters); ok {
+		t.Fatalf("expected the retries to be exhausted")
+	}
+	if counters.Exhausted.Load() != 1 {
+		t.Fatalf("expected one exhaustion, got %d", counters.Exhausted.Load())
+	}
+
+	st.reset()
+	p.MaxAttempts = 0
+	p.MaxElapsedTime = time.Minute
+	p.OnExhausted = RetryExhaustedActionContinue
+	if _, ok := st.next(p, now, &counters); !ok {
+		t.Fatalf("unexpectedly exhausted")
+	}
+	delay, ok := st.next(p, now.Add(time.Hour), &counters)
+	if !ok || delay != p.MaxInterval {
+		t.Fatalf("expected to continue with %v, got %v, %v", p.MaxInterval, delay, ok)
+	}
+	if _, ok := st.next(p, now.Add(2*time.Hour), &counters); !ok {
+		t.Fatalf("expected to continue")
+	}
+	if counters.Exhausted.Load() != 2 {
+		t.Fatalf("expected two exhaustions, got %d", counters.Exhausted.Load())
+	}
+}
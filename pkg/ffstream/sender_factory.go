@@ -16,12 +16,31 @@ import (
 	"github.com/xaionaro-go/avpipeline/processor"
 	avptypes "github.com/xaionaro-go/avpipeline/types"
 	"github.com/xaionaro-go/secret"
+	"github.com/xaionaro-go/xsync"
 )
 
 type SenderTemplate struct {
 	URLTemplate                 string
 	Options                     []avptypes.DictionaryItem
 	RetryOutputTimeoutOnFailure time.Duration
+
+	// RetryPolicy overrides Config.OutputRetryPolicy for this output;
+	// RetryOutputTimeoutOnFailure (if set) overrides its MaxElapsedTime.
+	RetryPolicy *RetryPolicy
+
+	// FallbackPriority is zero for a primary output; an output with a non-zero
+	// priority is a fallback of the last primary output (see AddOutputTemplate).
+	FallbackPriority uint
+
+	// Fallbacks are the outputs (ordered by FallbackPriority) the stream is
+	// switched to if this output fails.
+	Fallbacks []SenderTemplate
+
+	// FallbackRecheckInterval is how often this output is probed while
+	// a fallback is used; zero means DefaultOutputFallbackRecheckInterval.
+	// The stream is switched back after a few consecutive successful
+	// probes, see outputFallbackChain.probePrimary on what a probe does.
+	FallbackRecheckInterval time.Duration
 }
 
 func (t *SenderTemplate) GetURL(
@@ -62,17 +81,18 @@ func (s *senderFactory) asFFStream() *FFStream {
 type SendingNodeAbstract interface {
 	streammux.SendingNode[CustomData]
 	streammux.SetDropOnCloser
+
+	// GetURLs returns the URLs the node sends the stream to.
+	GetURLs() []string
 }
 
 func (s *senderFactory) NewSender(
 	ctx context.Context,
 	outputKey streammux.SenderKey,
 ) (streammux.SendingNode[CustomData], streammuxtypes.SenderConfig, error) {
-	if len(s.OutputTemplates) != 1 {
-		return nil, streammuxtypes.SenderConfig{}, fmt.Errorf("exactly one output template is required, got %d", len(s.OutputTemplates))
+	if len(s.OutputTemplates) == 0 {
+		return nil, streammuxtypes.SenderConfig{}, fmt.Errorf("at least one output template is required")
 	}
-	outputTemplate := s.OutputTemplates[0]
-	outputURL := outputTemplate.GetURL(ctx, outputKey)
 	var sendBufSize uint
 	if ffstream := s.asFFStream(); ffstream != nil {
 		if streamMux := ffstream.StreamMux; streamMux != nil {
@@ -90,21 +110,58 @@ func (s *senderFactory) NewSender(
 			}
 		}
 	}
-	if outputTemplate.RetryOutputTimeoutOnFailure != 0 {
-		return s.newOutputWithRetry(ctx, outputTemplate, outputURL, sendBufSize, outputTemplate.RetryOutputTimeoutOnFailure)
+	if len(s.OutputTemplates) == 1 {
+		return s.newDestination(ctx, 0, s.OutputTemplates[0], outputKey, sendBufSize)
+	}
+
+	// multiple outputs: encoding once, and sending the result to every destination
+	var destinations []SendingNodeAbstract
+	for idx, outputTemplate := range s.OutputTemplates {
+		dst, _, err := s.newDestination(ctx, idx, outputTemplate, outputKey, sendBufSize)
+		if err != nil {
+			for _, dst := range destinations {
+				if err := dst.GetProcessor().Close(ctx); err != nil {
+					logger.Errorf(ctx, "unable to close destination %s: %v", dst, err)
+				}
+			}
+			return nil, streammuxtypes.SenderConfig{}, fmt.Errorf("unable to create destination #%d: %w", idx, err)
+		}
+		destinations = append(destinations, dst)
+	}
+	return newNodeFanOutWrapper(ctx, destinations), streammuxtypes.SenderConfig{}, nil
+}
+
+func (s *senderFactory) newDestination(
+	ctx context.Context,
+	templateIdx int,
+	outputTemplate SenderTemplate,
+	outputKey streammux.SenderKey,
+	sendBufSize uint,
+) (SendingNodeAbstract, streammuxtypes.SenderConfig, error) {
+	outputURL := outputTemplate.GetURL(ctx, outputKey)
+	// only one destination is measured, otherwise the measured quality
+	// would be multiplied by the amount of destinations
+	measureQuality := templateIdx == OutputQualityDestination
+	if len(outputTemplate.Fallbacks) > 0 {
+		return s.newOutputWithFallback(ctx, templateIdx, outputTemplate, outputKey, sendBufSize, measureQuality)
+	}
+	if _, ok := s.asFFStream().getOutputRetryPolicy(ctx, outputTemplate); ok {
+		return s.newOutputWithRetry(ctx, templateIdx, outputTemplate, outputKey, sendBufSize, measureQuality)
 	}
-	return s.newOutput(ctx, outputTemplate, outputURL, sendBufSize)
+	return s.newOutput(ctx, outputTemplate, outputKey, outputURL, sendBufSize, measureQuality)
 }
 
 func (s *senderFactory) newOutputKernel(
 	ctx context.Context,
 	outputTemplate SenderTemplate,
+	outputKey streammux.SenderKey,
 	outputURL string,
 	bufSize uint,
+	measureQuality bool,
 ) (_ret *kernel.Output, _err error) {
-	logger.Debugf(ctx, "newOutputKernel(ctx, %#+v, %q, %d)", outputTemplate, outputURL, bufSize)
+	logger.Debugf(ctx, "newOutputKernel(ctx, %#+v, %#+v, %q, %d, %v)", outputTemplate, outputKey, outputURL, bufSize, measureQuality)
 	defer func() {
-		logger.Debugf(ctx, "/newOutputKernel(ctx, %#+v, %q, %d): %#+v, %v", outputTemplate, outputURL, bufSize, _ret, _err)
+		logger.Debugf(ctx, "/newOutputKernel(ctx, %#+v, %#+v, %q, %d, %v): %#+v, %v", outputTemplate, outputKey, outputURL, bufSize, measureQuality, _ret, _err)
 	}()
 	waitForStreams := kernel.OutputConfigWaitForOutputStreams{}
 	if s.StreamMux != nil {
@@ -121,6 +178,12 @@ func (s *senderFactory) newOutputKernel(
 			return nil, fmt.Errorf("unknown mux mode: %q", s.StreamMux.MuxMode)
 		}
 	}
+	if s.Filters != nil && s.Filters.isMissingTrackFilled(ctx, outputKey.VideoCodec, outputKey.AudioCodec) {
+		// a missing track is synthesized, so the header is written
+		// only when it is there, see MissingTrackFill
+		waitForStreams.MinStreamsVideo = 1
+		waitForStreams.MinStreamsAudio = 1
+	}
 	cfg := kernel.OutputConfig{
 		CustomOptions:                 outputTemplate.Options,
 		SendBufferSize:                bufSize,
@@ -138,65 +201,95 @@ func (s *senderFactory) newOutputKernel(
 		return nil, fmt.Errorf("unable to create output from URL %q: %w", outputURL, err)
 	}
 
-	outputKernel.Filter = s.OutputQualityMeasurer
+	if measureQuality {
+		outputKernel.Filter = s.OutputQualityMeasurer
+	}
 	return outputKernel, nil
 }
 
 func (s *senderFactory) newOutput(
 	ctx context.Context,
 	outputTemplate SenderTemplate,
+	outputKey streammux.SenderKey,
 	outputURL string,
 	bufSize uint,
+	measureQuality bool,
 ) (_ret0 SendingNodeAbstract, _ret1 streammuxtypes.SenderConfig, _err error) {
-	logger.Debugf(ctx, "newOutput(ctx, %#+v, %q, %d)", outputTemplate, outputURL, bufSize)
+	logger.Debugf(ctx, "newOutput(ctx, %#+v, %#+v, %q, %d, %v)", outputTemplate, outputKey, outputURL, bufSize, measureQuality)
 	defer func() {
-		logger.Debugf(ctx, "/newOutput(ctx, %#+v, %q, %d): %#+v, %#+v, %v", outputTemplate, outputURL, bufSize, _ret0, _ret1, _err)
+		logger.Debugf(ctx, "/newOutput(ctx, %#+v, %#+v, %q, %d, %v): %#+v, %#+v, %v", outputTemplate, outputKey, outputURL, bufSize, measureQuality, _ret0, _ret1, _err)
 	}()
 
-	outputKernel, err := s.newOutputKernel(ctx, outputTemplate, outputURL, bufSize)
+	outputKernel, err := s.newOutputKernel(ctx, outputTemplate, outputKey, outputURL, bufSize, measureQuality)
 	if err != nil {
 		return nil, streammuxtypes.SenderConfig{}, fmt.Errorf("unable to create output kernel: %w", err)
 	}
 	outputNode := node.NewWithCustomDataFromKernel[streammux.OutputCustomData[CustomData]](ctx, outputKernel, processor.DefaultOptionsOutput()...)
-	return nodeSetDropOnCloserWrapper{outputNode}, streammuxtypes.SenderConfig{}, nil
+	return nodeSetDropOnCloserWrapper{SendingNode: outputNode, URL: outputURL}, streammuxtypes.SenderConfig{}, nil
 }
 
 func (s *senderFactory) newOutputWithRetry(
 	ctx context.Context,
+	destination int,
 	outputTemplate SenderTemplate,
-	outputURL string,
+	outputKey streammux.SenderKey,
 	bufSize uint,
-	retryTimeout time.Duration,
+	measureQuality bool,
 ) (_ret0 SendingNodeAbstract, _ret1 streammuxtypes.SenderConfig, _err error) {
-	logger.Debugf(ctx, "newOutputWithRetry(ctx, %#+v, %q, %d, %v)", outputTemplate, outputURL, bufSize, retryTimeout)
+	logger.Debugf(ctx, "newOutputWithRetry(ctx, %d, %#+v, %#+v, %d, %v)", destination, outputTemplate, outputKey, bufSize, measureQuality)
 	defer func() {
-		logger.Debugf(ctx, "/newOutputWithRetry(ctx, %#+v, %q, %d, %v): %#+v, %#+v, %v", outputTemplate, outputURL, bufSize, retryTimeout, _ret0, _ret1, _err)
+		logger.Debugf(ctx, "/newOutputWithRetry(ctx, %d, %#+v, %#+v, %d, %v): %#+v, %#+v, %v", destination, outputTemplate, outputKey, bufSize, measureQuality, _ret0, _ret1, _err)
 	}()
-	var errorsStartedAt time.Time
+	ffStream := s.asFFStream()
+	counters := &ffStream.OutputRetryCounters
+	var retry retryState
+	var urlLocker xsync.Mutex
+	outputURL := outputTemplate.GetURL(ctx, outputKey)
 	outputKernel := kernel.NewRetryable(
 		ctx,
 		func(ctx context.Context) (_ret *kernel.Output, _err error) {
-			outputKernel, err := s.newOutputKernel(ctx, outputTemplate, outputURL, bufSize)
+			counters.Attempts.Add(1)
+			// the URL may be changed by ApplyConfig, see reconnectOutputs
+			t := outputTemplate
+			if urlTemplates := ffStream.getOutputURLTemplates(destination); len(urlTemplates) == 1 {
+				t.URLTemplate = urlTemplates[0]
+			}
+			url := t.GetURL(ctx, outputKey)
+			urlLocker.Do(ctx, func() {
+				outputURL = url
+			})
+			outputKernel, err := s.newOutputKernel(ctx, t, outputKey, url, bufSize, measureQuality)
 			if err != nil {
 				return nil, fmt.Errorf("(retryable-node:) unable to create output kernel: %w", err)
 			}
 			return outputKernel, nil
 		},
 		func(ctx context.Context, k *kernel.Output, err error) error {
-			now := time.Now()
-			if errorsStartedAt.IsZero() {
-				errorsStartedAt = now
+			policy, _ := ffStream.getOutputRetryPolicy(ctx, outputTemplate)
+			delay, ok := retry.next(policy, time.Now(), counters)
+			if !ok {
+				logger.Errorf(ctx, "the retries are exhausted (%v), not retrying output anymore", policy)
+				return ffStream.onRetriesExhausted(ctx, RetryTargetOutput, policy, err)
 			}
-			if now.Sub(errorsStartedAt) > retryTimeout {
-				logger.Errorf(ctx, "retry timeout exceeded (%v), not retrying output anymore", retryTimeout)
+			logger.Debugf(ctx, "connection ended, retrying in %v: %v", delay, err)
+			ffStream.publishEvent(ctx, OutputReconnectingEvent{
+				Time:        time.Now(),
+				SenderKey:   outputKey,
+				Destination: destination,
+				URL: xsync.DoR1(ctx, &urlLocker, func() string {
+					return outputURL
+				}),
+				Delay: delay,
+				Err:   err,
+			})
+			if err := sleepCtx(ctx, delay); err != nil {
 				return err
 			}
-			logger.Debugf(ctx, "connection ended: %v", err)
-			time.Sleep(100 * time.Millisecond)
 			return kernel.ErrRetry{Err: err}
 		},
 		kernel.RetryableOptionOnKernelOpen[*kernel.Output](func(ctx context.Context, k *kernel.Output) error {
-			errorsStartedAt = time.Time{}
+			counters.Successes.Add(1)
+			retry.reset()
 			return nil
 		}),
 	)
@@ -204,5 +297,13 @@ func (s *senderFactory) newOutputWithRetry(
 	retryOutputNode := node.NewWithCustomDataFromKernel[streammux.OutputCustomData[CustomData]](
 		ctx, outputKernel, processor.DefaultOptionsOutput()...,
 	)
-	return nodeWithRetrySetDropOnCloserWrapper{retryOutputNode}, streammuxtypes.SenderConfig{}, nil
+	return nodeWithRetrySetDropOnCloserWrapper{
+		SendingNodeWithRetry: retryOutputNode,
+		URL:                  outputURL,
+		CurrentURL: func() string {
+			return xsync.DoR1(context.Background(), &urlLocker, func() string {
+				return outputURL
+			})
+		},
+	}, streammuxtypes.SenderConfig{}, nil
 }
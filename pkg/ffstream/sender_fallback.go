@@ -0,0 +1,408 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/kernel"
+	"github.com/xaionaro-go/avpipeline/node"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	"github.com/xaionaro-go/avpipeline/processor"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/xsync"
+)
+
+// DefaultOutputFallbackRecheckInterval is how often the primary output is probed
+// while a fallback output is used (see SenderTemplate.FallbackRecheckInterval).
+const DefaultOutputFallbackRecheckInterval = 10 * time.Second
+
+// outputFallbackProbeSuccesses is how many consecutive probes of the primary
+// output should succeed to switch back to it, so that a flapping primary
+// output does not make the stream to switch back and forth.
+const outputFallbackProbeSuccesses = 3
+
+// outputFallbackProbeTimeout limits how long a probe may wait for
+// the primary output to accept the connection.
+const outputFallbackProbeTimeout = 5 * time.Second
+
+// OutputSwitchEvent is reported when an output switches between the URLs
+// of its fallback chain.
+type OutputSwitchEvent struct {
+	Time      time.Time
+	SenderKey streammux.SenderKey
+
+	// Destination is the index of the primary output template.
+	Destination int
+
+	FromPriority uint
+	FromURL      string
+	ToPriority   uint
+	ToURL        string
+	Reason       string
+}
+
+// outputFallbackChain is the state of an output with fallbacks: the stream is
+// sent to the first healthy URL of the chain, and the primary URL is probed
+// periodically while a fallback is used, to switch back to it.
+type outputFallbackChain struct {
+	senderFactory  *senderFactory
+	destination    int
+	senderKey      streammux.SenderKey
+	templates      []SenderTemplate
+	urls           []string
+	bufSize        uint
+	measureQuality bool
+	recheck        time.Duration
+
+	locker          xsync.Mutex
+	current         int
+	retry           retryState
+	isProbing       bool
+	probeSuccesses  int
+	isSwitchingBack bool
+	isReconnecting  bool
+	retryKernel     *kernel.Retryable[*kernel.Output]
+}
+
+func (s *senderFactory) newOutputWithFallback(
+	ctx context.Context,
+	destination int,
+	outputTemplate SenderTemplate,
+	outputKey streammux.SenderKey,
+	bufSize uint,
+	measureQuality bool,
+) (_ret0 SendingNodeAbstract, _ret1 streammuxtypes.SenderConfig, _err error) {
+	logger.Debugf(ctx, "newOutputWithFallback(ctx, %d, %#+v, %#+v, %d, %v)", destination, outputTemplate, outputKey, bufSize, measureQuality)
+	defer func() {
+		logger.Debugf(ctx, "/newOutputWithFallback(ctx, %d, %#+v, %#+v, %d, %v): %#+v, %#+v, %v", destination, outputTemplate, outputKey, bufSize, measureQuality, _ret0, _ret1, _err)
+	}()
+
+	c := &outputFallbackChain{
+		senderFactory:  s,
+		destination:    destination,
+		senderKey:      outputKey,
+		templates:      append([]SenderTemplate{outputTemplate}, outputTemplate.Fallbacks...),
+		bufSize:        bufSize,
+		measureQuality: measureQuality,
+		recheck:        outputTemplate.FallbackRecheckInterval,
+	}
+	if c.recheck <= 0 {
+		c.recheck = DefaultOutputFallbackRecheckInterval
+	}
+	for _, t := range c.templates {
+		c.urls = append(c.urls, t.GetURL(ctx, outputKey))
+	}
+
+	c.retryKernel = kernel.NewRetryable(
+		ctx,
+		c.newKernel,
+		c.onError,
+		kernel.RetryableOptionOnKernelOpen[*kernel.Output](func(ctx context.Context, k *kernel.Output) error {
+			c.senderFactory.asFFStream().OutputRetryCounters.Successes.Add(1)
+			c.locker.Do(ctx, func() {
+				c.retry.reset()
+			})
+			return nil
+		}),
+	)
+
+	retryOutputNode := node.NewWithCustomDataFromKernel[streammux.OutputCustomData[CustomData]](
+		ctx, c.retryKernel, processor.DefaultOptionsOutput()...,
+	)
+	return nodeWithFallbackWrapper{
+		nodeWithRetrySetDropOnCloserWrapper: nodeWithRetrySetDropOnCloserWrapper{
+			SendingNodeWithRetry: retryOutputNode,
+			URL:                  c.urls[0],
+		},
+		Chain: c,
+	}, streammuxtypes.SenderConfig{}, nil
+}
+
+func (c *outputFallbackChain) newKernel(ctx context.Context) (*kernel.Output, error) {
+	t, url := xsync.DoR2(ctx, &c.locker, func() (SenderTemplate, string) {
+		c.isReconnecting = false
+		c.refreshURLsLocked(ctx)
+		if c.isSwitchingBack {
+			c.isSwitchingBack = false
+			c.switchToLocked(ctx, 0, "the primary output is healthy again")
+		}
+		return c.templates[c.current], c.urls[c.current]
+	})
+	c.senderFactory.asFFStream().OutputRetryCounters.Attempts.Add(1)
+	outputKernel, err := c.senderFactory.newOutputKernel(ctx, t, c.senderKey, url, c.bufSize, c.measureQuality)
+	if err != nil {
+		return nil, fmt.Errorf("(fallback-chain:) unable to create output kernel for priority %d: %w", t.FallbackPriority, err)
+	}
+	return outputKernel, nil
+}
+
+func (c *outputFallbackChain) onError(
+	ctx context.Context,
+	_ *kernel.Output,
+	err error,
+) error {
+	ffStream := c.senderFactory.asFFStream()
+	var (
+		delay time.Duration
+		url   string
+	)
+	retryErr := xsync.DoR1(ctx, &c.locker, func() error {
+		defer func() { url = c.urls[c.current] }()
+		if c.isSwitchingBack || c.isReconnecting {
+			// the kernel was closed by the probing or by reconnect, see newKernel
+			return kernel.ErrRetry{Err: err}
+		}
+
+		hasFallback := c.current < len(c.templates)-1
+		policy, ok := ffStream.getOutputRetryPolicy(ctx, c.templates[c.current])
+		// a policy without limits is never exhausted, so if there is a fallback,
+		// it is used right away instead of retrying the failed URL forever;
+		// otherwise the policy is applied to each URL of the chain
+		if ok && (policy.IsLimited() || !hasFallback) {
+			delay, ok = c.retry.next(policy, time.Now(), &ffStream.OutputRetryCounters)
+			if ok && (!c.retry.isExhausted || !hasFallback) {
+				return kernel.ErrRetry{Err: err}
+			}
+			if policy.OnExhausted == RetryExhaustedActionStop {
+				return ffStream.onRetriesExhausted(ctx, RetryTargetOutput, policy, err)
+			}
+		}
+
+		if hasFallback {
+			delay = 0
+			c.switchToLocked(ctx, c.current+1, err.Error())
+			c.startProbingLocked(ctx)
+			return kernel.ErrRetry{Err: err}
+		}
+		logger.Errorf(ctx, "all the outputs of the fallback chain failed, not retrying output anymore")
+		err = fmt.Errorf("%w: all the outputs of the fallback chain failed: %w", ErrRetriesExhausted, err)
+		ffStream.publishEvent(ctx, RetriesExhaustedEvent{
+			Time:        time.Now(),
+			Target:      RetryTargetOutput,
+			OnExhausted: RetryExhaustedActionFallback,
+			Err:         err,
+		})
+		return err
+	})
+	if _, ok := retryErr.(kernel.ErrRetry); !ok {
+		return retryErr
+	}
+	logger.Debugf(ctx, "connection ended, retrying in %v: %v", delay, err)
+	ffStream.publishEvent(ctx, OutputReconnectingEvent{
+		Time:        time.Now(),
+		SenderKey:   c.senderKey,
+		Destination: c.destination,
+		URL:         url,
+		Delay:       delay,
+		Err:         err,
+	})
+	if err := sleepCtx(ctx, delay); err != nil {
+		return err
+	}
+	return retryErr
+}
+
+func (c *outputFallbackChain) switchToLocked(
+	ctx context.Context,
+	idx int,
+	reason string,
+) {
+	ev := OutputSwitchEvent{
+		Time:         time.Now(),
+		SenderKey:    c.senderKey,
+		Destination:  c.destination,
+		FromPriority: c.templates[c.current].FallbackPriority,
+		FromURL:      c.urls[c.current],
+		ToPriority:   c.templates[idx].FallbackPriority,
+		ToURL:        c.urls[idx],
+		Reason:       reason,
+	}
+	logger.Warnf(ctx, "switching output #%d from priority %d to priority %d: %s", c.destination, ev.FromPriority, ev.ToPriority, reason)
+	c.current = idx
+	c.retry.reset()
+	c.senderFactory.asFFStream().publishEvent(ctx, ev)
+}
+
+// startProbingLocked starts probing the primary output (if not started yet);
+// once it is available the chain switches back to it.
+func (c *outputFallbackChain) startProbingLocked(ctx context.Context) {
+	if c.isProbing {
+		return
+	}
+	c.isProbing = true
+	observability.Go(ctx, func(ctx context.Context) {
+		defer c.locker.Do(context.WithoutCancel(ctx), func() {
+			c.isProbing = false
+		})
+		t := time.NewTicker(c.recheck)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+			isOnPrimary := xsync.DoR1(ctx, &c.locker, func() bool {
+				return c.current == 0
+			})
+			if isOnPrimary {
+				return
+			}
+			isHealthy := c.probePrimary(ctx)
+			shouldSwitchBack := xsync.DoR1(ctx, &c.locker, func() bool {
+				if c.isSwitchingBack {
+					// the previous attempt to switch back is still pending
+					return true
+				}
+				if !c.onProbeResultLocked(isHealthy) {
+					return false
+				}
+				c.isSwitchingBack = true
+				return true
+			})
+			if !shouldSwitchBack {
+				continue
+			}
+			// closing the current kernel makes the retryable kernel to reopen,
+			// and newKernel redirects it to the primary output; if it is being
+			// reopened right now, this is repeated on the next tick
+			closeRetryableOutputKernel(ctx, c.retryKernel)
+		}
+	})
+}
+
+// onProbeResultLocked records the result of a probe of the primary output
+// and returns true if the chain should switch back to it.
+func (c *outputFallbackChain) onProbeResultLocked(isHealthy bool) bool {
+	if !isHealthy {
+		c.probeSuccesses = 0
+		return false
+	}
+	c.probeSuccesses++
+	if c.probeSuccesses < outputFallbackProbeSuccesses {
+		return false
+	}
+	c.probeSuccesses = 0
+	return true
+}
+
+// reconnect reopens the current output of the chain, so that the URLs
+// changed by FFStream.ApplyConfig are used.
+func (c *outputFallbackChain) reconnect(ctx context.Context) {
+	c.locker.Do(ctx, func() {
+		c.isReconnecting = true
+	})
+	closeRetryableOutputKernel(ctx, c.retryKernel)
+}
+
+// refreshURLsLocked updates the URLs of the chain from the current output
+// templates; only the URLs may be changed on a running output.
+func (c *outputFallbackChain) refreshURLsLocked(ctx context.Context) {
+	urlTemplates := c.senderFactory.asFFStream().getOutputURLTemplates(c.destination)
+	if len(urlTemplates) != len(c.templates) {
+		return
+	}
+	for idx, urlTemplate := range urlTemplates {
+		c.templates[idx].URLTemplate = urlTemplate
+		c.urls[idx] = c.templates[idx].GetURL(ctx, c.senderKey)
+	}
+}
+
+// probePrimary checks if the primary output is available again. A network
+// output is opened (e.g. the RTMP handshake and the publishing of the stream
+// key) and closed right away without sending any packets, so the server
+// sees a short empty session; the attempt is limited by
+// outputFallbackProbeTimeout. A local file is not opened, since that would
+// truncate it, so only its directory is checked.
+func (c *outputFallbackChain) probePrimary(ctx context.Context) bool {
+	t, url := xsync.DoR2(ctx, &c.locker, func() (SenderTemplate, string) {
+		return c.templates[0], c.urls[0]
+	})
+	if path, ok := localFilePath(url); ok {
+		info, err := os.Stat(filepath.Dir(path))
+		if err != nil || !info.IsDir() {
+			logger.Debugf(ctx, "the directory of the primary output %d is still unavailable: %v", c.destination, err)
+			return false
+		}
+		return true
+	}
+	ctx, cancelFn := context.WithTimeout(ctx, outputFallbackProbeTimeout)
+	defer cancelFn()
+	k, err := c.senderFactory.newOutputKernel(ctx, t, c.senderKey, url, c.bufSize, false)
+	if err != nil {
+		logger.Debugf(ctx, "the primary output %d is still unavailable: %v", c.destination, err)
+		return false
+	}
+	if err := k.Close(ctx); err != nil {
+		logger.Debugf(ctx, "unable to close the probe of the primary output %d: %v", c.destination, err)
+	}
+	return true
+}
+
+// localFilePath returns the path if the output URL is a local file,
+// that is a path or a "file:" URL.
+func localFilePath(outputURL string) (string, bool) {
+	if path, ok := strings.CutPrefix(outputURL, "file:"); ok {
+		return path, true
+	}
+	u, err := url.Parse(outputURL)
+	if err != nil || u.Scheme == "" || len(u.Scheme) == 1 {
+		// a one-letter scheme is a Windows drive letter
+		return outputURL, true
+	}
+	return "", false
+}
+
+func (c *outputFallbackChain) getCurrentURL(ctx context.Context) string {
+	return xsync.DoR1(ctx, &c.locker, func() string {
+		return c.urls[c.current]
+	})
+}
+
+type nodeWithFallbackWrapper struct {
+	nodeWithRetrySetDropOnCloserWrapper
+	Chain *outputFallbackChain
+}
+
+var _ SendingNodeAbstract = (*nodeWithFallbackWrapper)(nil)
+
+// GetURLs returns the URL of the chain currently used.
+func (n nodeWithFallbackWrapper) GetURLs() []string {
+	return []string{n.Chain.getCurrentURL(context.Background())}
+}
+
+func (n nodeWithFallbackWrapper) String() string {
+	return fmt.Sprintf("FallbackChain(%s)", n.OriginalNode())
+}
+
+// SubscribeOutputSwitches returns a channel of the switches between the URLs
+// of the output fallback chains; the channel is closed when ctx is cancelled.
+// Slow subscribers miss events rather than block the outputs.
+func (s *FFStream) SubscribeOutputSwitches(
+	ctx context.Context,
+) <-chan OutputSwitchEvent {
+	events := s.SubscribeEvents(ctx)
+	ch := make(chan OutputSwitchEvent, 16)
+	observability.Go(ctx, func(ctx context.Context) {
+		defer close(ch)
+		for ev := range events {
+			outputSwitch, ok := ev.(OutputSwitchEvent)
+			if !ok {
+				continue
+			}
+			select {
+			case ch <- outputSwitch:
+			default:
+				logger.Warnf(ctx, "an output switch subscriber is too slow, dropping the event")
+			}
+		}
+	})
+	return ch
+}
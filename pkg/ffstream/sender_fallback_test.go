@@ -0,0 +1,115 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/avpipeline/kernel"
+)
+
+func TestOutputFallbackChainProbeDebounce(t *testing.T) {
+	c := &outputFallbackChain{}
+	for _, tc := range []struct {
+		isHealthy        bool
+		shouldSwitchBack bool
+	}{
+		{true, false},
+		{true, false},
+		{false, false},
+		{true, false},
+		{true, false},
+		{true, true},
+		{true, false},
+	} {
+		if got := c.onProbeResultLocked(tc.isHealthy); got != tc.shouldSwitchBack {
+			t.Fatalf("expected %v after a probe (healthy=%v), got %v", tc.shouldSwitchBack, tc.isHealthy, got)
+		}
+	}
+}
+
+func TestLocalFilePath(t *testing.T) {
+	for _, tc := range []struct {
+		url    string
+		path   string
+		isFile bool
+	}{
+		{"/data/recording.ts", "/data/recording.ts", true},
+		{"recording.ts", "recording.ts", true},
+		{"file:/data/recording.ts", "/data/recording.ts", true},
+		{`C:\data\recording.ts`, `C:\data\recording.ts`, true},
+		{"rtmp://primary.example/live/key", "", false},
+		{"srt://primary.example:9000", "", false},
+	} {
+		t.Run(tc.url, func(t *testing.T) {
+			path, isFile := localFilePath(tc.url)
+			if path != tc.path || isFile != tc.isFile {
+				t.Fatalf("expected (%q, %v), got (%q, %v)", tc.path, tc.isFile, path, isFile)
+			}
+		})
+	}
+}
+
+func TestOutputFallbackChainProbeLocalFile(t *testing.T) {
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		url       string
+		isHealthy bool
+	}{
+		{filepath.Join(dir, "recording.ts"), true},
+		{filepath.Join(dir, "missing", "recording.ts"), false},
+	} {
+		c := &outputFallbackChain{
+			templates: []SenderTemplate{{URLTemplate: tc.url}},
+			urls:      []string{tc.url},
+		}
+		if got := c.probePrimary(context.Background()); got != tc.isHealthy {
+			t.Fatalf("expected healthy=%v for %q, got %v", tc.isHealthy, tc.url, got)
+		}
+		if matches, _ := filepath.Glob(filepath.Join(dir, "*")); len(matches) != 0 {
+			t.Fatalf("the probe should not create files, but got %v", matches)
+		}
+	}
+}
+
+func TestOutputFallbackChainLimitlessPolicy(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	policy := DefaultRetryPolicy()
+	policy.InitialInterval = time.Millisecond
+	policy.MaxInterval = time.Millisecond
+	if policy.IsLimited() {
+		t.Fatalf("expected a policy without limits, got %v", policy)
+	}
+	c := &outputFallbackChain{
+		senderFactory: (&FFStream{}).asSenderFactory(),
+		templates: []SenderTemplate{
+			{URLTemplate: "rtmp://primary.example/live/key", RetryPolicy: &policy},
+			{URLTemplate: "rtmp://secondary.example/live/key", FallbackPriority: 1, RetryPolicy: &policy},
+		},
+		urls:    []string{"rtmp://primary.example/live/key", "rtmp://secondary.example/live/key"},
+		recheck: time.Hour,
+	}
+
+	err := c.onError(ctx, nil, errors.New("connection refused"))
+	if _, ok := err.(kernel.ErrRetry); !ok {
+		t.Fatalf("expected a retry, got %v", err)
+	}
+	if c.current != 1 {
+		t.Fatalf("expected to switch to the fallback on the first failure, but the current URL is #%d", c.current)
+	}
+
+	// the last URL of the chain is retried according to the policy
+	for range 3 {
+		err := c.onError(ctx, nil, errors.New("connection refused"))
+		if _, ok := err.(kernel.ErrRetry); !ok {
+			t.Fatalf("expected a retry, got %v", err)
+		}
+		if c.current != 1 {
+			t.Fatalf("expected to stay on the fallback, but the current URL is #%d", c.current)
+		}
+	}
+}
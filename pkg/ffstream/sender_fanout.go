@@ -0,0 +1,226 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/kernel"
+	"github.com/xaionaro-go/avpipeline/node"
+	"github.com/xaionaro-go/avpipeline/packetorframe"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+	"github.com/xaionaro-go/avpipeline/processor"
+	"github.com/xaionaro-go/xsync"
+)
+
+// FanOutNode is the sending node given to the StreamMux when there are
+// multiple output templates: it receives the encoded stream once and pushes it
+// to every destination node.
+type FanOutNode = node.NodeWithCustomData[streammux.OutputCustomData[CustomData], *processor.FromKernel[*kernel.MapStreamIndices]]
+
+type nodeFanOutWrapper struct {
+	*FanOutNode
+
+	// Destinations are the sending nodes (one per output template, in the same order),
+	// each one having its own retry logic and own counters.
+	Destinations []SendingNodeAbstract
+
+	// failures are the errors the destinations were detached because of,
+	// see detachFailedDestination.
+	failures *fanOutFailures
+}
+
+type fanOutFailures struct {
+	Locker xsync.Mutex
+	Errs   []error
+}
+
+var _ SendingNodeAbstract = (*nodeFanOutWrapper)(nil)
+
+func newNodeFanOutWrapper(
+	ctx context.Context,
+	destinations []SendingNodeAbstract,
+) nodeFanOutWrapper {
+	fanOutNode := node.NewWithCustomDataFromKernel[streammux.OutputCustomData[CustomData]](
+		ctx,
+		kernel.NewMapStreamIndices(ctx, passthroughStreamIndexAssigner{}),
+		processor.DefaultOptionsOutput()...,
+	)
+	for _, dst := range destinations {
+		fanOutNode.AddPushPacketsTo(ctx, dst)
+	}
+	return nodeFanOutWrapper{
+		FanOutNode:   fanOutNode,
+		Destinations: destinations,
+		failures: &fanOutFailures{
+			Errs: make([]error, len(destinations)),
+		},
+	}
+}
+
+// getDestinationErr returns the error destination #idx was detached because
+// of, or nil if the destination is working.
+func (n nodeFanOutWrapper) getDestinationErr(
+	ctx context.Context,
+	idx int,
+) error {
+	return xsync.DoR1(ctx, &n.failures.Locker, func() error {
+		return n.failures.Errs[idx]
+	})
+}
+
+// detachFailedDestination isolates the failure of a destination from
+// the other ones: if errNode is one of the destinations, it stops pushing
+// packets to it and closes it, so that the shared encoder keeps sending
+// the stream to the rest of destinations.
+//
+// It returns false if errNode is not a destination of this fan-out, or
+// if it is the last working destination (so there is nothing to continue with).
+func (n nodeFanOutWrapper) detachFailedDestination(
+	ctx context.Context,
+	errNode node.Abstract,
+	err error,
+) (_ret int, _ok bool) {
+	logger.Debugf(ctx, "detachFailedDestination(ctx, %s, %v)", errNode, err)
+	defer func() { logger.Debugf(ctx, "/detachFailedDestination(ctx, %s, %v): %d, %v", errNode, err, _ret, _ok) }()
+	errNode = unwrapNode(errNode)
+	for idx, dst := range n.Destinations {
+		if unwrapNode(dst) != errNode {
+			continue
+		}
+		var isNew, isLast bool
+		n.failures.Locker.Do(ctx, func() {
+			if n.failures.Errs[idx] != nil {
+				return
+			}
+			isNew, isLast = true, true
+			for otherIdx, otherErr := range n.failures.Errs {
+				if otherIdx != idx && otherErr == nil {
+					isLast = false
+				}
+			}
+			n.failures.Errs[idx] = err
+		})
+		switch {
+		case !isNew:
+			return idx, true
+		case isLast:
+			return idx, false
+		}
+		if err := n.FanOutNode.RemovePushPacketsTo(ctx, dst); err != nil {
+			logger.Errorf(ctx, "unable to detach destination #%d (%s): %v", idx, dst, err)
+		}
+		if err := dst.GetProcessor().Close(ctx); err != nil {
+			logger.Errorf(ctx, "unable to close destination #%d (%s): %v", idx, dst, err)
+		}
+		return idx, true
+	}
+	return -1, false
+}
+
+func (n nodeFanOutWrapper) SetDropOnClose(
+	ctx context.Context,
+	dropOnClose bool,
+) (_err error) {
+	logger.Debugf(ctx, "SetDropOnClose(ctx, %v)", dropOnClose)
+	defer func() { logger.Debugf(ctx, "/SetDropOnClose(ctx, %v): %v", dropOnClose, _err) }()
+	var errs []error
+	for idx, dst := range n.Destinations {
+		if err := dst.SetDropOnClose(ctx, dropOnClose); err != nil {
+			errs = append(errs, fmt.Errorf("destination #%d: %w", idx, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n nodeFanOutWrapper) GetURLs() []string {
+	var urls []string
+	for _, dst := range n.Destinations {
+		urls = append(urls, dst.GetURLs()...)
+	}
+	return urls
+}
+
+func (n nodeFanOutWrapper) String() string {
+	var dsts []string
+	for _, dst := range n.Destinations {
+		dsts = append(dsts, dst.String())
+	}
+	return fmt.Sprintf("FanOut(%s)", strings.Join(dsts, ", "))
+}
+
+func (n nodeFanOutWrapper) OriginalNode() *FanOutNode {
+	return n.FanOutNode
+}
+
+func (n nodeFanOutWrapper) OriginalNodeAbstract() node.Abstract {
+	r := n.OriginalNode()
+	if r == nil {
+		return nil
+	}
+	return r
+}
+
+// detachFailedDestination detaches the failed node if it is a destination
+// of a fan-out output (see nodeFanOutWrapper.detachFailedDestination), so
+// that a failure of one destination does not stop the whole stream.
+func (s *FFStream) detachFailedDestination(
+	ctx context.Context,
+	err node.Error,
+) bool {
+	if s.StreamMux == nil || err.Node == nil {
+		return false
+	}
+	var isDetached bool
+	s.StreamMux.Outputs.Range(func(id streammux.OutputID, output *streammux.Output[CustomData]) bool {
+		fanOut, ok := output.SendingNode.(nodeFanOutWrapper)
+		if !ok {
+			return true
+		}
+		idx, ok := fanOut.detachFailedDestination(ctx, err.Node, err.Err)
+		if !ok {
+			return true
+		}
+		logger.Errorf(ctx, "destination #%d of output %d failed, continuing with the other destinations: %v", idx, id, err.Err)
+		isDetached = true
+		return false
+	})
+	return isDetached
+}
+
+// getDestinations returns the destinations of a sending node: the sending
+// node itself, unless it is a fan-out to multiple output templates.
+func getDestinations(sendingNode SendingNodeAbstract) []SendingNodeAbstract {
+	if fanOut, ok := sendingNode.(nodeFanOutWrapper); ok {
+		return fanOut.Destinations
+	}
+	return []SendingNodeAbstract{sendingNode}
+}
+
+// unwrapNode returns the original node of a wrapper (e.g. of
+// nodeSetDropOnCloserWrapper), so that wrapped nodes could be compared.
+func unwrapNode(n node.Abstract) node.Abstract {
+	wrapper, ok := n.(interface{ OriginalNodeAbstract() node.Abstract })
+	if !ok {
+		return n
+	}
+	if orig := wrapper.OriginalNodeAbstract(); orig != nil {
+		return orig
+	}
+	return n
+}
+
+// passthroughStreamIndexAssigner keeps stream indexes as is; it is used
+// to get a kernel that just forwards packets to the destinations.
+type passthroughStreamIndexAssigner struct{}
+
+var _ kernel.StreamIndexAssigner = passthroughStreamIndexAssigner{}
+
+func (passthroughStreamIndexAssigner) StreamIndexAssign(
+	_ context.Context,
+	in packetorframe.InputUnion,
+) ([]int, error) {
+	return []int{in.GetStreamIndex()}, nil
+}
@@ -0,0 +1,86 @@
+package ffstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
+)
+
+func TestNodeFanOutWrapperDetachFailedDestination(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	s := (&FFStream{}).asSenderFactory()
+	dir := t.TempDir()
+	var (
+		destinations []SendingNodeAbstract
+		urls         []string
+	)
+	for idx := range 3 {
+		url := filepath.Join(dir, fmt.Sprintf("destination%d.ts", idx))
+		dst, _, err := s.newOutput(ctx, SenderTemplate{URLTemplate: url}, streammux.SenderKey{}, url, 0, false)
+		if err != nil {
+			t.Fatalf("unable to create destination #%d: %v", idx, err)
+		}
+		destinations = append(destinations, dst)
+		urls = append(urls, url)
+	}
+	fanOut := newNodeFanOutWrapper(ctx, destinations)
+	defer fanOut.GetProcessor().Close(ctx)
+
+	if got := fanOut.GetURLs(); !slices.Equal(got, urls) {
+		t.Fatalf("expected URLs %v, got %v", urls, got)
+	}
+	if got := getDestinations(fanOut); len(got) != len(destinations) {
+		t.Fatalf("expected %d destinations, got %d", len(destinations), len(got))
+	}
+	if got := getDestinations(destinations[0]); len(got) != 1 || unwrapNode(got[0]) != unwrapNode(destinations[0]) {
+		t.Fatalf("expected a single-template output to be its own destination, got %v", got)
+	}
+
+	if _, ok := fanOut.detachFailedDestination(ctx, fanOut.FanOutNode, errors.New("unrelated")); ok {
+		t.Fatalf("a node that is not a destination must not be detached")
+	}
+
+	// the error is reported by the node itself, not by its wrapper
+	errBroken := errors.New("broken pipe")
+	idx, ok := fanOut.detachFailedDestination(ctx, unwrapNode(destinations[1]), errBroken)
+	if !ok || idx != 1 {
+		t.Fatalf("expected destination #1 to be detached, got #%d (%v)", idx, ok)
+	}
+	for idx := range destinations {
+		expected := error(nil)
+		if idx == 1 {
+			expected = errBroken
+		}
+		if got := fanOut.getDestinationErr(ctx, idx); got != expected {
+			t.Fatalf("expected error %v of destination #%d, got %v", expected, idx, got)
+		}
+	}
+
+	// a repeated error of the detached destination changes nothing
+	if idx, ok := fanOut.detachFailedDestination(ctx, destinations[1], errors.New("another error")); !ok || idx != 1 {
+		t.Fatalf("expected destination #1 to stay detached, got #%d (%v)", idx, ok)
+	}
+	if got := fanOut.getDestinationErr(ctx, 1); got != errBroken {
+		t.Fatalf("expected the first error to be kept, got %v", got)
+	}
+
+	if idx, ok := fanOut.detachFailedDestination(ctx, destinations[0], errBroken); !ok || idx != 0 {
+		t.Fatalf("expected destination #0 to be detached, got #%d (%v)", idx, ok)
+	}
+
+	// the failure of the last working destination is not isolated,
+	// there is nothing to continue with
+	if idx, ok := fanOut.detachFailedDestination(ctx, destinations[2], errBroken); ok || idx != 2 {
+		t.Fatalf("expected the failure of the last destination #2 to stop the stream, got #%d (%v)", idx, ok)
+	}
+	if err := destinations[2].GetProcessor().Close(ctx); err != nil {
+		t.Fatalf("unable to close destination #2: %v", err)
+	}
+}
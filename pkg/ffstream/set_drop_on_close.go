@@ -16,6 +16,7 @@ type SendingNode = node.NodeWithCustomData[streammux.OutputCustomData[CustomData
 
 type nodeSetDropOnCloserWrapper struct {
 	*SendingNode
+	URL string
 }
 
 var _ SendingNodeAbstract = (*nodeSetDropOnCloserWrapper)(nil)
@@ -33,6 +34,10 @@ func (n nodeSetDropOnCloserWrapper) SetDropOnClose(
 	return n.Processor.Kernel.UnsafeSetLinger(ctx, onOff, 0)
 }
 
+func (n nodeSetDropOnCloserWrapper) GetURLs() []string {
+	return []string{n.URL}
+}
+
 func (n nodeSetDropOnCloserWrapper) String() string {
 	return fmt.Sprintf("SetDropOnCloserWrapper(%s)", n.OriginalNode())
 }
@@ -53,6 +58,11 @@ type SendingNodeWithRetry = node.NodeWithCustomData[streammux.OutputCustomData[C
 
 type nodeWithRetrySetDropOnCloserWrapper struct {
 	*SendingNodeWithRetry
+	URL string
+
+	// CurrentURL (if set) returns the URL the node sends the stream to
+	// at the moment, since it may be changed by FFStream.ApplyConfig.
+	CurrentURL func() string
 }
 
 var _ SendingNodeAbstract = (*nodeWithRetrySetDropOnCloserWrapper)(nil)
@@ -81,6 +91,13 @@ func (n nodeWithRetrySetDropOnCloserWrapper) SetDropOnClose(
 	})
 }
 
+func (n nodeWithRetrySetDropOnCloserWrapper) GetURLs() []string {
+	if n.CurrentURL != nil {
+		return []string{n.CurrentURL()}
+	}
+	return []string{n.URL}
+}
+
 func (n nodeWithRetrySetDropOnCloserWrapper) String() string {
 	return fmt.Sprintf("SetDropOnCloserWrapper(%s)", n.OriginalNode())
 }
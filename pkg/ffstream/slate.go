@@ -0,0 +1,325 @@
+package ffstream
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/asticode/go-astiav"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+)
+
+// SlateURLPrefix is the prefix of the URL of a slate input, see ParseSlateURL.
+const SlateURLPrefix = "slate:"
+
+const (
+	slateDefaultWidth      = 1280
+	slateDefaultHeight     = 720
+	slateDefaultFrameRate  = 30
+	slateDefaultSampleRate = 48000
+	slateDefaultColor      = "black"
+	slateToneFrequency     = 1000
+)
+
+// Slate is a generated input (e.g. a "be right back" picture) to be used as
+// the last-resort fallback: a picture and/or a text on a solid background
+// with silent audio (or a tone). It is generated in-process by libavfilter
+// (the "lavfi" input format), so it works without any network or devices.
+type Slate struct {
+	// Image is the path of a picture, scaled to fit the frame; empty means none.
+	Image string
+
+	// Text is the text drawn over the frame; empty means none.
+	Text string
+
+	// Font is the path of the font file of Text; empty means the default font.
+	Font string
+
+	// Color is the background color, in the syntax of FFmpeg (e.g. "black" or "0x202020").
+	Color string
+
+	// Width, Height and FrameRate are the video parameters; zero means
+	// the configured resolution of the video encoder and its "r" option
+	// (or 1280x720 at 30 FPS if they are not configured).
+	Width     uint32
+	Height    uint32
+	FrameRate avptypes.Rational
+
+	// SampleRate is the audio sample rate; zero means the configured sample
+	// rate of the audio encoder (or 48000 if it is not configured).
+	SampleRate uint32
+
+	// ToneFrequency is the frequency (in Hz) of the tone; zero means silence.
+	ToneFrequency float64
+}
+
+// IsSlateURL returns true if the URL is of a slate input, see ParseSlateURL.
+func IsSlateURL(url string) bool {
+	return strings.HasPrefix(url, SlateURLPrefix)
+}
+
+// ParseSlateURL parses a URL like "slate:image=brb.png,text=Be right back,tone=off":
+// a comma-separated list of key=value pairs (a comma or a backslash in a value
+// should be escaped by a backslash). The keys are:
+//
+//	image        the path of a picture
+//	text         the text
+//	font         the path of the font file of the text
+//	color        the background color (black by default)
+//	size         the resolution, e.g. 1920x1080
+//	rate         the frame rate, e.g. 25, 29.97 or 30000/1001
+//	sample_rate  the audio sample rate
+//	tone         "off" (silence, by default), "on" (1000 Hz) or the frequency in Hz
+func ParseSlateURL(url string) (Slate, error) {
+	if !IsSlateURL(url) {
+		return Slate{}, fmt.Errorf("the URL should start with %q", SlateURLPrefix)
+	}
+	sl := Slate{
+		Color: slateDefaultColor,
+	}
+	for _, kv := range splitEscaped(strings.TrimPrefix(url, SlateURLPrefix), ',') {
+		if kv == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return Slate{}, fmt.Errorf("expected key=value, got %q", kv)
+		}
+		var err error
+		switch k {
+		case "image":
+			sl.Image = v
+		case "text":
+			sl.Text = v
+		case "font":
+			sl.Font = v
+		case "color":
+			if v == "" {
+				err = fmt.Errorf("the color is empty")
+			}
+			sl.Color = v
+		case "size":
+			_, err = fmt.Sscanf(v, "%dx%d", &sl.Width, &sl.Height)
+			if err == nil && (sl.Width == 0 || sl.Height == 0) {
+				err = fmt.Errorf("the resolution should be positive")
+			}
+		case "rate":
+			sl.FrameRate, err = parseFrameRate(v)
+		case "sample_rate":
+			sl.SampleRate, err = parsePositiveUint32(v)
+		case "tone":
+			switch v {
+			case "off":
+				sl.ToneFrequency = 0
+			case "on":
+				sl.ToneFrequency = slateToneFrequency
+			default:
+				sl.ToneFrequency, err = strconv.ParseFloat(v, 64)
+				if err == nil && sl.ToneFrequency <= 0 {
+					err = fmt.Errorf("the frequency should be positive")
+				}
+			}
+		default:
+			return Slate{}, fmt.Errorf("unknown key %q", k)
+		}
+		if err != nil {
+			return Slate{}, fmt.Errorf("unable to parse the value of %q: %w", k, err)
+		}
+	}
+	return sl, nil
+}
+
+func parsePositiveUint32(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if v == 0 {
+		return 0, fmt.Errorf("should be positive")
+	}
+	return uint32(v), nil
+}
+
+// parseFrameRate parses a frame rate as an integer,
+// a decimal (e.g. 29.97) or a fraction (e.g. 30000/1001).
+func parseFrameRate(s string) (avptypes.Rational, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return avptypes.Rational{}, fmt.Errorf("invalid frame rate %q", s)
+	}
+	if r.Sign() <= 0 {
+		return avptypes.Rational{}, fmt.Errorf("should be positive")
+	}
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() ||
+		r.Num().Int64() > math.MaxInt32 || r.Denom().Int64() > math.MaxInt32 {
+		return avptypes.Rational{}, fmt.Errorf("the frame rate %q is too precise", s)
+	}
+	return avptypes.Rational{
+		Num: int(r.Num().Int64()),
+		Den: int(r.Denom().Int64()),
+	}, nil
+}
+
+// splitEscaped splits s by sep, unless sep is escaped by a backslash;
+// the escaping backslashes are removed.
+func splitEscaped(s string, sep byte) []string {
+	var (
+		result []string
+		cur    strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			cur.WriteByte(s[i])
+		case c == sep:
+			result = append(result, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	return append(result, cur.String())
+}
+
+// withDefaults returns the slate with the unset video and audio parameters
+// set from the configuration of the encoders.
+func (sl Slate) withDefaults(
+	width, height uint32,
+	frameRate avptypes.Rational,
+	sampleRate uint32,
+) Slate {
+	if sl.Width == 0 || sl.Height == 0 {
+		sl.Width, sl.Height = width, height
+	}
+	if sl.Width == 0 || sl.Height == 0 {
+		sl.Width, sl.Height = slateDefaultWidth, slateDefaultHeight
+	}
+	if sl.FrameRate.Num <= 0 || sl.FrameRate.Den <= 0 {
+		sl.FrameRate = frameRate
+	}
+	if sl.FrameRate.Num <= 0 || sl.FrameRate.Den <= 0 {
+		sl.FrameRate = avptypes.Rational{Num: slateDefaultFrameRate, Den: 1}
+	}
+	if sl.SampleRate == 0 {
+		sl.SampleRate = sampleRate
+	}
+	if sl.SampleRate == 0 {
+		sl.SampleRate = slateDefaultSampleRate
+	}
+	if sl.Color == "" {
+		sl.Color = slateDefaultColor
+	}
+	return sl
+}
+
+// FilterGraph returns the description of the libavfilter graph generating
+// the slate (to be opened with the "lavfi" input format): the video
+// is the output "out0" and the audio is the output "out1". Both are
+// paced in real time.
+func (sl Slate) FilterGraph() string {
+	sl = sl.withDefaults(0, 0, avptypes.Rational{}, 0)
+
+	var video strings.Builder
+	fmt.Fprintf(&video, "color=c=%s:s=%dx%d:r=%d/%d", escapeFilterValue(sl.Color), sl.Width, sl.Height, sl.FrameRate.Num, sl.FrameRate.Den)
+	if sl.Image != "" {
+		fmt.Fprintf(&video, "[bg];movie=filename=%s,scale=w=%d:h=%d:force_original_aspect_ratio=decrease[img];[bg][img]overlay=x=(W-w)/2:y=(H-h)/2",
+			escapeFilterValue(sl.Image), sl.Width, sl.Height,
+		)
+	}
+	if sl.Text != "" {
+		y := "(h-text_h)/2"
+		if sl.Image != "" {
+			y = "h-2*text_h"
+		}
+		fmt.Fprintf(&video, ",drawtext=text=%s:expansion=none:fontcolor=white:fontsize=h/12:x=(w-text_w)/2:y=%s", escapeFilterValue(sl.Text), y)
+		if sl.Font != "" {
+			fmt.Fprintf(&video, ":fontfile=%s", escapeFilterValue(sl.Font))
+		}
+	}
+	video.WriteString(",format=yuv420p,realtime[out0]")
+
+	audio := fmt.Sprintf("anullsrc=r=%d:cl=stereo", sl.SampleRate)
+	if sl.ToneFrequency > 0 {
+		audio = fmt.Sprintf("sine=f=%v:r=%d,aformat=channel_layouts=stereo", sl.ToneFrequency, sl.SampleRate)
+	}
+	return video.String() + ";" + audio + ",arealtime[out1]"
+}
+
+// escapeFilterValue escapes a value of a filter option to be used in
+// a filter graph description: first for the option parser, then for
+// the graph parser (see "Notes on filtergraph escaping" of FFmpeg).
+func escapeFilterValue(s string) string {
+	return escapeChars(escapeChars(s, `\':`), `\'[],;`)
+}
+
+func escapeChars(s string, chars string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if strings.ContainsRune(chars, c) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// registerDevices registers the input formats of libavdevice,
+// which include "lavfi".
+var registerDevices = sync.OnceFunc(astiav.RegisterAllDevices)
+
+// slateResource converts the resource of a slate input to the resource of
+// the "lavfi" input generating it, with the video and audio parameters
+// of the current encoders as the defaults.
+func (s *FFStream) slateResource(
+	ctx context.Context,
+	res Resource,
+) (Resource, error) {
+	sl, err := ParseSlateURL(res.URL)
+	if err != nil {
+		return Resource{}, fmt.Errorf("invalid slate %q: %w", res.URL, err)
+	}
+
+	s.locker.Lock()
+	cfg := s.transcoderConfig
+	s.locker.Unlock()
+	sl = sl.withDefaults(encoderParams(cfg))
+	registerDevices()
+
+	res.URL = sl.FilterGraph()
+	res.CustomOptions = append(slices.Clone(res.CustomOptions), avptypes.DictionaryItem{
+		Key:   "f",
+		Value: "lavfi",
+	})
+	return res, nil
+}
+
+// encoderParams returns the configured resolution and frame rate (the "r"
+// option) of the video encoder and the sample rate of the audio encoder;
+// zero means not configured.
+func encoderParams(
+	cfg streammuxtypes.TranscoderConfig,
+) (width, height uint32, frameRate avptypes.Rational, sampleRate uint32) {
+	if len(cfg.Output.VideoTrackConfigs) > 0 {
+		video := cfg.Output.VideoTrackConfigs[0]
+		width, height = uint32(video.Resolution.Width), uint32(video.Resolution.Height)
+		for _, opt := range video.CustomOptions {
+			if opt.Key != "r" {
+				continue
+			}
+			if v, err := parseFrameRate(opt.Value); err == nil {
+				frameRate = v
+			}
+		}
+	}
+	if len(cfg.Output.AudioTrackConfigs) > 0 {
+		sampleRate = uint32(cfg.Output.AudioTrackConfigs[0].SampleRate)
+	}
+	return width, height, frameRate, sampleRate
+}
@@ -0,0 +1,218 @@
+package ffstream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/asticode/go-astiav"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
+)
+
+func TestParseSlateURL(t *testing.T) {
+	sl, err := ParseSlateURL(`slate:image=/data/brb.png,text=Back soon\, stay tuned,font=/fonts/a.ttf,color=0x202020,size=1920x1080,rate=25,sample_rate=44100,tone=on`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Slate{
+		Image:         "/data/brb.png",
+		Text:          "Back soon, stay tuned",
+		Font:          "/fonts/a.ttf",
+		Color:         "0x202020",
+		Width:         1920,
+		Height:        1080,
+		FrameRate:     avptypes.Rational{Num: 25, Den: 1},
+		SampleRate:    44100,
+		ToneFrequency: slateToneFrequency,
+	}
+	if sl != want {
+		t.Fatalf("expected %#v, got %#v", want, sl)
+	}
+
+	sl, err = ParseSlateURL("slate:")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sl != (Slate{Color: slateDefaultColor}) {
+		t.Fatalf("expected the default slate, got %#v", sl)
+	}
+
+	for _, tc := range []struct {
+		rate string
+		want avptypes.Rational
+	}{
+		{"30", avptypes.Rational{Num: 30, Den: 1}},
+		{"29.97", avptypes.Rational{Num: 2997, Den: 100}},
+		{"30000/1001", avptypes.Rational{Num: 30000, Den: 1001}},
+	} {
+		sl, err := ParseSlateURL("slate:rate=" + tc.rate)
+		if err != nil {
+			t.Fatalf("unexpected error for the rate %q: %v", tc.rate, err)
+		}
+		if sl.FrameRate != tc.want {
+			t.Fatalf("expected the rate %v for %q, got %v", tc.want, tc.rate, sl.FrameRate)
+		}
+	}
+
+	for _, in := range []string{"rtmp://example", "slate:foo=1", "slate:text", "slate:size=0x720", "slate:size=big", "slate:rate=0", "slate:rate=-30000/1001", "slate:rate=1/0", "slate:rate=fast", "slate:sample_rate=-1", "slate:tone=loud", "slate:tone=-5", "slate:color="} {
+		t.Run("invalid_"+in, func(t *testing.T) {
+			if _, err := ParseSlateURL(in); err == nil {
+				t.Fatalf("expected an error for %q", in)
+			}
+		})
+	}
+}
+
+func TestSlateFilterGraph(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		slate Slate
+		want  string
+	}{
+		{
+			name:  "defaults",
+			slate: Slate{},
+			want:  "color=c=black:s=1280x720:r=30/1,format=yuv420p,realtime[out0];anullsrc=r=48000:cl=stereo,arealtime[out1]",
+		},
+		{
+			name: "text_and_tone",
+			slate: Slate{
+				Text:          "Back: soon, [really]",
+				Width:         640,
+				Height:        360,
+				FrameRate:     avptypes.Rational{Num: 25, Den: 1},
+				SampleRate:    44100,
+				ToneFrequency: 440,
+			},
+			want: `color=c=black:s=640x360:r=25/1,drawtext=text=Back\\: soon\, \[really\]:expansion=none:fontcolor=white:fontsize=h/12:x=(w-text_w)/2:y=(h-text_h)/2,format=yuv420p,realtime[out0];` +
+				`sine=f=440:r=44100,aformat=channel_layouts=stereo,arealtime[out1]`,
+		},
+		{
+			name: "image_text_font",
+			slate: Slate{
+				Image: "/data/it's.png",
+				Text:  "brb",
+				Font:  "C:/fonts/a.ttf",
+				Color: "blue",
+			},
+			want: `color=c=blue:s=1280x720:r=30/1[bg];movie=filename=/data/it\\\'s.png,scale=w=1280:h=720:force_original_aspect_ratio=decrease[img];[bg][img]overlay=x=(W-w)/2:y=(H-h)/2,` +
+				`drawtext=text=brb:expansion=none:fontcolor=white:fontsize=h/12:x=(w-text_w)/2:y=h-2*text_h:fontfile=C\\:/fonts/a.ttf,format=yuv420p,realtime[out0];` +
+				`anullsrc=r=48000:cl=stereo,arealtime[out1]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.slate.FilterGraph(); got != tc.want {
+				t.Fatalf("expected:\n%s\ngot:\n%s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSlateWithDefaults(t *testing.T) {
+	ntsc := avptypes.Rational{Num: 30000, Den: 1001}
+	pal := avptypes.Rational{Num: 25, Den: 1}
+	sl := Slate{}.withDefaults(1920, 1080, ntsc, 44100)
+	if sl.Width != 1920 || sl.Height != 1080 || sl.FrameRate != ntsc || sl.SampleRate != 44100 {
+		t.Fatalf("expected the configured parameters, got %#v", sl)
+	}
+	sl = Slate{Width: 640, Height: 360, FrameRate: pal, SampleRate: 48000}.withDefaults(1920, 1080, ntsc, 44100)
+	if sl.Width != 640 || sl.Height != 360 || sl.FrameRate != pal || sl.SampleRate != 48000 {
+		t.Fatalf("expected the parameters of the slate, got %#v", sl)
+	}
+}
+
+func TestEncoderParamsFrameRate(t *testing.T) {
+	for _, tc := range []struct {
+		r    string
+		want avptypes.Rational
+	}{
+		{"60", avptypes.Rational{Num: 60, Den: 1}},
+		{"29.97", avptypes.Rational{Num: 2997, Den: 100}},
+		{"30000/1001", avptypes.Rational{Num: 30000, Den: 1001}},
+		{"invalid", avptypes.Rational{}},
+	} {
+		var cfg streammuxtypes.TranscoderConfig
+		cfg.Output.VideoTrackConfigs = append(cfg.Output.VideoTrackConfigs, streammuxtypes.OutputVideoTrackConfig{
+			CustomOptions: []avptypes.DictionaryItem{{Key: "r", Value: tc.r}},
+		})
+		if _, _, frameRate, _ := encoderParams(cfg); frameRate != tc.want {
+			t.Errorf("expected the frame rate %v for -r %s, got %v", tc.want, tc.r, frameRate)
+		}
+	}
+}
+
+func TestSlateInput(t *testing.T) {
+	ctx := context.Background()
+	res, err := (&FFStream{}).slateResource(ctx, Resource{URL: "slate:size=320x240,rate=30000/1001,sample_rate=44100,tone=on"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputFormat := astiav.FindInputFormat("lavfi")
+	if inputFormat == nil {
+		t.Fatalf("the lavfi input format is not registered")
+	}
+	formatContext := astiav.AllocFormatContext()
+	defer formatContext.Free()
+	if err := formatContext.OpenInput(res.URL, inputFormat, nil); err != nil {
+		t.Fatalf("unable to open the slate %q: %v", res.URL, err)
+	}
+	defer formatContext.CloseInput()
+	if err := formatContext.FindStreamInfo(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	decoders := map[int]*astiav.CodecContext{}
+	for _, stream := range formatContext.Streams() {
+		codec := astiav.FindDecoder(stream.CodecParameters().CodecID())
+		if codec == nil {
+			t.Fatalf("no decoder for %s", stream.CodecParameters().CodecID())
+		}
+		decoder := astiav.AllocCodecContext(codec)
+		defer decoder.Free()
+		if err := stream.CodecParameters().ToCodecContext(decoder); err != nil {
+			t.Fatal(err)
+		}
+		if err := decoder.Open(codec, nil); err != nil {
+			t.Fatal(err)
+		}
+		decoders[stream.Index()] = decoder
+	}
+
+	pkt := astiav.AllocPacket()
+	defer pkt.Free()
+	f := astiav.AllocFrame()
+	defer f.Free()
+	gotFrames := map[astiav.MediaType]bool{}
+	for i := 0; i < 100 && len(gotFrames) < 2; i++ {
+		if err := formatContext.ReadFrame(pkt); err != nil {
+			t.Fatalf("unable to read a packet: %v", err)
+		}
+		decoder, ok := decoders[pkt.StreamIndex()]
+		if !ok {
+			t.Fatalf("unexpected stream %d", pkt.StreamIndex())
+		}
+		err := decoder.SendPacket(pkt)
+		pkt.Unref()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for decoder.ReceiveFrame(f) == nil {
+			mediaType := decoder.MediaType()
+			switch mediaType {
+			case astiav.MediaTypeVideo:
+				if f.Width() != 320 || f.Height() != 240 {
+					t.Fatalf("expected a 320x240 video frame, got %dx%d", f.Width(), f.Height())
+				}
+			case astiav.MediaTypeAudio:
+				if f.SampleRate() != 44100 {
+					t.Fatalf("expected an audio frame at 44100 Hz, got %d Hz", f.SampleRate())
+				}
+			}
+			gotFrames[mediaType] = true
+			f.Unref()
+		}
+	}
+	if !gotFrames[astiav.MediaTypeVideo] || !gotFrames[astiav.MediaTypeAudio] {
+		t.Fatalf("expected a video and an audio frame, got %v", gotFrames)
+	}
+}
@@ -0,0 +1,420 @@
+package ffstream
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/asticode/go-astiav"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+)
+
+// StreamSelector is a parsed ffmpeg-style `-map` argument, e.g. "0:v:0", "1:a", "-0:s"
+// or "[out]" (an output of the `-filter_complex` graph).
+//
+// Unlike in ffmpeg, InputNum is the position of the input within its fallback
+// priority (the same "num" as in InputInfo), so the same `-map` is applied
+// to every fallback level.
+type StreamSelector struct {
+	// Negative means the matching streams are excluded from the preceding selectors.
+	Negative bool
+
+	// Optional means the selector may match nothing (the trailing "?").
+	Optional bool
+
+	InputNum int
+
+	// MediaType is astiav.MediaTypeUnknown if any media type matches.
+	MediaType astiav.MediaType
+
+	// NoAttachedPics is set by the "V" media type: only the video streams
+	// that are not attached pictures (e.g. cover arts) match.
+	NoAttachedPics bool
+
+	// StreamIndex is -1 if any stream matches. If MediaType is set then it is
+	// an index among the streams of that media type (excluding the attached
+	// pictures if NoAttachedPics is set), otherwise it is an absolute stream index.
+	StreamIndex int
+
+	// Label is the label of a `-filter_complex` output, if the selector refers
+	// to one; such a selector matches no input streams, and its MediaType
+	// is resolved by ParseStreamMap.
+	Label string
+}
+
+func ParseStreamSelector(s string) (StreamSelector, error) {
+	sel := StreamSelector{
+		MediaType:   astiav.MediaTypeUnknown,
+		StreamIndex: -1,
+	}
+	if strings.HasPrefix(s, "[") {
+		if !strings.HasSuffix(s, "]") || len(s) < 3 {
+			return StreamSelector{}, fmt.Errorf("invalid filter graph output label %q", s)
+		}
+		sel.Label = s[1 : len(s)-1]
+		return sel, nil
+	}
+	if strings.HasPrefix(s, "-") {
+		sel.Negative = true
+		s = s[1:]
+	}
+	if strings.HasSuffix(s, "?") {
+		sel.Optional = true
+		s = s[:len(s)-1]
+	}
+
+	parts := strings.Split(s, ":")
+	inputNum, err := strconv.ParseUint(parts[0], 10, 31)
+	if err != nil {
+		return StreamSelector{}, fmt.Errorf("unable to parse the input index %q: %w", parts[0], err)
+	}
+	sel.InputNum = int(inputNum)
+	parts = parts[1:]
+
+	if len(parts) > 0 {
+		switch parts[0] {
+		case "v":
+			sel.MediaType = astiav.MediaTypeVideo
+		case "V":
+			sel.MediaType = astiav.MediaTypeVideo
+			sel.NoAttachedPics = true
+		case "a":
+			sel.MediaType = astiav.MediaTypeAudio
+		case "s":
+			sel.MediaType = astiav.MediaTypeSubtitle
+		case "d":
+			sel.MediaType = astiav.MediaTypeData
+		case "t":
+			sel.MediaType = astiav.MediaTypeAttachment
+		}
+		if sel.MediaType != astiav.MediaTypeUnknown {
+			parts = parts[1:]
+		}
+	}
+
+	if len(parts) > 0 {
+		streamIndex, err := strconv.ParseUint(parts[0], 10, 31)
+		if err != nil {
+			return StreamSelector{}, fmt.Errorf("unable to parse the stream specifier %q: %w", parts[0], err)
+		}
+		sel.StreamIndex = int(streamIndex)
+		parts = parts[1:]
+	}
+
+	if len(parts) > 0 {
+		return StreamSelector{}, fmt.Errorf("unsupported stream specifier suffix %q", strings.Join(parts, ":"))
+	}
+	return sel, nil
+}
+
+func (sel StreamSelector) String() string {
+	if sel.Label != "" {
+		return "[" + sel.Label + "]"
+	}
+	var b strings.Builder
+	if sel.Negative {
+		b.WriteString("-")
+	}
+	b.WriteString(strconv.Itoa(sel.InputNum))
+	switch sel.MediaType {
+	case astiav.MediaTypeVideo:
+		if sel.NoAttachedPics {
+			b.WriteString(":V")
+		} else {
+			b.WriteString(":v")
+		}
+	case astiav.MediaTypeAudio:
+		b.WriteString(":a")
+	case astiav.MediaTypeSubtitle:
+		b.WriteString(":s")
+	case astiav.MediaTypeData:
+		b.WriteString(":d")
+	case astiav.MediaTypeAttachment:
+		b.WriteString(":t")
+	}
+	if sel.StreamIndex >= 0 {
+		b.WriteString(":" + strconv.Itoa(sel.StreamIndex))
+	}
+	if sel.Optional {
+		b.WriteString("?")
+	}
+	return b.String()
+}
+
+// StreamInfo describes an input stream to be matched against the selectors.
+type StreamInfo struct {
+	InputNum  int
+	MediaType astiav.MediaType
+
+	// TypeIndex is the index among the streams of the same media type.
+	TypeIndex int
+
+	// AbsIndex is the index of the stream within its input.
+	AbsIndex int
+
+	// IsAttachedPic is true for an attached picture (e.g. a cover art).
+	IsAttachedPic bool
+
+	// NoAttachedPicsIndex is the index among the video streams that are
+	// not attached pictures (the "V" media type); -1 for the other streams.
+	NoAttachedPicsIndex int
+}
+
+// NewStreamInfos describes the given streams of the input inputNum.
+func NewStreamInfos(
+	inputNum int,
+	streams []*astiav.Stream,
+) []StreamInfo {
+	result := make([]StreamInfo, 0, len(streams))
+	typeCount := map[astiav.MediaType]int{}
+	noAttachedPicsCount := 0
+	for _, stream := range streams {
+		info := StreamInfo{
+			InputNum:            inputNum,
+			MediaType:           stream.CodecParameters().MediaType(),
+			AbsIndex:            stream.Index(),
+			IsAttachedPic:       stream.DispositionFlags().Has(astiav.DispositionFlagAttachedPic),
+			NoAttachedPicsIndex: -1,
+		}
+		info.TypeIndex = typeCount[info.MediaType]
+		typeCount[info.MediaType]++
+		if info.MediaType == astiav.MediaTypeVideo && !info.IsAttachedPic {
+			info.NoAttachedPicsIndex = noAttachedPicsCount
+			noAttachedPicsCount++
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+// Matches returns true if the stream is selected (ignoring the Negative flag).
+func (sel StreamSelector) Matches(stream StreamInfo) bool {
+	if sel.Label != "" || sel.InputNum != stream.InputNum {
+		return false
+	}
+	if sel.MediaType != astiav.MediaTypeUnknown && sel.MediaType != stream.MediaType {
+		return false
+	}
+	if sel.NoAttachedPics && stream.IsAttachedPic {
+		return false
+	}
+	switch {
+	case sel.StreamIndex < 0:
+		return true
+	case sel.NoAttachedPics:
+		return sel.StreamIndex == stream.NoAttachedPicsIndex
+	case sel.MediaType != astiav.MediaTypeUnknown:
+		return sel.StreamIndex == stream.TypeIndex
+	default:
+		return sel.StreamIndex == stream.AbsIndex
+	}
+}
+
+// StreamMap is an ordered list of `-map` selectors.
+//
+// Each positive selector produces an output track (a positive selector without
+// a media type produces a video and an audio track); the position of the track
+// is both the stream index assigned by InputFactory.StreamIndexAssign and
+// the output track ID. If a selector matches multiple streams, only the first
+// matched stream is used. A positive selector without the trailing "?" that
+// matches no stream is an error (see CheckMatched); since the same map is
+// applied to every fallback priority, a selector that is not expected to match
+// at every priority should be optional.
+//
+// The stream indexes following the output tracks are used internally for
+// the frames fed into the `-filter_complex` graph (see FilterComplexInputStreamIndex).
+type StreamMap []StreamSelector
+
+// ParseStreamMap parses `-map` arguments; filterComplex (may be nil) is used
+// to resolve the "[label]" selectors. If no arguments are given
+// but filterComplex is, then all the outputs of the graph are mapped.
+func ParseStreamMap(
+	args []string,
+	filterComplex *FilterComplex,
+) (StreamMap, error) {
+	var m StreamMap
+	for _, arg := range args {
+		sel, err := ParseStreamSelector(arg)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse '-map %s': %w", arg, err)
+		}
+		if sel.Label != "" {
+			if filterComplex == nil {
+				return nil, fmt.Errorf("'-map %s' refers to a filter graph output, but no -filter_complex is given", arg)
+			}
+			out, ok := filterComplex.Output(sel.Label)
+			if !ok {
+				return nil, fmt.Errorf("'-map %s': the filter graph has no output %q", arg, sel.Label)
+			}
+			sel.MediaType = out.MediaType
+		}
+		m = append(m, sel)
+	}
+	if len(args) == 0 && filterComplex != nil {
+		for _, out := range filterComplex.Outputs {
+			m = append(m, StreamSelector{
+				InputNum:    0,
+				MediaType:   out.MediaType,
+				StreamIndex: -1,
+				Label:       out.Label,
+			})
+		}
+	}
+	if _, err := m.Tracks(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type StreamMapTrack struct {
+	// SelectorIndex is the index of the selector in the StreamMap.
+	SelectorIndex int
+	MediaType     astiav.MediaType
+}
+
+// Tracks returns the output tracks, the index in the slice is the track ID.
+func (m StreamMap) Tracks() ([]StreamMapTrack, error) {
+	var tracks []StreamMapTrack
+	for idx, sel := range m {
+		if sel.Negative {
+			continue
+		}
+		if sel.Label != "" && sel.MediaType == astiav.MediaTypeUnknown {
+			return nil, fmt.Errorf("'-map %s': unknown filter graph output", sel)
+		}
+		switch sel.MediaType {
+		case astiav.MediaTypeVideo, astiav.MediaTypeAudio:
+			tracks = append(tracks, StreamMapTrack{SelectorIndex: idx, MediaType: sel.MediaType})
+		case astiav.MediaTypeUnknown:
+			if sel.StreamIndex >= 0 {
+				return nil, fmt.Errorf("'-map %s': selecting a stream by an absolute index requires a media type, e.g. '%d:v:0'", sel, sel.InputNum)
+			}
+			tracks = append(tracks,
+				StreamMapTrack{SelectorIndex: idx, MediaType: astiav.MediaTypeVideo},
+				StreamMapTrack{SelectorIndex: idx, MediaType: astiav.MediaTypeAudio},
+			)
+		default:
+			return nil, fmt.Errorf("'-map %s': only video and audio streams are supported", sel)
+		}
+	}
+	return tracks, nil
+}
+
+// FindTrack returns the ID of the output track the given input stream
+// should be sent to; isTaken reports tracks already used by other streams.
+func (m StreamMap) FindTrack(
+	stream StreamInfo,
+	isTaken func(trackID int) bool,
+) (int, bool) {
+	tracks, err := m.Tracks()
+	if err != nil {
+		return -1, false
+	}
+	for trackID, track := range tracks {
+		if track.MediaType != stream.MediaType {
+			continue
+		}
+		if !m[track.SelectorIndex].Matches(stream) {
+			continue
+		}
+		if m.isExcluded(track.SelectorIndex, stream) {
+			continue
+		}
+		if isTaken != nil && isTaken(trackID) {
+			continue
+		}
+		return trackID, true
+	}
+	return -1, false
+}
+
+// CheckMatched returns an error if a positive selector that is not optional
+// matches none of the given streams (all the streams of all the inputs of
+// a fallback priority), the same way as ffmpeg does.
+func (m StreamMap) CheckMatched(streams []StreamInfo) error {
+	for idx, sel := range m {
+		if sel.Negative || sel.Optional || sel.Label != "" {
+			continue
+		}
+		if !slices.ContainsFunc(streams, func(stream StreamInfo) bool {
+			return sel.Matches(stream) && !m.isExcluded(idx, stream)
+		}) {
+			return fmt.Errorf("'-map %s' matches no streams; to ignore this, add a trailing '?' to the map", sel)
+		}
+	}
+	return nil
+}
+
+// TrackIDByLabel returns the ID of the output track the `-filter_complex`
+// output with the given label is mapped to.
+func (m StreamMap) TrackIDByLabel(label string) (int, bool) {
+	tracks, err := m.Tracks()
+	if err != nil {
+		return -1, false
+	}
+	for trackID, track := range tracks {
+		if m[track.SelectorIndex].Label == label {
+			return trackID, true
+		}
+	}
+	return -1, false
+}
+
+// FilterComplexInputStreamIndex returns the internal stream index assigned
+// to the frames fed into the given input pad of the `-filter_complex` graph.
+func (m StreamMap) FilterComplexInputStreamIndex(padIdx int) int {
+	tracks, _ := m.Tracks()
+	return len(tracks) + padIdx
+}
+
+func (m StreamMap) isExcluded(
+	selectorIndex int,
+	stream StreamInfo,
+) bool {
+	for _, sel := range m[selectorIndex+1:] {
+		if sel.Negative && sel.Matches(stream) {
+			return true
+		}
+	}
+	return false
+}
+
+// TranscoderConfig lays out the given video and audio track configs according
+// to the stream map. An empty map gives the default layout: the video
+// track 0 and the audio track 1 taken from any input stream.
+func (m StreamMap) TranscoderConfig(
+	video streammuxtypes.OutputVideoTrackConfig,
+	audio streammuxtypes.OutputAudioTrackConfig,
+) (streammuxtypes.TranscoderConfig, error) {
+	var cfg streammuxtypes.TranscoderConfig
+	if len(m) == 0 {
+		video.InputTrackIDs = []int{0, 1, 2, 3, 4, 5, 6, 7}
+		video.OutputTrackIDs = []int{0}
+		audio.InputTrackIDs = []int{0, 1, 2, 3, 4, 5, 6, 7}
+		audio.OutputTrackIDs = []int{1}
+		cfg.Output.VideoTrackConfigs = []streammuxtypes.OutputVideoTrackConfig{video}
+		cfg.Output.AudioTrackConfigs = []streammuxtypes.OutputAudioTrackConfig{audio}
+		return cfg, nil
+	}
+
+	tracks, err := m.Tracks()
+	if err != nil {
+		return streammuxtypes.TranscoderConfig{}, err
+	}
+	for trackID, track := range tracks {
+		switch track.MediaType {
+		case astiav.MediaTypeVideo:
+			trackCfg := video
+			trackCfg.InputTrackIDs = []int{trackID}
+			trackCfg.OutputTrackIDs = []int{trackID}
+			cfg.Output.VideoTrackConfigs = append(cfg.Output.VideoTrackConfigs, trackCfg)
+		case astiav.MediaTypeAudio:
+			trackCfg := audio
+			trackCfg.InputTrackIDs = []int{trackID}
+			trackCfg.OutputTrackIDs = []int{trackID}
+			cfg.Output.AudioTrackConfigs = append(cfg.Output.AudioTrackConfigs, trackCfg)
+		}
+	}
+	return cfg, nil
+}
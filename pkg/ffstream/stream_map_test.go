@@ -0,0 +1,190 @@
+package ffstream
+
+import (
+	"testing"
+
+	"github.com/asticode/go-astiav"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+)
+
+func TestParseStreamSelector(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want StreamSelector
+	}{
+		{"0", StreamSelector{InputNum: 0, MediaType: astiav.MediaTypeUnknown, StreamIndex: -1}},
+		{"0:v:0", StreamSelector{InputNum: 0, MediaType: astiav.MediaTypeVideo, StreamIndex: 0}},
+		{"0:V:1", StreamSelector{InputNum: 0, MediaType: astiav.MediaTypeVideo, NoAttachedPics: true, StreamIndex: 1}},
+		{"1:a", StreamSelector{InputNum: 1, MediaType: astiav.MediaTypeAudio, StreamIndex: -1}},
+		{"-0:s", StreamSelector{Negative: true, InputNum: 0, MediaType: astiav.MediaTypeSubtitle, StreamIndex: -1}},
+		{"2:a:1?", StreamSelector{Optional: true, InputNum: 2, MediaType: astiav.MediaTypeAudio, StreamIndex: 1}},
+		{"0:3", StreamSelector{InputNum: 0, MediaType: astiav.MediaTypeUnknown, StreamIndex: 3}},
+		{"[out]", StreamSelector{MediaType: astiav.MediaTypeUnknown, StreamIndex: -1, Label: "out"}},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := ParseStreamSelector(tc.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("expected %#v, got %#v", tc.want, got)
+			}
+			if got.String() != tc.in {
+				t.Fatalf("expected String() == %q, got %q", tc.in, got.String())
+			}
+		})
+	}
+
+	for _, in := range []string{"", "x", "0:p:1", "0:v:x", "[]", "[out"} {
+		t.Run("invalid_"+in, func(t *testing.T) {
+			if _, err := ParseStreamSelector(in); err == nil {
+				t.Fatalf("expected an error for %q", in)
+			}
+		})
+	}
+}
+
+func TestStreamMapFindTrack(t *testing.T) {
+	// video from the camera, audio from the mic
+	m, err := ParseStreamMap([]string{"0:v:0", "1:a", "-1:a:1"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	taken := map[int]bool{}
+	isTaken := func(trackID int) bool { return taken[trackID] }
+
+	if trackID, ok := m.FindTrack(StreamInfo{InputNum: 0, MediaType: astiav.MediaTypeAudio, TypeIndex: 0, AbsIndex: 1}, isTaken); ok {
+		t.Fatalf("expected camera audio to be dropped, got track %d", trackID)
+	}
+	if trackID, ok := m.FindTrack(StreamInfo{InputNum: 1, MediaType: astiav.MediaTypeAudio, TypeIndex: 1, AbsIndex: 1}, isTaken); ok {
+		t.Fatalf("expected the excluded mic audio stream to be dropped, got track %d", trackID)
+	}
+	trackID, ok := m.FindTrack(StreamInfo{InputNum: 0, MediaType: astiav.MediaTypeVideo, TypeIndex: 0, AbsIndex: 0}, isTaken)
+	if !ok || trackID != 0 {
+		t.Fatalf("expected camera video at track 0, got %d (%v)", trackID, ok)
+	}
+	taken[trackID] = true
+	trackID, ok = m.FindTrack(StreamInfo{InputNum: 1, MediaType: astiav.MediaTypeAudio, TypeIndex: 0, AbsIndex: 0}, isTaken)
+	if !ok || trackID != 1 {
+		t.Fatalf("expected mic audio at track 1, got %d (%v)", trackID, ok)
+	}
+	taken[trackID] = true
+	if trackID, ok := m.FindTrack(StreamInfo{InputNum: 1, MediaType: astiav.MediaTypeAudio, TypeIndex: 2, AbsIndex: 2}, isTaken); ok {
+		t.Fatalf("expected the second mic audio stream to be dropped, got track %d", trackID)
+	}
+}
+
+func TestStreamMapCheckMatched(t *testing.T) {
+	streams := []StreamInfo{
+		{InputNum: 0, MediaType: astiav.MediaTypeVideo, TypeIndex: 0, AbsIndex: 0, IsAttachedPic: true, NoAttachedPicsIndex: -1},
+		{InputNum: 0, MediaType: astiav.MediaTypeVideo, TypeIndex: 1, AbsIndex: 1, NoAttachedPicsIndex: 0},
+		{InputNum: 0, MediaType: astiav.MediaTypeAudio, TypeIndex: 0, AbsIndex: 2, NoAttachedPicsIndex: -1},
+	}
+
+	for _, args := range [][]string{
+		{"0:v:1", "0:a"},
+		{"0:V:0"},
+		{"0:a", "1:a?"},
+		{"0:V:1?"},
+	} {
+		m, err := ParseStreamMap(args, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := m.CheckMatched(streams); err != nil {
+			t.Fatalf("%v: unexpected error: %v", args, err)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"0:v", "1:a"},
+		{"0:V:1"},
+		{"0:a:1"},
+		{"0:a", "-0:a"},
+	} {
+		m, err := ParseStreamMap(args, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := m.CheckMatched(streams); err == nil {
+			t.Fatalf("%v: expected an error for a selector matching no streams", args)
+		}
+	}
+
+	m, err := ParseStreamMap([]string{"0:V"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trackID, ok := m.FindTrack(streams[0], nil); ok {
+		t.Fatalf("expected the attached picture to be dropped, got track %d", trackID)
+	}
+	if trackID, ok := m.FindTrack(streams[1], nil); !ok || trackID != 0 {
+		t.Fatalf("expected the video at track 0, got %d (%v)", trackID, ok)
+	}
+}
+
+func TestStreamMapTranscoderConfig(t *testing.T) {
+	m, err := ParseStreamMap([]string{"0", "1:a"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg, err := m.TranscoderConfig(
+		streammuxtypes.OutputVideoTrackConfig{CodecName: "libx264"},
+		streammuxtypes.OutputAudioTrackConfig{CodecName: "aac"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Output.VideoTrackConfigs) != 1 || cfg.Output.VideoTrackConfigs[0].OutputTrackIDs[0] != 0 {
+		t.Fatalf("unexpected video track configs: %#+v", cfg.Output.VideoTrackConfigs)
+	}
+	if len(cfg.Output.AudioTrackConfigs) != 2 ||
+		cfg.Output.AudioTrackConfigs[0].OutputTrackIDs[0] != 1 ||
+		cfg.Output.AudioTrackConfigs[1].OutputTrackIDs[0] != 2 {
+		t.Fatalf("unexpected audio track configs: %#+v", cfg.Output.AudioTrackConfigs)
+	}
+
+	if _, err := ParseStreamMap([]string{"0:s"}, nil); err == nil {
+		t.Fatalf("expected an error for mapping subtitles")
+	}
+}
+
+func TestStreamMapFilterComplexLabels(t *testing.T) {
+	fc := &FilterComplex{
+		Description: "[0:v][1:v]overlay=W-w-10:10[out]",
+		Inputs: []FilterComplexInput{
+			{Label: "0:v", Selector: StreamSelector{InputNum: 0, MediaType: astiav.MediaTypeVideo, StreamIndex: -1}},
+			{Label: "1:v", Selector: StreamSelector{InputNum: 1, MediaType: astiav.MediaTypeVideo, StreamIndex: -1}},
+		},
+		Outputs: []FilterComplexOutput{{Label: "out", MediaType: astiav.MediaTypeVideo}},
+	}
+
+	m, err := ParseStreamMap([]string{"[out]", "0:a"}, fc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trackID, ok := m.TrackIDByLabel("out"); !ok || trackID != 0 {
+		t.Fatalf("expected [out] at track 0, got %d (%v)", trackID, ok)
+	}
+	if trackID, ok := m.FindTrack(StreamInfo{InputNum: 0, MediaType: astiav.MediaTypeVideo, TypeIndex: 0, AbsIndex: 0}, nil); ok {
+		t.Fatalf("expected camera video to be used only by the filter graph, got track %d", trackID)
+	}
+	if idx := m.FilterComplexInputStreamIndex(1); idx != 3 {
+		t.Fatalf("expected the second pad at the stream index 3, got %d", idx)
+	}
+
+	m, err = ParseStreamMap(nil, fc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(m) != 1 || m[0].Label != "out" || m[0].MediaType != astiav.MediaTypeVideo {
+		t.Fatalf("expected the graph output to be mapped by default, got %#+v", m)
+	}
+
+	if _, err := ParseStreamMap([]string{"[pip]"}, fc); err == nil {
+		t.Fatalf("expected an error for an unknown label")
+	}
+	if _, err := ParseStreamMap([]string{"[out]"}, nil); err == nil {
+		t.Fatalf("expected an error for a label without -filter_complex")
+	}
+}
@@ -6,11 +6,42 @@ package ffstream
 import (
 	"context"
 	"fmt"
+
+	"github.com/xaionaro-go/avpipeline/kernel"
 )
 
+// SRTSocket is a placeholder, since SRT is not supported without with_libsrt.
+type SRTSocket struct{}
+
 func (s *FFStream) WithSRTOutput(
 	ctx context.Context,
-	callback func(any) error,
+	outputID int,
+	destination uint,
+	callback func(*SRTSocket) error,
+) error {
+	return fmt.Errorf("compiled without with_libsrt")
+}
+
+func (s *FFStream) WithSRTInput(
+	ctx context.Context,
+	priority uint,
+	num uint,
+	callback func(*SRTSocket) error,
 ) error {
 	return fmt.Errorf("compiled without with_libsrt")
 }
+
+func (s *FFStream) getInputSRTTotals(
+	ctx context.Context,
+	priority uint,
+) (inputSRTTotals, bool) {
+	return inputSRTTotals{}, false
+}
+
+func getInputKernelsSRTTotals(
+	ctx context.Context,
+	priority uint,
+	inputs []*kernel.Input,
+) (inputSRTTotals, bool) {
+	return inputSRTTotals{}, false
+}
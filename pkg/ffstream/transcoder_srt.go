@@ -6,7 +6,9 @@ package ffstream
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/avpipeline/kernel"
 	"github.com/xaionaro-go/avpipeline/preset/streammux"
 	"github.com/xaionaro-go/avpipeline/processor"
@@ -14,11 +16,27 @@ import (
 	"github.com/xaionaro-go/xsync"
 )
 
+type SRTSocket = threadsafe.Socket
+
+// WithSRTOutput calls the callback with the SRT socket of the destination
+// (the index of the output template, see OutputInfo.Destinations) of
+// the output; a negative outputID means the active output.
 func (s *FFStream) WithSRTOutput(
 	ctx context.Context,
 	outputID int,
-	callback func(*threadsafe.Socket) error,
+	destination uint,
+	callback func(*SRTSocket) error,
 ) error {
+	if s.StreamMux == nil {
+		return fmt.Errorf("it is allowed to use WithSRTOutput only after Start is invoked")
+	}
+	if outputID < 0 {
+		activeOutputID, ok := s.GetActiveOutputID(ctx)
+		if !ok {
+			return fmt.Errorf("there is no active output")
+		}
+		outputID = int(activeOutputID)
+	}
 	output, err := xsync.DoR2(ctx, &s.StreamMux.Locker, func() (*streammux.Output[CustomData], error) {
 		output, _ := s.StreamMux.Outputs.Load(streammux.OutputID(outputID))
 		if output == nil {
@@ -30,19 +48,80 @@ func (s *FFStream) WithSRTOutput(
 		return fmt.Errorf("unable to get the output: %w", err)
 	}
 
-	procAbstract := output.SendingNode.GetProcessor()
+	sendingNode, ok := output.SendingNode.(SendingNodeAbstract)
+	if !ok {
+		return fmt.Errorf("output %d sending node %T does not implement SendingNodeAbstract", outputID, output.SendingNode)
+	}
+	destinations := getDestinations(sendingNode)
+	if destination >= uint(len(destinations)) {
+		return fmt.Errorf("output %d has %d destinations, but destination #%d is requested", outputID, len(destinations), destination)
+	}
+	dst := destinations[destination]
+
+	procAbstract := dst.GetProcessor()
 	proc, ok := procAbstract.(processor.GetKerneler)
 	if !ok {
-		return fmt.Errorf("output %d processor %T does not implement GetKerneler interface", outputID, procAbstract)
+		return fmt.Errorf("output %d destination #%d processor %T does not implement GetKerneler interface", outputID, destination, procAbstract)
+	}
+
+	sock, err := getOutputSRTSocket(ctx, proc.GetKernel())
+	if err != nil {
+		return fmt.Errorf("output %d destination #%d: %w", outputID, destination, err)
 	}
 
-	kernelAbstract := proc.GetKernel()
-	kernel, ok := kernelAbstract.(kernel.GetSRTer)
+	err = callback(sock)
+	if err != nil {
+		return fmt.Errorf("callback failed: %w", err)
+	}
+
+	return nil
+}
+
+// getOutputSRTSocket returns the SRT socket of the output kernel,
+// unwrapping the retry logic (also used by the output fallbacks).
+func getOutputSRTSocket(
+	ctx context.Context,
+	kernelAbstract kernel.Abstract,
+) (*SRTSocket, error) {
+	if retryable, ok := kernelAbstract.(*kernel.Retryable[*kernel.Output]); ok {
+		return xsync.DoR2(ctx, &retryable.KernelLocker, func() (*SRTSocket, error) {
+			if retryable.Kernel == nil {
+				return nil, fmt.Errorf("the output is not connected at the moment")
+			}
+			return getOutputSRTSocket(ctx, retryable.Kernel)
+		})
+	}
+
+	srter, ok := kernelAbstract.(kernel.GetSRTer)
 	if !ok {
-		return fmt.Errorf("output %d kernel %T does not implement GetSRTer interface", outputID, kernelAbstract)
+		return nil, fmt.Errorf("kernel %T does not implement GetSRTer interface", kernelAbstract)
 	}
+	sock, err := srter.SRT(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get SRT socket: %w", err)
+	}
+	return sock, nil
+}
 
-	sock, err := kernel.SRT(ctx)
+// WithSRTInput calls the callback with the SRT socket of the input number `num`
+// of the given fallback priority.
+func (s *FFStream) WithSRTInput(
+	ctx context.Context,
+	priority uint,
+	num uint,
+	callback func(*SRTSocket) error,
+) error {
+	input, err := s.GetInputKernel(ctx, priority, num)
+	if err != nil {
+		return fmt.Errorf("unable to get the input: %w", err)
+	}
+
+	srter, ok := any(input).(kernel.GetSRTer)
+	if !ok {
+		return fmt.Errorf("input %d at priority %d kernel %T does not implement GetSRTer interface", num, priority, input)
+	}
+
+	sock, err := srter.SRT(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to get SRT socket: %w", err)
 	}
@@ -54,3 +133,50 @@ func (s *FFStream) WithSRTOutput(
 
 	return nil
 }
+
+// getInputSRTTotals returns the total counters of the SRT inputs of the given
+// fallback priority, or false if the priority has no opened SRT inputs.
+func (s *FFStream) getInputSRTTotals(
+	ctx context.Context,
+	priority uint,
+) (inputSRTTotals, bool) {
+	inputs, err := s.GetInputKernels(ctx, priority)
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the inputs at priority %d: %v", priority, err)
+		return inputSRTTotals{}, false
+	}
+	return getInputKernelsSRTTotals(ctx, priority, inputs)
+}
+
+// getInputKernelsSRTTotals returns the total counters of the SRT inputs
+// among the given ones, or false if there are no SRT inputs.
+func getInputKernelsSRTTotals(
+	ctx context.Context,
+	priority uint,
+	inputs []*kernel.Input,
+) (inputSRTTotals, bool) {
+	result := inputSRTTotals{Priority: priority}
+	found := false
+	for num, input := range inputs {
+		srter, ok := any(input).(kernel.GetSRTer)
+		if !ok {
+			continue
+		}
+		sock, err := srter.SRT(ctx)
+		if err != nil {
+			// e.g. not an SRT input
+			continue
+		}
+		stats, err := sock.Bistats(false, true)
+		if err != nil {
+			logger.Debugf(ctx, "unable to get the SRT stats of input %d at priority %d: %v", num, priority, err)
+			continue
+		}
+		converted := stats.Convert()
+		result.PacketsReceived += uint64(converted.PktRecvTotal)
+		result.PacketsLost += uint64(converted.PktRcvLossTotal)
+		result.RTT = max(result.RTT, time.Duration(converted.MsRTT*float64(time.Millisecond)))
+		found = true
+	}
+	return result, found
+}
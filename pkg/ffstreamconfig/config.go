@@ -0,0 +1,152 @@
+// Package ffstreamconfig implements the declarative configuration of ffstream
+// (see `ffstream -config`), an alternative to the ffmpeg-style arguments.
+package ffstreamconfig
+
+import (
+	"time"
+)
+
+// Config is the root of the configuration file.
+type Config struct {
+	Logging     Logging      `yaml:"logging,omitempty"`
+	Listen      Listen       `yaml:"listen,omitempty"`
+	Auth        Auth         `yaml:"auth,omitempty"`
+	HWAccel     string       `yaml:"hwaccel,omitempty"`
+	Inputs      []Input      `yaml:"inputs,omitempty"`
+	Outputs     []Output     `yaml:"outputs,omitempty"`
+	Encoders    Encoders     `yaml:"encoders,omitempty"`
+	Filters     Filters      `yaml:"filters,omitempty"`
+	Map         []string     `yaml:"map,omitempty"`
+	MuxMode     string       `yaml:"mux_mode,omitempty"`
+	AutoBitRate *AutoBitRate `yaml:"auto_bitrate,omitempty"`
+	Retry       Retry        `yaml:"retry,omitempty"`
+	Webhook     *Webhook     `yaml:"webhook,omitempty"`
+}
+
+// Logging is the equivalent of flags -v, -log_file, -logstash_addr,
+// -sentry_dsn and -remove_secrets_from_logs.
+type Logging struct {
+	Level         string `yaml:"level,omitempty"`
+	File          string `yaml:"file,omitempty"`
+	LogstashAddr  string `yaml:"logstash_addr,omitempty"`
+	SentryDSN     string `yaml:"sentry_dsn,omitempty"`
+	RemoveSecrets bool   `yaml:"remove_secrets,omitempty"`
+}
+
+// Listen is the equivalent of flags -listen_control, -listen_http,
+// -listen_metrics, -listen_net_pprof and -tls_*.
+type Listen struct {
+	Control  string `yaml:"control,omitempty"`
+	HTTP     string `yaml:"http,omitempty"`
+	TLS      TLS    `yaml:"tls,omitempty"`
+	Metrics  string `yaml:"metrics,omitempty"`
+	NetPprof string `yaml:"net_pprof,omitempty"`
+}
+
+// TLS is the equivalent of flags -tls_cert, -tls_key and -tls_client_ca.
+type TLS struct {
+	Cert     string `yaml:"cert,omitempty"`
+	Key      string `yaml:"key,omitempty"`
+	ClientCA string `yaml:"client_ca,omitempty"`
+}
+
+// Auth is the equivalent of flags -auth_admin_token and -auth_read_only_token.
+type Auth struct {
+	AdminToken    string `yaml:"admin_token,omitempty"`
+	ReadOnlyToken string `yaml:"read_only_token,omitempty"`
+}
+
+// Input is the equivalent of `[options] -i <url>`.
+type Input struct {
+	URL              string  `yaml:"url"`
+	FallbackPriority uint    `yaml:"fallback_priority,omitempty"`
+	Options          Options `yaml:"options,omitempty"`
+
+	// HealthPolicy is in the format of ffstream.ParseInputHealthPolicy;
+	// it applies to all the inputs of the same fallback priority.
+	HealthPolicy string `yaml:"health_policy,omitempty"`
+}
+
+// Output is the equivalent of `[options] <url>`.
+type Output struct {
+	URL     string  `yaml:"url"`
+	Format  string  `yaml:"format,omitempty"`
+	Options Options `yaml:"options,omitempty"`
+
+	// RetryTimeout overrides Retry.OutputTimeoutOnFailure for this output.
+	RetryTimeout time.Duration `yaml:"retry_timeout,omitempty"`
+
+	// RetryPolicy overrides Retry.OutputPolicy for this output.
+	RetryPolicy string `yaml:"retry_policy,omitempty"`
+
+	// FallbackPriority makes this output a fallback of the last
+	// preceding output with zero priority.
+	FallbackPriority        uint          `yaml:"fallback_priority,omitempty"`
+	FallbackRecheckInterval time.Duration `yaml:"fallback_recheck_interval,omitempty"`
+}
+
+// Encoders are the encoder settings of the video and audio tracks.
+type Encoders struct {
+	Video *Encoder `yaml:"video,omitempty"`
+	Audio *Encoder `yaml:"audio,omitempty"`
+
+	// FillMissingTracks is the equivalent of -fill_missing_tracks.
+	FillMissingTracks string `yaml:"fill_missing_tracks,omitempty"`
+}
+
+// Encoder is the equivalent of `[options] -c:v <codec> -b:v <bitrate>`.
+type Encoder struct {
+	Codec   string  `yaml:"codec"`
+	BitRate BitRate `yaml:"bitrate,omitempty"`
+	Options Options `yaml:"options,omitempty"`
+}
+
+// Filters is the equivalent of flags -vf, -af and -filter_complex.
+type Filters struct {
+	Video   string `yaml:"video,omitempty"`
+	Audio   string `yaml:"audio,omitempty"`
+	Complex string `yaml:"complex,omitempty"`
+}
+
+// AutoBitRate enables the automatic bit rate control
+// (the equivalent of flags -auto_bitrate*).
+type AutoBitRate struct {
+	MaxHeight  uint  `yaml:"max_height,omitempty"`
+	MinHeight  uint  `yaml:"min_height,omitempty"`
+	AutoBypass *bool `yaml:"auto_bypass,omitempty"`
+
+	// Ladder replaces the default resolutions and bit rates of the codec
+	// (the equivalent of -auto_bitrate_ladder); MaxHeight and MinHeight
+	// are applied to it as well.
+	Ladder []AutoBitRateStep `yaml:"ladder,omitempty"`
+}
+
+// AutoBitRateStep is a resolution of the automatic bit rate ladder and
+// the range of the bit rates it is used within.
+type AutoBitRateStep struct {
+	Width      uint32  `yaml:"width"`
+	Height     uint32  `yaml:"height"`
+	MinBitRate BitRate `yaml:"min_bitrate"`
+	MaxBitRate BitRate `yaml:"max_bitrate"`
+}
+
+// Retry is the equivalent of flags -retry_*.
+type Retry struct {
+	InputTimeoutOnFailure  time.Duration `yaml:"input_timeout_on_failure,omitempty"`
+	OutputTimeoutOnFailure time.Duration `yaml:"output_timeout_on_failure,omitempty"`
+
+	// InputPolicy and OutputPolicy are in the format of ffstream.ParseRetryPolicy.
+	InputPolicy  string `yaml:"input_policy,omitempty"`
+	OutputPolicy string `yaml:"output_policy,omitempty"`
+}
+
+// Webhook is the equivalent of flags -webhook_*.
+type Webhook struct {
+	URL    string   `yaml:"url"`
+	Secret string   `yaml:"secret,omitempty"`
+	Events []string `yaml:"events,omitempty"`
+
+	// RetryPolicy is in the format of ffstream.ParseRetryPolicy.
+	RetryPolicy string        `yaml:"retry_policy,omitempty"`
+	Timeout     time.Duration `yaml:"timeout,omitempty"`
+}
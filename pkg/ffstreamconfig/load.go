@@ -0,0 +1,207 @@
+package ffstreamconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	"github.com/xaionaro-go/ffstream/pkg/ffflag"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"gopkg.in/yaml.v3"
+)
+
+// Load reads, parses and validates the configuration file.
+func Load(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the config file: %w", err)
+	}
+	return Parse(b)
+}
+
+// Parse parses and validates the configuration; unknown keys are errors.
+func Parse(b []byte) (*Config, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	var cfg Config
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unable to parse the config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Bytes returns the configuration in YAML.
+func (cfg *Config) Bytes() ([]byte, error) {
+	return yaml.Marshal(cfg)
+}
+
+// Validate returns all the problems of the configuration, each prefixed
+// with the path of the key (e.g. "outputs[1].retry_policy: ...").
+func (cfg *Config) Validate() error {
+	var errs []error
+	fail := func(path string, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+	}
+
+	if cfg.Logging.Level != "" {
+		if err := new(ffflag.LogLevel).Parse(cfg.Logging.Level); err != nil {
+			fail("logging.level", "%v", err)
+		}
+	}
+
+	for idx, in := range cfg.Inputs {
+		path := fmt.Sprintf("inputs[%d]", idx)
+		if in.URL == "" {
+			fail(path+".url", "is required")
+		}
+		if in.HealthPolicy != "" {
+			if _, err := ffstream.ParseInputHealthPolicy(in.HealthPolicy); err != nil {
+				fail(path+".health_policy", "%v", err)
+			}
+		}
+		validateOptions(path+".options", in.Options, fail, "fallback_priority", "health_policy")
+	}
+
+	for idx, out := range cfg.Outputs {
+		path := fmt.Sprintf("outputs[%d]", idx)
+		if out.URL == "" {
+			fail(path+".url", "is required")
+		}
+		if idx == 0 && out.FallbackPriority != 0 {
+			fail(path+".fallback_priority", "the first output cannot be a fallback, it should be preceded by its primary output")
+		}
+		if out.RetryTimeout < 0 {
+			fail(path+".retry_timeout", "is negative: %v", out.RetryTimeout)
+		}
+		if out.FallbackRecheckInterval < 0 {
+			fail(path+".fallback_recheck_interval", "is negative: %v", out.FallbackRecheckInterval)
+		}
+		if out.RetryPolicy != "" {
+			if _, err := ffstream.ParseRetryPolicy(out.RetryPolicy); err != nil {
+				fail(path+".retry_policy", "%v", err)
+			}
+		}
+		validateOptions(path+".options", out.Options, fail, "f", "retry_timeout", "retry_policy", "output_fallback_priority", "output_fallback_recheck_interval")
+	}
+
+	for _, item := range []struct {
+		Path    string
+		Encoder *Encoder
+	}{
+		{"encoders.video", cfg.Encoders.Video},
+		{"encoders.audio", cfg.Encoders.Audio},
+	} {
+		enc, path := item.Encoder, item.Path
+		if enc == nil {
+			continue
+		}
+		if enc.Codec == "" {
+			fail(path+".codec", "is required")
+		}
+		validateOptions(path+".options", enc.Options, fail)
+	}
+	if v := cfg.Encoders.FillMissingTracks; v != "" && ffstream.MissingTrackFillFromString(v) == ffstream.UndefinedMissingTrackFill {
+		fail("encoders.fill_missing_tracks", "unknown value %q, expected none, black or freeze", v)
+	}
+
+	var filterComplex *ffstream.FilterComplex
+	if cfg.Filters.Complex != "" {
+		var err error
+		filterComplex, err = ffstream.ParseFilterComplex(cfg.Filters.Complex)
+		if err != nil {
+			fail("filters.complex", "%v", err)
+		}
+	}
+	if len(cfg.Map) != 0 {
+		if _, err := ffstream.ParseStreamMap(cfg.Map, filterComplex); err != nil {
+			fail("map", "%v", err)
+		}
+	}
+
+	if cfg.MuxMode != "" && streammuxtypes.MuxModeFromString(cfg.MuxMode) == streammuxtypes.UndefinedMuxMode {
+		fail("mux_mode", "unknown mux mode %q", cfg.MuxMode)
+	}
+
+	if abr := cfg.AutoBitRate; abr != nil {
+		if abr.MaxHeight != 0 && abr.MinHeight > abr.MaxHeight {
+			fail("auto_bitrate.min_height", "is greater than max_height: %d > %d", abr.MinHeight, abr.MaxHeight)
+		}
+		for idx, step := range abr.Ladder {
+			path := fmt.Sprintf("auto_bitrate.ladder[%d]", idx)
+			if step.Width == 0 || step.Height == 0 {
+				fail(path, "the resolution is not set")
+			}
+			if step.MaxBitRate == 0 {
+				fail(path+".max_bitrate", "is not set")
+			}
+			if step.MinBitRate > step.MaxBitRate {
+				fail(path+".min_bitrate", "is greater than max_bitrate: %d > %d", step.MinBitRate, step.MaxBitRate)
+			}
+		}
+	}
+
+	if cfg.Retry.OutputTimeoutOnFailure < 0 {
+		fail("retry.output_timeout_on_failure", "is negative: %v", cfg.Retry.OutputTimeoutOnFailure)
+	}
+	for _, item := range []struct {
+		Path   string
+		Policy string
+	}{
+		{"retry.input_policy", cfg.Retry.InputPolicy},
+		{"retry.output_policy", cfg.Retry.OutputPolicy},
+	} {
+		if item.Policy == "" {
+			continue
+		}
+		if _, err := ffstream.ParseRetryPolicy(item.Policy); err != nil {
+			fail(item.Path, "%v", err)
+		}
+	}
+
+	if wh := cfg.Webhook; wh != nil {
+		if wh.URL == "" {
+			fail("webhook.url", "is required")
+		}
+		if wh.RetryPolicy != "" {
+			if _, err := ffstream.ParseRetryPolicy(wh.RetryPolicy); err != nil {
+				fail("webhook.retry_policy", "%v", err)
+			}
+		}
+		if wh.Timeout < 0 {
+			fail("webhook.timeout", "is negative: %v", wh.Timeout)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+}
+
+func validateOptions(
+	path string,
+	opts Options,
+	fail func(path string, format string, args ...any),
+	reservedKeys ...string,
+) {
+	for _, opt := range opts {
+		switch {
+		case opt.Key == "":
+			fail(path, "an empty option key")
+		case strings.HasPrefix(opt.Key, "-"):
+			fail(path+"."+opt.Key, "the option keys should not start with '-'")
+		}
+		for _, reserved := range reservedKeys {
+			if opt.Key == reserved {
+				fail(path+"."+opt.Key, "use the dedicated key instead of the option")
+			}
+		}
+	}
+}
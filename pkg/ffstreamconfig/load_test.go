@@ -0,0 +1,155 @@
+package ffstreamconfig
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testConfig = `
+logging:
+  level: debug
+listen:
+  control: unix:/tmp/ffstream.sock
+inputs:
+  - url: rtmp://127.0.0.1:1937/test/camera
+    options:
+      rtmp_live: live
+      rtmp_buffer: "100"
+  - url: /data/brb.flv
+    fallback_priority: 1
+outputs:
+  - url: rtmp://primary.example/live/key
+    format: flv
+    retry_timeout: 1m
+  - url: srt://backup.example:9000
+    format: mpegts
+    fallback_priority: 1
+encoders:
+  video:
+    codec: libx264
+    bitrate: 4M
+    options:
+      s: 1280x720
+  audio:
+    codec: aac
+    bitrate: 128k
+  fill_missing_tracks: freeze
+map: ["0:v:0", "0:a:0"]
+mux_mode: forbid
+auto_bitrate:
+  ladder:
+    - {width: 1920, height: 1080, min_bitrate: 3M, max_bitrate: 8M}
+    - {width: 1280, height: 720, min_bitrate: 1500k, max_bitrate: 4M}
+`
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse([]byte(testConfig))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Inputs) != 2 || cfg.Inputs[1].FallbackPriority != 1 {
+		t.Fatalf("unexpected inputs: %#+v", cfg.Inputs)
+	}
+	wantOpts := Options{{Key: "rtmp_live", Value: "live"}, {Key: "rtmp_buffer", Value: "100"}}
+	if len(cfg.Inputs[0].Options) != len(wantOpts) {
+		t.Fatalf("expected options %#+v, got %#+v", wantOpts, cfg.Inputs[0].Options)
+	}
+	for idx, opt := range wantOpts {
+		if cfg.Inputs[0].Options[idx] != opt {
+			t.Fatalf("expected options %#+v (in this order), got %#+v", wantOpts, cfg.Inputs[0].Options)
+		}
+	}
+	if cfg.Outputs[0].RetryTimeout != time.Minute {
+		t.Fatalf("expected retry_timeout 1m, got %v", cfg.Outputs[0].RetryTimeout)
+	}
+	if cfg.Encoders.Video.BitRate != 4_000_000 || cfg.Encoders.Audio.BitRate != 128_000 {
+		t.Fatalf("unexpected bit rates: %d, %d", cfg.Encoders.Video.BitRate, cfg.Encoders.Audio.BitRate)
+	}
+	if got := strings.Join(cfg.Encoders.Video.Options.Args(), " "); got != "-s 1280x720" {
+		t.Fatalf("unexpected encoder args: %q", got)
+	}
+	if cfg.Encoders.FillMissingTracks != "freeze" {
+		t.Fatalf("expected fill_missing_tracks freeze, got %q", cfg.Encoders.FillMissingTracks)
+	}
+	wantLadder := []AutoBitRateStep{
+		{Width: 1920, Height: 1080, MinBitRate: 3_000_000, MaxBitRate: 8_000_000},
+		{Width: 1280, Height: 720, MinBitRate: 1_500_000, MaxBitRate: 4_000_000},
+	}
+	if cfg.AutoBitRate == nil || len(cfg.AutoBitRate.Ladder) != len(wantLadder) {
+		t.Fatalf("expected the ladder %#+v, got %#+v", wantLadder, cfg.AutoBitRate)
+	}
+	for idx, step := range wantLadder {
+		if cfg.AutoBitRate.Ladder[idx] != step {
+			t.Fatalf("expected the ladder %#+v, got %#+v", wantLadder, cfg.AutoBitRate.Ladder)
+		}
+	}
+
+	b, err := cfg.Bytes()
+	if err != nil {
+		t.Fatalf("unable to marshal: %v", err)
+	}
+	cfg2, err := Parse(b)
+	if err != nil {
+		t.Fatalf("unable to parse the marshaled config: %v\n%s", err, b)
+	}
+	if cfg2.Inputs[0].Options[1] != wantOpts[1] || cfg2.Encoders.Video.BitRate != cfg.Encoders.Video.BitRate {
+		t.Fatalf("the config changed after a round trip:\n%s", b)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{
+			name: "unknown_key",
+			in:   "inputs:\n  - url: a\n    priority: 1\n",
+			want: []string{"line 3", "priority"},
+		},
+		{
+			name: "wrong_type",
+			in:   "outputs:\n  - url: a\n    retry_timeout: soon\n",
+			want: []string{"line 3"},
+		},
+		{
+			name: "invalid_values",
+			in: "logging:\n  level: loud\n" +
+				"inputs:\n  - options: {fallback_priority: 1}\n    health_policy: min_fps=-1\n" +
+				"outputs:\n  - url: a\n    fallback_priority: 1\n    retry_policy: initial=0s\n" +
+				"encoders:\n  fill_missing_tracks: grey\n" +
+				"mux_mode: sometimes\n" +
+				"auto_bitrate:\n  ladder:\n    - {width: 1280, height: 720, min_bitrate: 4M, max_bitrate: 1M}\n    - {min_bitrate: 1M}\n" +
+				"webhook:\n  timeout: -1s\n",
+			want: []string{
+				"logging.level:",
+				"inputs[0].url: is required",
+				"inputs[0].options.fallback_priority:",
+				"inputs[0].health_policy:",
+				"outputs[0].fallback_priority:",
+				"outputs[0].retry_policy:",
+				"encoders.fill_missing_tracks:",
+				"mux_mode:",
+				"auto_bitrate.ladder[0].min_bitrate:",
+				"auto_bitrate.ladder[1]: the resolution is not set",
+				"auto_bitrate.ladder[1].max_bitrate:",
+				"webhook.url: is required",
+				"webhook.timeout:",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.in))
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected the error to contain %q, got: %v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package ffstreamconfig
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"gopkg.in/yaml.v3"
+)
+
+// Option is a key-value option (like `-key value` in the command line).
+type Option struct {
+	Key   string
+	Value string
+}
+
+// Options is a YAML mapping that keeps the order of the keys.
+type Options []Option
+
+var (
+	_ yaml.Unmarshaler = (*Options)(nil)
+	_ yaml.Marshaler   = (Options)(nil)
+)
+
+func (opts *Options) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping of options (key: value)", node.Line)
+	}
+	result := make(Options, 0, len(node.Content)/2)
+	for idx := 0; idx+1 < len(node.Content); idx += 2 {
+		k, v := node.Content[idx], node.Content[idx+1]
+		if k.Kind != yaml.ScalarNode || v.Kind != yaml.ScalarNode {
+			return fmt.Errorf("line %d: the option keys and values should be scalars", k.Line)
+		}
+		result = append(result, Option{Key: k.Value, Value: v.Value})
+	}
+	*opts = result
+	return nil
+}
+
+func (opts Options) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, opt := range opts {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: opt.Key},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: opt.Value},
+		)
+	}
+	return node, nil
+}
+
+// Args returns the options in the command line format: -key1 value1 -key2 value2 ...
+func (opts Options) Args() []string {
+	result := make([]string, 0, len(opts)*2)
+	for _, opt := range opts {
+		result = append(result, "-"+opt.Key, opt.Value)
+	}
+	return result
+}
+
+// BitRate is a bit rate in bits per second; in YAML it may be
+// written with a suffix, e.g. "4M" or "128k".
+type BitRate uint64
+
+var (
+	_ yaml.Unmarshaler = (*BitRate)(nil)
+	_ yaml.Marshaler   = (BitRate)(0)
+)
+
+func (b *BitRate) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: expected a bit rate", node.Line)
+	}
+	v, err := humanize.ParseBytes(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: unable to parse bit rate %q: %w", node.Line, node.Value, err)
+	}
+	*b = BitRate(v)
+	return nil
+}
+
+func (b BitRate) MarshalYAML() (any, error) {
+	return uint64(b), nil
+}
@@ -0,0 +1,225 @@
+// Package ffstreamd hosts many FFStream instances (streams) in one process
+// behind one control API, see the ffstreamd command.
+package ffstreamd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/xsync"
+)
+
+// MaxStreamIDLength is the maximal length of a stream ID.
+const MaxStreamIDLength = 64
+
+var (
+	ErrInvalidStreamID     = errors.New("invalid stream ID")
+	ErrInvalidArguments    = errors.New("invalid arguments")
+	ErrStreamNotFound      = errors.New("stream not found")
+	ErrStreamAlreadyExists = errors.New("stream already exists")
+	ErrStreamIsRunning     = errors.New("stream is running")
+	ErrStreamIsNotRunning  = errors.New("stream is not running")
+)
+
+// Daemon hosts many streams, each identified by its ID.
+type Daemon struct {
+	// Auth (if set) restricts the access to the control API.
+	Auth *ffstreamserver.Auth
+
+	// ctx is the context the streams are run in.
+	ctx     context.Context
+	locker  xsync.Mutex
+	streams map[string]*Stream
+}
+
+// New returns a Daemon running the streams in ctx.
+func New(ctx context.Context) *Daemon {
+	return &Daemon{
+		ctx:     ctx,
+		streams: map[string]*Stream{},
+	}
+}
+
+// ValidateStreamID returns an error if the stream ID is empty, too long, or
+// contains anything but ASCII letters, digits, '-', '_' and '.' (since it is
+// also passed in HTTP headers and URLs).
+func ValidateStreamID(id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidStreamID)
+	}
+	if len(id) > MaxStreamIDLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrInvalidStreamID, MaxStreamIDLength)
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.':
+		default:
+			return fmt.Errorf("%w: unexpected character %q in %q", ErrInvalidStreamID, c, id)
+		}
+	}
+	return nil
+}
+
+// CreateStream creates a stream from the arguments in the same form as of
+// the ffstream command (without the program name) and the content of
+// the configuration file (optional, see -config). Use StartStream to start it.
+func (d *Daemon) CreateStream(
+	ctx context.Context,
+	id string,
+	args []string,
+	config []byte,
+) (_ret *Stream, _err error) {
+	logger.Debugf(ctx, "CreateStream: %q %q", id, args)
+	defer func() { logger.Debugf(ctx, "/CreateStream: %q: %v", id, _err) }()
+	if err := ValidateStreamID(id); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.Stream(ctx, id); err == nil {
+		return nil, fmt.Errorf("%w: %q", ErrStreamAlreadyExists, id)
+	}
+
+	// initializing outside of d.locker, since it may take a while
+	st := &Stream{
+		ID:     id,
+		Args:   args,
+		config: config,
+	}
+	if err := xsync.DoR1(ctx, &st.locker, func() error {
+		return st.initLocked(d.ctx)
+	}); err != nil {
+		return nil, err
+	}
+
+	err := xsync.DoR1(ctx, &d.locker, func() error {
+		if _, ok := d.streams[id]; ok {
+			return fmt.Errorf("%w: %q", ErrStreamAlreadyExists, id)
+		}
+		d.streams[id] = st
+		return nil
+	})
+	if err != nil {
+		st.locker.Do(ctx, func() {
+			st.cancelFn()
+		})
+		return nil, err
+	}
+	return st, nil
+}
+
+// Stream returns the stream by its ID.
+func (d *Daemon) Stream(
+	ctx context.Context,
+	id string,
+) (*Stream, error) {
+	return xsync.DoR2(ctx, &d.locker, func() (*Stream, error) {
+		st, ok := d.streams[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrStreamNotFound, id)
+		}
+		return st, nil
+	})
+}
+
+// Streams returns all the streams sorted by ID.
+func (d *Daemon) Streams(ctx context.Context) []*Stream {
+	streams := xsync.DoR1(ctx, &d.locker, func() []*Stream {
+		result := make([]*Stream, 0, len(d.streams))
+		for _, st := range d.streams {
+			result = append(result, st)
+		}
+		return result
+	})
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].ID < streams[j].ID
+	})
+	return streams
+}
+
+// StartStream starts the stream; a stopped stream is re-created
+// from its arguments and started again.
+func (d *Daemon) StartStream(
+	ctx context.Context,
+	id string,
+) (_err error) {
+	logger.Debugf(ctx, "StartStream: %q", id)
+	defer func() { logger.Debugf(ctx, "/StartStream: %q: %v", id, _err) }()
+	st, err := d.Stream(ctx, id)
+	if err != nil {
+		return err
+	}
+	return xsync.DoR1(ctx, &st.locker, func() error {
+		return st.startLocked(d.ctx)
+	})
+}
+
+// StopStream stops the stream and waits until it is finished.
+func (d *Daemon) StopStream(
+	ctx context.Context,
+	id string,
+) (_err error) {
+	logger.Debugf(ctx, "StopStream: %q", id)
+	defer func() { logger.Debugf(ctx, "/StopStream: %q: %v", id, _err) }()
+	st, err := d.Stream(ctx, id)
+	if err != nil {
+		return err
+	}
+	return st.stop(ctx)
+}
+
+// DeleteStream stops the stream (if it is running) and deletes it.
+func (d *Daemon) DeleteStream(
+	ctx context.Context,
+	id string,
+) (_err error) {
+	logger.Debugf(ctx, "DeleteStream: %q", id)
+	defer func() { logger.Debugf(ctx, "/DeleteStream: %q: %v", id, _err) }()
+	st, err := xsync.DoR2(ctx, &d.locker, func() (*Stream, error) {
+		st, ok := d.streams[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrStreamNotFound, id)
+		}
+		delete(d.streams, id)
+		return st, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := st.stop(ctx); err != nil && !errors.Is(err, ErrStreamIsNotRunning) {
+		return err
+	}
+	st.locker.Do(ctx, func() {
+		st.cancelFn()
+	})
+	return nil
+}
+
+// Close stops all the streams and waits until they are finished.
+func (d *Daemon) Close(ctx context.Context) error {
+	var (
+		wg     sync.WaitGroup
+		locker sync.Mutex
+		errs   []error
+	)
+	for _, st := range d.Streams(ctx) {
+		wg.Add(1)
+		observability.Go(ctx, func(ctx context.Context) {
+			defer wg.Done()
+			err := st.stop(ctx)
+			if err == nil || errors.Is(err, ErrStreamIsNotRunning) {
+				return
+			}
+			locker.Lock()
+			defer locker.Unlock()
+			errs = append(errs, fmt.Errorf("unable to stop stream %q: %w", st.ID, err))
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
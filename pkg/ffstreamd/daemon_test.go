@@ -0,0 +1,131 @@
+package ffstreamd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateStreamID(t *testing.T) {
+	for _, id := range []string{"a", "main-1", "twitch_backup.2", strings.Repeat("x", MaxStreamIDLength)} {
+		if err := ValidateStreamID(id); err != nil {
+			t.Errorf("unexpected error for %q: %v", id, err)
+		}
+	}
+	for _, id := range []string{"", "a b", "a/b", "тест", strings.Repeat("x", MaxStreamIDLength+1)} {
+		if err := ValidateStreamID(id); !errors.Is(err, ErrInvalidStreamID) {
+			t.Errorf("expected ErrInvalidStreamID for %q, got %v", id, err)
+		}
+	}
+}
+
+// newTestStreamArgs returns the arguments of a stream which input waits
+// for a TCP connection, so the stream runs until it is stopped.
+func newTestStreamArgs(t *testing.T) []string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return []string{
+		"-i", "tcp://" + addr + "?listen=1",
+		"-c:v", "copy", "-c:a", "copy",
+		"-f", "mpegts", filepath.Join(t.TempDir(), "output.ts"),
+	}
+}
+
+func TestDaemonStreamLifecycle(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	d := New(ctx)
+	defer d.Close(ctx)
+
+	const id = "main"
+	args := newTestStreamArgs(t)
+	st, err := d.CreateStream(ctx, id, args, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state := st.Info(ctx).State; state != StreamStateCreated {
+		t.Fatalf("expected state %s after the creation, got %s", StreamStateCreated, state)
+	}
+	if _, err := d.CreateStream(ctx, id, args, nil); !errors.Is(err, ErrStreamAlreadyExists) {
+		t.Fatalf("expected ErrStreamAlreadyExists, got %v", err)
+	}
+	if err := d.StopStream(ctx, id); !errors.Is(err, ErrStreamIsNotRunning) {
+		t.Fatalf("expected ErrStreamIsNotRunning on stopping a created stream, got %v", err)
+	}
+
+	// the second start re-creates the stopped FFStream
+	prevFFStream := st.FFStream(ctx)
+	for attempt := range 2 {
+		if err := d.StartStream(ctx, id); err != nil {
+			t.Fatalf("start #%d: %v", attempt, err)
+		}
+		if state := st.Info(ctx).State; state != StreamStateRunning {
+			t.Fatalf("start #%d: expected state %s, got %s", attempt, StreamStateRunning, state)
+		}
+		s := st.FFStream(ctx)
+		if attempt > 0 && s == prevFFStream {
+			t.Fatalf("start #%d: expected the stopped FFStream to be re-created", attempt)
+		}
+		prevFFStream = s
+		if err := d.StartStream(ctx, id); !errors.Is(err, ErrStreamIsRunning) {
+			t.Fatalf("start #%d: expected ErrStreamIsRunning on a repeated start, got %v", attempt, err)
+		}
+
+		if err := d.StopStream(ctx, id); err != nil {
+			t.Fatalf("stop #%d: %v", attempt, err)
+		}
+		info := st.Info(ctx)
+		if info.State != StreamStateStopped || info.Error != nil {
+			t.Fatalf("stop #%d: expected state %s without an error, got %s (%v)", attempt, StreamStateStopped, info.State, info.Error)
+		}
+	}
+
+	if err := d.DeleteStream(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Stream(ctx, id); !errors.Is(err, ErrStreamNotFound) {
+		t.Fatalf("expected ErrStreamNotFound after the deletion, got %v", err)
+	}
+	if err := d.StartStream(ctx, id); !errors.Is(err, ErrStreamNotFound) {
+		t.Fatalf("expected ErrStreamNotFound on starting a deleted stream, got %v", err)
+	}
+	if err := d.DeleteStream(ctx, id); !errors.Is(err, ErrStreamNotFound) {
+		t.Fatalf("expected ErrStreamNotFound on a repeated deletion, got %v", err)
+	}
+
+	// the ID is free again
+	if _, err := d.CreateStream(ctx, id, args, nil); err != nil {
+		t.Fatalf("unable to re-create the deleted stream: %v", err)
+	}
+}
+
+func TestDaemonDeleteRunningStream(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	d := New(ctx)
+	defer d.Close(ctx)
+
+	st, err := d.CreateStream(ctx, "main", newTestStreamArgs(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.StartStream(ctx, st.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DeleteStream(ctx, st.ID); err != nil {
+		t.Fatal(err)
+	}
+	if state := st.Info(ctx).State; state != StreamStateStopped {
+		t.Fatalf("expected the deleted stream to be stopped, got state %s", state)
+	}
+}
@@ -0,0 +1,208 @@
+package ffstreamd
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
+	"github.com/xaionaro-go/xsync"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServer implements FFStreamDaemon.
+type GRPCServer struct {
+	ffstream_grpc.UnimplementedFFStreamDaemonServer
+	Daemon        *Daemon
+	Observability *belt.Belt
+}
+
+func NewGRPCServer(
+	ctx context.Context,
+	d *Daemon,
+) *GRPCServer {
+	return &GRPCServer{
+		Observability: belt.CtxBelt(ctx),
+		Daemon:        d,
+	}
+}
+
+func (srv *GRPCServer) ctx(ctx context.Context) context.Context {
+	return belt.CtxWithBelt(ctx, srv.Observability)
+}
+
+// ServeContext serves the control API (FFStreamDaemon and FFStream of
+// each stream, see ffstreamserver.StreamIDMetadataKey) on the listener
+// until ctx is cancelled.
+func (d *Daemon) ServeContext(
+	ctx context.Context,
+	listener net.Listener,
+) error {
+	return ffstreamserver.ServeGRPC(ctx, listener, d.Auth, d.register(ctx))
+}
+
+// ServeHTTPContext is the same as ServeContext, but serves the HTTP/JSON
+// gateway and the web dashboard (see ffstreamserver.ServeHTTPGateway).
+func (d *Daemon) ServeHTTPContext(
+	ctx context.Context,
+	listener net.Listener,
+) error {
+	return ffstreamserver.ServeHTTPGateway(ctx, listener, d.Auth, d.register(ctx))
+}
+
+func (d *Daemon) register(ctx context.Context) func(grpc.ServiceRegistrar) {
+	daemonGRPC := NewGRPCServer(ctx, d)
+	return func(r grpc.ServiceRegistrar) {
+		ffstream_grpc.RegisterFFStreamDaemonServer(r, daemonGRPC)
+		ffstreamserver.RegisterFFStreamResolver(r, d.resolveFFStreamServer)
+	}
+}
+
+// resolveFFStreamServer implements ffstreamserver.FFStreamResolver.
+func (d *Daemon) resolveFFStreamServer(
+	ctx context.Context,
+) (ffstream_grpc.FFStreamServer, error) {
+	id := ffstreamserver.StreamIDFromContext(ctx)
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the stream is not selected (the %q metadata, see `ffstreamctl --stream`)", ffstreamserver.StreamIDMetadataKey)
+	}
+	st, err := d.Stream(ctx, id)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	return xsync.DoR1(ctx, &st.locker, func() ffstream_grpc.FFStreamServer {
+		return st.grpcServer
+	}), nil
+}
+
+// statusFromError converts the errors of Daemon to the gRPC errors.
+func statusFromError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrInvalidStreamID), errors.Is(err, ErrInvalidArguments):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, ErrStreamNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, ErrStreamAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, ErrStreamIsRunning), errors.Is(err, ErrStreamIsNotRunning):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Canceled, "%v", err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func streamInfoToGRPC(info StreamInfo) *ffstream_grpc.StreamInfo {
+	result := &ffstream_grpc.StreamInfo{
+		Id:   info.ID,
+		Args: info.Args,
+	}
+	switch info.State {
+	case StreamStateCreated:
+		result.State = ffstream_grpc.StreamState_STREAM_STATE_CREATED
+	case StreamStateRunning:
+		result.State = ffstream_grpc.StreamState_STREAM_STATE_RUNNING
+	case StreamStateStopped:
+		result.State = ffstream_grpc.StreamState_STREAM_STATE_STOPPED
+	}
+	if info.Error != nil {
+		result.Error = info.Error.Error()
+	}
+	return result
+}
+
+func (srv *GRPCServer) CreateStream(
+	ctx context.Context,
+	req *ffstream_grpc.CreateStreamRequest,
+) (*ffstream_grpc.CreateStreamReply, error) {
+	ctx = srv.ctx(ctx)
+	var config []byte
+	if len(req.GetConfig()) != 0 {
+		config = req.GetConfig()
+	}
+	st, err := srv.Daemon.CreateStream(ctx, req.GetId(), req.GetArgs(), config)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	if req.GetStart() {
+		if err := srv.Daemon.StartStream(ctx, st.ID); err != nil {
+			return nil, statusFromError(err)
+		}
+	}
+	return &ffstream_grpc.CreateStreamReply{
+		Stream: streamInfoToGRPC(st.Info(ctx)),
+	}, nil
+}
+
+func (srv *GRPCServer) StartStream(
+	ctx context.Context,
+	req *ffstream_grpc.StartStreamRequest,
+) (*ffstream_grpc.StartStreamReply, error) {
+	ctx = srv.ctx(ctx)
+	if err := srv.Daemon.StartStream(ctx, req.GetId()); err != nil {
+		return nil, statusFromError(err)
+	}
+	logger.Infof(ctx, "started the stream %q", req.GetId())
+	return &ffstream_grpc.StartStreamReply{}, nil
+}
+
+func (srv *GRPCServer) StopStream(
+	ctx context.Context,
+	req *ffstream_grpc.StopStreamRequest,
+) (*ffstream_grpc.StopStreamReply, error) {
+	ctx = srv.ctx(ctx)
+	if err := srv.Daemon.StopStream(ctx, req.GetId()); err != nil {
+		return nil, statusFromError(err)
+	}
+	logger.Infof(ctx, "stopped the stream %q", req.GetId())
+	return &ffstream_grpc.StopStreamReply{}, nil
+}
+
+func (srv *GRPCServer) DeleteStream(
+	ctx context.Context,
+	req *ffstream_grpc.DeleteStreamRequest,
+) (*ffstream_grpc.DeleteStreamReply, error) {
+	ctx = srv.ctx(ctx)
+	if err := srv.Daemon.DeleteStream(ctx, req.GetId()); err != nil {
+		return nil, statusFromError(err)
+	}
+	logger.Infof(ctx, "deleted the stream %q", req.GetId())
+	return &ffstream_grpc.DeleteStreamReply{}, nil
+}
+
+func (srv *GRPCServer) ListStreams(
+	ctx context.Context,
+	req *ffstream_grpc.ListStreamsRequest,
+) (*ffstream_grpc.ListStreamsReply, error) {
+	ctx = srv.ctx(ctx)
+	reply := &ffstream_grpc.ListStreamsReply{}
+	for _, st := range srv.Daemon.Streams(ctx) {
+		reply.Streams = append(reply.Streams, streamInfoToGRPC(st.Info(ctx)))
+	}
+	return reply, nil
+}
+
+func (srv *GRPCServer) SetDaemonLoggingLevel(
+	ctx context.Context,
+	req *ffstream_grpc.SetLoggingLevelRequest,
+) (*ffstream_grpc.SetLoggingLevelReply, error) {
+	ctx = srv.ctx(ctx)
+	level, ok := goconv.LoggingLevelFromGRPC(req.GetLevel())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown logging level: %v", req.GetLevel())
+	}
+	ffstreamserver.SetProcessLoggingLevel(level)
+	logger.Infof(ctx, "the logging level of all the streams is set to %v", level)
+	return &ffstream_grpc.SetLoggingLevelReply{}, nil
+}
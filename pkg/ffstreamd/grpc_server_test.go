@@ -0,0 +1,104 @@
+package ffstreamd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestDaemonResolveFFStreamServer(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	d := New(ctx)
+	st, err := d.CreateStream(ctx, "main", newTestStreamArgs(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		StreamID string
+		Code     codes.Code
+	}{
+		{"", codes.InvalidArgument},
+		{"unknown", codes.NotFound},
+		{"main", codes.OK},
+	} {
+		reqCtx := ctx
+		if tc.StreamID != "" {
+			reqCtx = metadata.NewIncomingContext(ctx, metadata.Pairs(ffstreamserver.StreamIDMetadataKey, tc.StreamID))
+		}
+		srv, err := d.resolveFFStreamServer(reqCtx)
+		if code := status.Code(err); code != tc.Code {
+			t.Errorf("stream %q: expected code %s, got %v", tc.StreamID, tc.Code, err)
+			continue
+		}
+		if err == nil && srv != st.grpcServer {
+			t.Errorf("stream %q: resolved to another server", tc.StreamID)
+		}
+	}
+}
+
+func TestDaemonHTTPGatewayRouting(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	d := New(ctx)
+	if _, err := d.CreateStream(ctx, "main", newTestStreamArgs(t), nil); err != nil {
+		t.Fatal(err)
+	}
+	gw := ffstreamserver.NewHTTPGateway(nil)
+	d.register(ctx)(gw)
+
+	for _, tc := range []struct {
+		Target string
+		Code   int
+	}{
+		{"/v1/GetFPSFraction", http.StatusBadRequest},
+		{"/v1/GetFPSFraction?stream=unknown", http.StatusNotFound},
+		{"/v1/ListStreams", http.StatusOK},
+	} {
+		rec := httptest.NewRecorder()
+		gw.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.Target, nil))
+		body, _ := io.ReadAll(rec.Result().Body)
+		if rec.Code != tc.Code {
+			t.Errorf("%s: expected status %d, got %d %s", tc.Target, tc.Code, rec.Code, body)
+		}
+	}
+}
+
+func TestDaemonSetLoggingLevel(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	d := New(ctx)
+	if _, err := d.CreateStream(ctx, "main", newTestStreamArgs(t), nil); err != nil {
+		t.Fatal(err)
+	}
+	req := &ffstream_grpc.SetLoggingLevelRequest{
+		Level: ffstream_grpc.LoggingLevel_LOGGING_LEVEL_WARN,
+	}
+
+	// the level is process-wide, so it is not set via a stream
+	streamCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(ffstreamserver.StreamIDMetadataKey, "main"))
+	srv, err := d.resolveFFStreamServer(streamCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.SetLoggingLevel(streamCtx, req); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected code %s, got %v", codes.FailedPrecondition, err)
+	}
+
+	if _, err := NewGRPCServer(ctx, d).SetDaemonLoggingLevel(ctx, req); err != nil {
+		t.Fatalf("unable to set the logging level of the daemon: %v", err)
+	}
+	req.Level = ffstream_grpc.LoggingLevel(100)
+	if _, err := NewGRPCServer(ctx, d).SetDaemonLoggingLevel(ctx, req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected code %s, got %v", codes.InvalidArgument, err)
+	}
+}
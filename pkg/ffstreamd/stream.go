@@ -0,0 +1,283 @@
+package ffstreamd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream/ffargs"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamwebhook"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/xsync"
+)
+
+// StreamState is the state of a Stream.
+type StreamState int
+
+const (
+	UndefinedStreamState = StreamState(iota)
+
+	// StreamStateCreated means the stream is created, but was never started.
+	StreamStateCreated
+
+	// StreamStateRunning means the stream is started and not finished yet.
+	StreamStateRunning
+
+	// StreamStateStopped means the stream is finished: stopped by StopStream,
+	// finished normally or failed (see StreamInfo.Error).
+	StreamStateStopped
+
+	EndOfStreamState
+)
+
+func (s StreamState) String() string {
+	switch s {
+	case UndefinedStreamState:
+		return "<undefined>"
+	case StreamStateCreated:
+		return "created"
+	case StreamStateRunning:
+		return "running"
+	case StreamStateStopped:
+		return "stopped"
+	default:
+		return fmt.Sprintf("<unknown_%d>", int(s))
+	}
+}
+
+// Stream is an FFStream hosted by the Daemon.
+//
+// An FFStream cannot be started twice, so a stopped stream is
+// re-created from its arguments on the next start.
+type Stream struct {
+	// ID is the unique identifier of the stream within the Daemon.
+	ID string
+
+	// Args are the arguments of the stream in the same form as of
+	// the ffstream command (without the program name).
+	Args []string
+
+	locker        xsync.Mutex
+	config        []byte
+	flags         ffargs.Flags
+	ffStream      *ffstream.FFStream
+	runtimeConfig ffstream.RuntimeConfig
+	grpcServer    *ffstreamserver.GRPCServer
+	state         StreamState
+	err           error
+
+	// ctx is the context of the current FFStream, cancelled by cancelFn.
+	ctx      context.Context
+	cancelFn context.CancelFunc
+
+	// done is closed when the running FFStream is finished.
+	done chan struct{}
+}
+
+// StreamInfo is the state of a Stream at some moment.
+type StreamInfo struct {
+	ID    string
+	Args  []string
+	State StreamState
+
+	// Error is the error the stream is finished with, if any.
+	Error error
+}
+
+// Info returns the current state of the stream.
+func (st *Stream) Info(ctx context.Context) StreamInfo {
+	return xsync.DoR1(ctx, &st.locker, func() StreamInfo {
+		return StreamInfo{
+			ID:    st.ID,
+			Args:  st.Args,
+			State: st.state,
+			Error: st.err,
+		}
+	})
+}
+
+// FFStream returns the current FFStream of the stream.
+func (st *Stream) FFStream(ctx context.Context) *ffstream.FFStream {
+	return xsync.DoR1(ctx, &st.locker, func() *ffstream.FFStream {
+		return st.ffStream
+	})
+}
+
+// parseStreamFlags parses the arguments of a stream and
+// rejects the flags that belong to ffstreamd itself.
+func parseStreamFlags(
+	ctx context.Context,
+	args []string,
+	config []byte,
+) (ffargs.Flags, error) {
+	flags, err := ffargs.ParseWithConfig(ctx, args, config)
+	if err != nil {
+		return ffargs.Flags{}, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+	}
+	for _, item := range []struct {
+		Flag  string
+		IsSet bool
+	}{
+		{"-listen_control", flags.ListenControlSocket != ""},
+		{"-listen_http", flags.ListenHTTP != ""},
+		{"-listen_net_pprof", flags.ListenNetPprof != ""},
+		{"-listen_metrics", flags.ListenMetrics != ""},
+		{"-auth_*", flags.AuthAdminToken != "" || flags.AuthReadOnlyToken != ""},
+		{"-tls_*", flags.TLSCert != "" || flags.TLSKey != "" || flags.TLSClientCA != ""},
+		{"-version, -demuxers, -encoders, -decoders", flags.PrintVersion || flags.PrintDemuxers || flags.PrintEncoders || flags.PrintDecoders},
+	} {
+		if item.IsSet {
+			return ffargs.Flags{}, fmt.Errorf("%w: %s is not supported per stream, it is a flag of ffstreamd itself", ErrInvalidArguments, item.Flag)
+		}
+	}
+	if flags.WebhookURL != "" {
+		if _, err := ffstreamwebhook.New(webhookConfig(flags)); err != nil {
+			return ffargs.Flags{}, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+	}
+	return flags, nil
+}
+
+// initLocked (re-)creates the FFStream from the arguments.
+func (st *Stream) initLocked(parentCtx context.Context) error {
+	flags, err := parseStreamFlags(parentCtx, st.Args, st.config)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelFn := context.WithCancel(belt.WithField(parentCtx, "stream_id", st.ID))
+	s, cfg, err := ffargs.New(ctx, flags)
+	if err != nil {
+		cancelFn()
+		return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+	}
+	grpcServer := ffstreamserver.NewGRPCServer(ctx, s)
+	grpcServer.ConfigApplier = st.applyConfig
+
+	st.flags = flags
+	st.ffStream = s
+	st.runtimeConfig = cfg
+	st.grpcServer = grpcServer
+	st.ctx = ctx
+	st.cancelFn = cancelFn
+	st.state = StreamStateCreated
+	st.err = nil
+	return nil
+}
+
+// startLocked starts the FFStream, re-creating it if it was stopped.
+func (st *Stream) startLocked(parentCtx context.Context) error {
+	switch st.state {
+	case StreamStateRunning:
+		return ErrStreamIsRunning
+	case StreamStateStopped:
+		if err := st.initLocked(parentCtx); err != nil {
+			return err
+		}
+	}
+	ctx, s, flags := st.ctx, st.ffStream, st.flags
+
+	// the notifier outlives ctx to deliver the last events (e.g. end_of_stream)
+	webhookCtx, webhookCancelFn := context.WithCancel(context.WithoutCancel(ctx))
+	var webhookDone chan struct{}
+	if flags.WebhookURL != "" {
+		notifier, err := ffstreamwebhook.New(webhookConfig(flags))
+		if err != nil {
+			webhookCancelFn()
+			return err
+		}
+		events := s.SubscribeEvents(webhookCtx)
+		webhookDone = make(chan struct{})
+		observability.Go(webhookCtx, func(ctx context.Context) {
+			defer close(webhookDone)
+			logger.Infof(ctx, "sending the events to the webhook %s", flags.WebhookURL)
+			notifier.Serve(ctx, events)
+		})
+	}
+
+	if err := ffargs.Start(ctx, s, st.runtimeConfig); err != nil {
+		webhookCancelFn()
+		st.cancelFn()
+		st.state = StreamStateStopped
+		st.err = err
+		return err
+	}
+
+	done := make(chan struct{})
+	st.state = StreamStateRunning
+	st.err = nil
+	st.done = done
+	observability.Go(ctx, func(ctx context.Context) {
+		defer close(done)
+		err := s.Wait(context.WithoutCancel(ctx))
+		if ctx.Err() != nil && errors.Is(err, context.Canceled) {
+			// stopped by StopStream or DeleteStream
+			err = nil
+		}
+		if webhookDone != nil {
+			select {
+			case <-webhookDone:
+			case <-time.After(webhookConfig(flags).DrainTimeout()):
+				logger.Warnf(ctx, "timed out on sending the last events to the webhook")
+			}
+		}
+		webhookCancelFn()
+		st.locker.Do(context.WithoutCancel(ctx), func() {
+			st.cancelFn()
+			st.state = StreamStateStopped
+			st.err = err
+		})
+		if err != nil {
+			logger.Errorf(ctx, "the stream is finished with an error: %v", err)
+			return
+		}
+		logger.Infof(ctx, "the stream is finished")
+	})
+	return nil
+}
+
+// stop stops the stream (if it is running) and waits until it is finished.
+func (st *Stream) stop(ctx context.Context) error {
+	done, err := xsync.DoR2(ctx, &st.locker, func() (chan struct{}, error) {
+		if st.state != StreamStateRunning {
+			return nil, ErrStreamIsNotRunning
+		}
+		st.cancelFn()
+		return st.done, nil
+	})
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return nil
+	}
+}
+
+// applyConfig implements ffstreamserver.ConfigApplier: the same as
+// ApplyConfig of the ffstream command, but nil config means the config
+// the stream was created (or last reconfigured) with.
+func (st *Stream) applyConfig(
+	ctx context.Context,
+	config []byte,
+	dryRun bool,
+) ([]ffstream.ConfigChange, error) {
+	return xsync.DoR2(ctx, &st.locker, func() ([]ffstream.ConfigChange, error) {
+		if config == nil {
+			config = st.config
+		}
+		changes, err := ffargs.Reload(ctx, st.ffStream, st.flags, st.Args, config, dryRun)
+		if err == nil && !dryRun {
+			// to be used on the next start
+			st.config = config
+		}
+		return changes, err
+	})
+}
@@ -0,0 +1,392 @@
+// Package ffstreammetrics exports the metrics of an FFStream to Prometheus.
+package ffstreammetrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	quality "github.com/xaionaro-go/avpipeline/packetorframe/filter/quality/types"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	avpipeline_grpc "github.com/xaionaro-go/avpipeline/protobuf/avpipeline"
+	goconvavp "github.com/xaionaro-go/avpipeline/protobuf/goconv/avpipeline"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+)
+
+const namespace = "ffstream"
+
+// Collector is a prometheus.Collector which reads the metrics from the FFStream
+// on each scrape (so nothing is collected in background).
+type Collector struct {
+	ctx      context.Context
+	FFStream *ffstream.FFStream
+
+	inputPackets        *prometheus.Desc
+	inputBytes          *prometheus.Desc
+	outputPackets       *prometheus.Desc
+	outputBytes         *prometheus.Desc
+	destinationPackets  *prometheus.Desc
+	destinationBytes    *prometheus.Desc
+	destinationFailed   *prometheus.Desc
+	bitRate             *prometheus.Desc
+	latency             *prometheus.Desc
+	inputQuality        qualityDescs
+	outputQuality       qualityDescs
+	inputActivePriority *prometheus.Desc
+	inputPriorityActive *prometheus.Desc
+	outputActive        *prometheus.Desc
+	videoWidth          *prometheus.Desc
+	videoHeight         *prometheus.Desc
+	videoBitRate        *prometheus.Desc
+	videoMaxBitRate     *prometheus.Desc
+	retryAttempts       *prometheus.Desc
+	retrySuccesses      *prometheus.Desc
+	retryExhausted      *prometheus.Desc
+
+	srt srtDescs
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
+
+// NewCollector returns a new Collector of the metrics of the given FFStream;
+// the context is used for logging.
+func NewCollector(
+	ctx context.Context,
+	s *ffstream.FFStream,
+) *Collector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, labels, nil)
+	}
+	return &Collector{
+		ctx:      ctx,
+		FFStream: s,
+
+		inputPackets:        desc("input_packets_total", "The amount of packets passed through the input node of the fallback priority.", "input_priority", "section", "media_type"),
+		inputBytes:          desc("input_bytes_total", "The amount of bytes passed through the input node of the fallback priority.", "input_priority", "section", "media_type"),
+		outputPackets:       desc("output_packets_total", "The amount of packets passed through the output node.", "output_id", "section", "media_type"),
+		outputBytes:         desc("output_bytes_total", "The amount of bytes passed through the output node.", "output_id", "section", "media_type"),
+		destinationPackets:  desc("output_destination_packets_total", "The amount of packets passed through the node of the output destination (output template).", "output_id", "destination", "section", "media_type"),
+		destinationBytes:    desc("output_destination_bytes_total", "The amount of bytes passed through the node of the output destination (output template).", "output_id", "destination", "section", "media_type"),
+		destinationFailed:   desc("output_destination_failed", "1 if the output destination failed and is detached, otherwise 0.", "output_id", "destination"),
+		bitRate:             desc("bit_rate_bits_per_second", "The measured bit rate.", "stage", "media_type"),
+		latency:             desc("latency_seconds", "The measured latency.", "stage", "media_type"),
+		inputQuality:        newQualityDescs(desc, "input", "of the inputs of the active fallback priority", "input_priority"),
+		outputQuality:       newQualityDescs(desc, "output", "of the measured destination of the active output", "output_id", "destination"),
+		inputActivePriority: desc("input_active_priority", "The fallback priority the stream is received from."),
+		inputPriorityActive: desc("input_priority_active", "1 if the inputs of the fallback priority are opened, otherwise 0.", "input_priority"),
+		outputActive:        desc("output_active", "1 if the stream is sent to the output, otherwise 0 (standby).", "output_id"),
+		videoWidth:          desc("video_width", "The width of the encoded video."),
+		videoHeight:         desc("video_height", "The height of the encoded video."),
+		videoBitRate:        desc("video_bit_rate_bits_per_second", "The target bit rate of the video encoder."),
+		videoMaxBitRate:     desc("video_max_bit_rate_bits_per_second", "The maximal bit rate allowed by the automatic bit rate control (0 if disabled)."),
+		retryAttempts:       desc("retry_attempts_total", "The amount of attempts to open an input or an output.", "target"),
+		retrySuccesses:      desc("retry_successes_total", "The amount of successful attempts to open an input or an output.", "target"),
+		retryExhausted:      desc("retry_exhausted_total", "The amount of times the retries of an input or an output were exhausted.", "target"),
+
+		srt: newSRTDescs(desc),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		c.inputPackets, c.inputBytes,
+		c.outputPackets, c.outputBytes,
+		c.destinationPackets, c.destinationBytes, c.destinationFailed,
+		c.bitRate, c.latency,
+		c.inputActivePriority, c.inputPriorityActive, c.outputActive,
+		c.videoWidth, c.videoHeight, c.videoBitRate, c.videoMaxBitRate,
+		c.retryAttempts, c.retrySuccesses, c.retryExhausted,
+	} {
+		ch <- d
+	}
+	c.inputQuality.describe(ch)
+	c.outputQuality.describe(ch)
+	c.srt.describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx := c.ctx
+	c.collectInputs(ctx, ch)
+	c.collectOutputs(ctx, ch)
+	c.collectBitRates(ctx, ch)
+	c.collectLatencies(ctx, ch)
+	c.collectQuality(ctx, ch)
+	c.collectVideo(ctx, ch)
+	c.collectRetries(ch)
+	c.collectSRT(ctx, ch)
+}
+
+func (c *Collector) collectInputs(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	s := c.FFStream
+	if s.Inputs == nil {
+		return
+	}
+	activePriority, hasActive := s.GetActiveInputPriority(ctx)
+	if hasActive {
+		ch <- prometheus.MustNewConstMetric(c.inputActivePriority, prometheus.GaugeValue, float64(activePriority))
+	}
+
+	var inputChains []*ffstream.InputChain
+	s.Inputs.InputChainsLocker.Do(ctx, func() {
+		inputChains = append(inputChains, s.Inputs.InputChains...)
+	})
+	for priority, inputChain := range inputChains {
+		priorityLabel := strconv.Itoa(priority)
+		isActive := 0.0
+		if ffstream.IsInputChainOpened(ctx, inputChain) {
+			isActive = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.inputPriorityActive, prometheus.GaugeValue, isActive, priorityLabel)
+		counters := goconvavp.NodeCountersToGRPC(inputChain.Input.GetCountersPtr(), inputChain.Input.GetProcessor().CountersPtr())
+		c.collectNodeCounters(ch, c.inputPackets, c.inputBytes, counters, priorityLabel)
+	}
+}
+
+func (c *Collector) collectOutputs(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	outputs, err := c.FFStream.ListOutputs(ctx)
+	if err != nil {
+		logger.Tracef(ctx, "unable to list the outputs: %v", err)
+		return
+	}
+	for _, output := range outputs {
+		outputLabel := strconv.FormatUint(uint64(output.ID), 10)
+		isActive := 0.0
+		if output.IsActive {
+			isActive = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.outputActive, prometheus.GaugeValue, isActive, outputLabel)
+		counters := goconvavp.NodeCountersToGRPC(
+			output.Output.SendingNode.GetCountersPtr(),
+			output.Output.SendingNode.GetProcessor().CountersPtr(),
+		)
+		c.collectNodeCounters(ch, c.outputPackets, c.outputBytes, counters, outputLabel)
+		for _, dst := range output.Destinations {
+			dstLabel := strconv.Itoa(dst.Index)
+			isFailed := 0.0
+			if dst.Err != nil {
+				isFailed = 1
+			}
+			ch <- prometheus.MustNewConstMetric(c.destinationFailed, prometheus.GaugeValue, isFailed, outputLabel, dstLabel)
+			counters := goconvavp.NodeCountersToGRPC(
+				dst.SendingNode.GetCountersPtr(),
+				dst.SendingNode.GetProcessor().CountersPtr(),
+			)
+			c.collectNodeCounters(ch, c.destinationPackets, c.destinationBytes, counters, outputLabel, dstLabel)
+		}
+	}
+}
+
+func (c *Collector) collectNodeCounters(
+	ch chan<- prometheus.Metric,
+	packetsDesc *prometheus.Desc,
+	bytesDesc *prometheus.Desc,
+	counters *avpipeline_grpc.NodeCounters,
+	labels ...string,
+) {
+	if counters == nil {
+		return
+	}
+	for _, section := range []struct {
+		Name    string
+		Section *avpipeline_grpc.NodeCountersSection
+	}{
+		{"received", counters.GetReceived()},
+		{"processed", counters.GetProcessed()},
+		{"missed", counters.GetMissed()},
+		{"generated", counters.GetGenerated()},
+		{"sent", counters.GetSent()},
+	} {
+		packets := section.Section.GetPackets()
+		for _, item := range []struct {
+			MediaType string
+			Item      *avpipeline_grpc.NodeCountersItem
+		}{
+			{"video", packets.GetVideo()},
+			{"audio", packets.GetAudio()},
+			{"other", packets.GetOther()},
+			{"unknown", packets.GetUnknown()},
+		} {
+			if item.Item == nil {
+				continue
+			}
+			labelValues := append(append([]string{}, labels...), section.Name, item.MediaType)
+			ch <- prometheus.MustNewConstMetric(packetsDesc, prometheus.CounterValue, float64(item.Item.GetCount()), labelValues...)
+			ch <- prometheus.MustNewConstMetric(bytesDesc, prometheus.CounterValue, float64(item.Item.GetBytes()), labelValues...)
+		}
+	}
+}
+
+func (c *Collector) collectBitRates(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	bitRates, err := c.FFStream.GetBitRates(ctx)
+	if err != nil {
+		logger.Tracef(ctx, "unable to get the bit rates: %v", err)
+		return
+	}
+	for _, stage := range []struct {
+		Name string
+		Info streammuxtypes.BitRateInfo
+	}{
+		{"input", bitRates.Input},
+		{"encoded", bitRates.Encoded},
+		{"output", bitRates.Output},
+	} {
+		ch <- prometheus.MustNewConstMetric(c.bitRate, prometheus.GaugeValue, float64(stage.Info.Video), stage.Name, "video")
+		ch <- prometheus.MustNewConstMetric(c.bitRate, prometheus.GaugeValue, float64(stage.Info.Audio), stage.Name, "audio")
+		ch <- prometheus.MustNewConstMetric(c.bitRate, prometheus.GaugeValue, float64(stage.Info.Other), stage.Name, "other")
+	}
+}
+
+func (c *Collector) collectLatencies(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	latencies, err := c.FFStream.GetLatencies(ctx)
+	if err != nil {
+		logger.Tracef(ctx, "unable to get the latencies: %v", err)
+		return
+	}
+	for _, track := range []struct {
+		MediaType string
+		Latencies streammuxtypes.TrackLatencies
+	}{
+		{"video", latencies.Video},
+		{"audio", latencies.Audio},
+	} {
+		for _, stage := range []struct {
+			Name  string
+			Value time.Duration
+		}{
+			{"pre_transcoding", track.Latencies.PreTranscoding},
+			{"transcoding", track.Latencies.Transcoding},
+			{"transcoded_pre_send", track.Latencies.TranscodedPreSend},
+			{"sending", track.Latencies.Sending},
+		} {
+			ch <- prometheus.MustNewConstMetric(c.latency, prometheus.GaugeValue, stage.Value.Seconds(), stage.Name, track.MediaType)
+		}
+	}
+}
+
+// qualityDescs are the descriptions of the quality metrics of a side
+// (the input or the output) of the stream.
+type qualityDescs struct {
+	continuity *prometheus.Desc
+	overlap    *prometheus.Desc
+	frameRate  *prometheus.Desc
+	invalidDTS *prometheus.Desc
+}
+
+func newQualityDescs(
+	desc func(name, help string, labels ...string) *prometheus.Desc,
+	side string,
+	of string,
+	labels ...string,
+) qualityDescs {
+	labels = append(labels, "media_type")
+	return qualityDescs{
+		continuity: desc(side+"_quality_continuity", "The continuity of the stream "+of+" (1 means no gaps).", labels...),
+		overlap:    desc(side+"_quality_overlap", "The overlap of the stream "+of+" (0 means no overlapping timestamps).", labels...),
+		frameRate:  desc(side+"_quality_frame_rate", "The measured frame rate of the stream "+of+".", labels...),
+		invalidDTS: desc(side+"_quality_invalid_dts", "The amount of packets with invalid DTS of the stream "+of+".", labels...),
+	}
+}
+
+func (d qualityDescs) describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		d.continuity, d.overlap, d.frameRate, d.invalidDTS,
+	} {
+		ch <- desc
+	}
+}
+
+func (d qualityDescs) collect(
+	ch chan<- prometheus.Metric,
+	q *quality.QualityAggregated,
+	labels ...string,
+) {
+	for _, stream := range []struct {
+		MediaType string
+		Quality   quality.StreamQuality
+	}{
+		{"video", q.Video},
+		{"audio", q.Audio},
+	} {
+		labelValues := append(append([]string{}, labels...), stream.MediaType)
+		ch <- prometheus.MustNewConstMetric(d.continuity, prometheus.GaugeValue, float64(stream.Quality.Continuity), labelValues...)
+		ch <- prometheus.MustNewConstMetric(d.overlap, prometheus.GaugeValue, float64(stream.Quality.Overlap), labelValues...)
+		ch <- prometheus.MustNewConstMetric(d.frameRate, prometheus.GaugeValue, float64(stream.Quality.FrameRate), labelValues...)
+		ch <- prometheus.MustNewConstMetric(d.invalidDTS, prometheus.GaugeValue, float64(stream.Quality.InvalidDTS), labelValues...)
+	}
+}
+
+// collectQuality collects the quality of the stream received from the active
+// fallback priority and of the stream sent to the active output; nothing is
+// collected for a side which is not active (the measurements would belong
+// to no input or output).
+func (c *Collector) collectQuality(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	s := c.FFStream
+	if s.Inputs != nil {
+		if priority, ok := s.GetActiveInputPriority(ctx); ok {
+			q, err := s.GetInputQuality(ctx)
+			if err != nil {
+				logger.Tracef(ctx, "unable to get the input quality: %v", err)
+			} else {
+				c.inputQuality.collect(ch, q, strconv.FormatUint(uint64(priority), 10))
+			}
+		}
+	}
+
+	if outputID, ok := s.GetActiveOutputID(ctx); ok {
+		q, err := s.GetOutputQuality(ctx)
+		if err != nil {
+			logger.Tracef(ctx, "unable to get the output quality: %v", err)
+		} else {
+			c.outputQuality.collect(ch, q, strconv.FormatUint(uint64(outputID), 10), strconv.Itoa(ffstream.OutputQualityDestination))
+		}
+	}
+}
+
+func (c *Collector) collectVideo(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	s := c.FFStream
+	if s.StreamMux == nil {
+		return
+	}
+	cfg := s.GetTranscoderConfig(ctx)
+	if len(cfg.Output.VideoTrackConfigs) > 0 {
+		video := cfg.Output.VideoTrackConfigs[0]
+		ch <- prometheus.MustNewConstMetric(c.videoWidth, prometheus.GaugeValue, float64(video.Resolution.Width))
+		ch <- prometheus.MustNewConstMetric(c.videoHeight, prometheus.GaugeValue, float64(video.Resolution.Height))
+		ch <- prometheus.MustNewConstMetric(c.videoBitRate, prometheus.GaugeValue, float64(video.AverageBitRate))
+	}
+	ch <- prometheus.MustNewConstMetric(c.videoMaxBitRate, prometheus.GaugeValue, float64(s.GetMaxBitRate(ctx)))
+}
+
+func (c *Collector) collectRetries(
+	ch chan<- prometheus.Metric,
+) {
+	for _, target := range []ffstream.RetryTarget{ffstream.RetryTargetInput, ffstream.RetryTargetOutput} {
+		counters := c.FFStream.GetRetryCounters(target)
+		label := target.String()
+		ch <- prometheus.MustNewConstMetric(c.retryAttempts, prometheus.CounterValue, float64(counters.Attempts.Load()), label)
+		ch <- prometheus.MustNewConstMetric(c.retrySuccesses, prometheus.CounterValue, float64(counters.Successes.Load()), label)
+		ch <- prometheus.MustNewConstMetric(c.retryExhausted, prometheus.CounterValue, float64(counters.Exhausted.Load()), label)
+	}
+}
@@ -0,0 +1,26 @@
+//go:build !with_libsrt
+// +build !with_libsrt
+
+package ffstreammetrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type srtDescs struct{}
+
+func newSRTDescs(
+	func(name, help string, labels ...string) *prometheus.Desc,
+) srtDescs {
+	return srtDescs{}
+}
+
+func (srtDescs) describe(chan<- *prometheus.Desc) {}
+
+func (c *Collector) collectSRT(
+	context.Context,
+	chan<- prometheus.Metric,
+) {
+}
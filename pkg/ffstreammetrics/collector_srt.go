@@ -0,0 +1,144 @@
+//go:build with_libsrt
+// +build with_libsrt
+
+package ffstreammetrics
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/libsrt"
+)
+
+type srtDescs struct {
+	outputRTT       *prometheus.Desc
+	outputSendRate  *prometheus.Desc
+	outputBandwidth *prometheus.Desc
+	outputLoss      *prometheus.Desc
+	outputRetrans   *prometheus.Desc
+	outputDrop      *prometheus.Desc
+	outputSendBuf   *prometheus.Desc
+	inputRTT        *prometheus.Desc
+	inputRecvRate   *prometheus.Desc
+	inputBandwidth  *prometheus.Desc
+	inputLoss       *prometheus.Desc
+	inputRetrans    *prometheus.Desc
+	inputDrop       *prometheus.Desc
+	inputRecvBuf    *prometheus.Desc
+}
+
+func newSRTDescs(
+	desc func(name, help string, labels ...string) *prometheus.Desc,
+) srtDescs {
+	outputLabels := []string{"output_id", "destination"}
+	inputLabels := []string{"input_priority", "input_num"}
+	return srtDescs{
+		outputRTT:       desc("output_srt_rtt_seconds", "The round-trip time of the SRT output.", outputLabels...),
+		outputSendRate:  desc("output_srt_send_rate_bits_per_second", "The sending rate of the SRT output.", outputLabels...),
+		outputBandwidth: desc("output_srt_bandwidth_bits_per_second", "The estimated bandwidth of the SRT output.", outputLabels...),
+		outputLoss:      desc("output_srt_lost_packets_total", "The amount of packets lost by the SRT output.", outputLabels...),
+		outputRetrans:   desc("output_srt_retransmitted_packets_total", "The amount of packets retransmitted by the SRT output.", outputLabels...),
+		outputDrop:      desc("output_srt_dropped_packets_total", "The amount of packets dropped (too late to send) by the SRT output.", outputLabels...),
+		outputSendBuf:   desc("output_srt_send_buffer_seconds", "The amount of data in the sending buffer of the SRT output.", outputLabels...),
+		inputRTT:        desc("input_srt_rtt_seconds", "The round-trip time of the SRT input.", inputLabels...),
+		inputRecvRate:   desc("input_srt_receive_rate_bits_per_second", "The receiving rate of the SRT input.", inputLabels...),
+		inputBandwidth:  desc("input_srt_bandwidth_bits_per_second", "The estimated bandwidth of the SRT input.", inputLabels...),
+		inputLoss:       desc("input_srt_lost_packets_total", "The amount of packets lost on the way to the SRT input.", inputLabels...),
+		inputRetrans:    desc("input_srt_retransmitted_packets_total", "The amount of retransmitted packets received by the SRT input.", inputLabels...),
+		inputDrop:       desc("input_srt_dropped_packets_total", "The amount of packets dropped (arrived too late) by the SRT input.", inputLabels...),
+		inputRecvBuf:    desc("input_srt_receive_buffer_seconds", "The amount of data in the receiving buffer of the SRT input.", inputLabels...),
+	}
+}
+
+func (d srtDescs) describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		d.outputRTT, d.outputSendRate, d.outputBandwidth, d.outputLoss, d.outputRetrans, d.outputDrop, d.outputSendBuf,
+		d.inputRTT, d.inputRecvRate, d.inputBandwidth, d.inputLoss, d.inputRetrans, d.inputDrop, d.inputRecvBuf,
+	} {
+		ch <- desc
+	}
+}
+
+func (c *Collector) collectSRT(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	s := c.FFStream
+	d := c.srt
+
+	outputs, err := s.ListOutputs(ctx)
+	if err != nil {
+		logger.Tracef(ctx, "unable to list the outputs: %v", err)
+	}
+	for _, output := range outputs {
+		for _, dst := range output.Destinations {
+			stats, err := getSRTStats(func(callback func(*ffstream.SRTSocket) error) error {
+				return s.WithSRTOutput(ctx, int(output.ID), uint(dst.Index), callback)
+			})
+			if err != nil {
+				logger.Tracef(ctx, "unable to get the SRT stats of output %d destination #%d: %v", output.ID, dst.Index, err)
+				continue
+			}
+			labels := []string{strconv.FormatUint(uint64(output.ID), 10), strconv.Itoa(dst.Index)}
+			ch <- prometheus.MustNewConstMetric(d.outputRTT, prometheus.GaugeValue, stats.MsRTT/1000, labels...)
+			ch <- prometheus.MustNewConstMetric(d.outputSendRate, prometheus.GaugeValue, stats.MbpsSendRate*1000000, labels...)
+			ch <- prometheus.MustNewConstMetric(d.outputBandwidth, prometheus.GaugeValue, stats.MbpsBandwidth*1000000, labels...)
+			ch <- prometheus.MustNewConstMetric(d.outputLoss, prometheus.CounterValue, float64(stats.PktSndLossTotal), labels...)
+			ch <- prometheus.MustNewConstMetric(d.outputRetrans, prometheus.CounterValue, float64(stats.PktRetransTotal), labels...)
+			ch <- prometheus.MustNewConstMetric(d.outputDrop, prometheus.CounterValue, float64(stats.PktSndDropTotal), labels...)
+			ch <- prometheus.MustNewConstMetric(d.outputSendBuf, prometheus.GaugeValue, float64(stats.MsSndBuf)/1000, labels...)
+		}
+	}
+
+	if s.Inputs == nil {
+		return
+	}
+	for priority := uint(0); priority < uint(s.Inputs.GetInputChainsCount()); priority++ {
+		inputs, err := s.GetInputKernels(ctx, priority)
+		if err != nil {
+			logger.Tracef(ctx, "unable to get the inputs at priority %d: %v", priority, err)
+			continue
+		}
+		for num := range inputs {
+			stats, err := getSRTStats(func(callback func(*ffstream.SRTSocket) error) error {
+				return s.WithSRTInput(ctx, priority, uint(num), callback)
+			})
+			if err != nil {
+				// most of inputs are not SRT
+				continue
+			}
+			labels := []string{strconv.FormatUint(uint64(priority), 10), strconv.Itoa(num)}
+			ch <- prometheus.MustNewConstMetric(d.inputRTT, prometheus.GaugeValue, stats.MsRTT/1000, labels...)
+			ch <- prometheus.MustNewConstMetric(d.inputRecvRate, prometheus.GaugeValue, stats.MbpsRecvRate*1000000, labels...)
+			ch <- prometheus.MustNewConstMetric(d.inputBandwidth, prometheus.GaugeValue, stats.MbpsBandwidth*1000000, labels...)
+			ch <- prometheus.MustNewConstMetric(d.inputLoss, prometheus.CounterValue, float64(stats.PktRcvLossTotal), labels...)
+			ch <- prometheus.MustNewConstMetric(d.inputRetrans, prometheus.CounterValue, float64(stats.PktRcvRetrans), labels...)
+			ch <- prometheus.MustNewConstMetric(d.inputDrop, prometheus.CounterValue, float64(stats.PktRcvDropTotal), labels...)
+			ch <- prometheus.MustNewConstMetric(d.inputRecvBuf, prometheus.GaugeValue, float64(stats.MsRcvBuf)/1000, labels...)
+		}
+	}
+}
+
+func getSRTStats(
+	withSocket func(func(*ffstream.SRTSocket) error) error,
+) (*libsrt.Tracebstats, error) {
+	var stats *libsrt.Tracebstats
+	err := withSocket(func(sock *ffstream.SRTSocket) error {
+		result, err := sock.Bistats(false, true)
+		if err == nil {
+			stats = ptr(result.Convert())
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
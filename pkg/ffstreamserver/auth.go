@@ -0,0 +1,202 @@
+package ffstreamserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Scope is the access level of a token.
+type Scope int
+
+const (
+	UndefinedScope = Scope(iota)
+
+	// ScopeReadOnly allows the RPCs that do not change anything
+	// (statistics, configs, subscriptions to events, etc).
+	ScopeReadOnly
+
+	// ScopeAdmin allows all the RPCs.
+	ScopeAdmin
+
+	EndOfScope
+)
+
+func (s Scope) String() string {
+	switch s {
+	case UndefinedScope:
+		return "<undefined>"
+	case ScopeReadOnly:
+		return "read-only"
+	case ScopeAdmin:
+		return "admin"
+	default:
+		return fmt.Sprintf("<unknown_%d>", int(s))
+	}
+}
+
+// readOnlyMethods are the RPCs allowed with ScopeReadOnly,
+// the rest of the RPCs require ScopeAdmin.
+var readOnlyMethods = map[string]struct{}{
+	ffstream_grpc.FFStream_GetCurrentOutput_FullMethodName:              {},
+	ffstream_grpc.FFStream_GetStats_FullMethodName:                      {},
+	ffstream_grpc.FFStream_GetOutputSRTStats_FullMethodName:             {},
+	ffstream_grpc.FFStream_GetSRTFlagInt_FullMethodName:                 {},
+	ffstream_grpc.FFStream_WaitChan_FullMethodName:                      {},
+	ffstream_grpc.FFStream_GetPipelines_FullMethodName:                  {},
+	ffstream_grpc.FFStream_GetVideoAutoBitRateConfig_FullMethodName:     {},
+	ffstream_grpc.FFStream_GetVideoAutoBitRateCalculator_FullMethodName: {},
+	ffstream_grpc.FFStream_GetFPSFraction_FullMethodName:                {},
+	ffstream_grpc.FFStream_GetBitRates_FullMethodName:                   {},
+	ffstream_grpc.FFStream_GetLatencies_FullMethodName:                  {},
+	ffstream_grpc.FFStream_GetInputQuality_FullMethodName:               {},
+	ffstream_grpc.FFStream_GetOutputQuality_FullMethodName:              {},
+	ffstream_grpc.FFStream_Monitor_FullMethodName:                       {},
+	ffstream_grpc.FFStream_GetInputsInfo_FullMethodName:                 {},
+	ffstream_grpc.FFStream_ListOutputs_FullMethodName:                   {},
+	ffstream_grpc.FFStream_WatchOutputSRTStats_FullMethodName:           {},
+	ffstream_grpc.FFStream_GetInputSRTStats_FullMethodName:              {},
+	ffstream_grpc.FFStream_GetInputSRTFlagInt_FullMethodName:            {},
+	ffstream_grpc.FFStream_WatchOutputSwitches_FullMethodName:           {},
+	ffstream_grpc.FFStream_GetRetryPolicy_FullMethodName:                {},
+	ffstream_grpc.FFStream_SubscribeEvents_FullMethodName:               {},
+	ffstream_grpc.FFStreamDaemon_ListStreams_FullMethodName:             {},
+}
+
+// MethodScope returns the scope required to call the RPC
+// (given by its full name, e.g. "/ffstream_grpc.FFStream/GetStats").
+func MethodScope(fullMethod string) Scope {
+	if _, ok := readOnlyMethods[fullMethod]; ok {
+		return ScopeReadOnly
+	}
+	return ScopeAdmin
+}
+
+// Auth is the bearer-token authentication of the control API
+// (the "authorization: Bearer <token>" metadata/header).
+// If no tokens are set, all the requests are allowed.
+type Auth struct {
+	ReadOnlyTokens []string
+	AdminTokens    []string
+}
+
+// NewAuth returns the authentication with the given tokens
+// (e.g. of flags -auth_admin_token and -auth_read_only_token);
+// the empty tokens are ignored.
+func NewAuth(adminToken, readOnlyToken string) *Auth {
+	auth := &Auth{}
+	if adminToken != "" {
+		auth.AdminTokens = append(auth.AdminTokens, adminToken)
+	}
+	if readOnlyToken != "" {
+		auth.ReadOnlyTokens = append(auth.ReadOnlyTokens, readOnlyToken)
+	}
+	return auth
+}
+
+// IsEnabled returns true if any token is set.
+func (a *Auth) IsEnabled() bool {
+	return a != nil && (len(a.ReadOnlyTokens) != 0 || len(a.AdminTokens) != 0)
+}
+
+// TokenScope returns the scope granted by the token,
+// or UndefinedScope if the token is not known.
+func (a *Auth) TokenScope(token string) Scope {
+	if token == "" {
+		return UndefinedScope
+	}
+	// checking all the tokens (instead of returning on the first match)
+	// to make the time independent of which token matched
+	scope := UndefinedScope
+	for _, t := range a.AdminTokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			scope = ScopeAdmin
+		}
+	}
+	for _, t := range a.ReadOnlyTokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 && scope == UndefinedScope {
+			scope = ScopeReadOnly
+		}
+	}
+	return scope
+}
+
+// Authorize returns nil if the token allows to call the RPC, otherwise
+// an error with codes.Unauthenticated (no or unknown token) or
+// codes.PermissionDenied (the scope of the token is not enough).
+func (a *Auth) Authorize(
+	token string,
+	fullMethod string,
+) error {
+	if !a.IsEnabled() {
+		return nil
+	}
+	if token == "" {
+		return status.Errorf(codes.Unauthenticated, "no token provided")
+	}
+	scope := a.TokenScope(token)
+	if scope == UndefinedScope {
+		return status.Errorf(codes.Unauthenticated, "invalid token")
+	}
+	if required := MethodScope(fullMethod); scope < required {
+		return status.Errorf(codes.PermissionDenied, "%s requires the %s scope, but the token has %s", fullMethod, required, scope)
+	}
+	return nil
+}
+
+// BearerToken returns the token of an "authorization" value
+// in form "Bearer <token>", or an empty string.
+func BearerToken(authorization string) string {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func tokenFromIncomingContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if token := BearerToken(v); token != "" {
+			return token
+		}
+	}
+	return ""
+}
+
+// UnaryServerInterceptor returns the interceptor enforcing Authorize on unary RPCs.
+func (a *Auth) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := a.Authorize(tokenFromIncomingContext(ctx), info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the interceptor enforcing Authorize on streaming RPCs.
+func (a *Auth) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := a.Authorize(tokenFromIncomingContext(ss.Context()), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,53 @@
+package ffstreamserver
+
+import (
+	"testing"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthAuthorize(t *testing.T) {
+	auth := &Auth{
+		ReadOnlyTokens: []string{"viewer"},
+		AdminTokens:    []string{"admin"},
+	}
+	for _, tc := range []struct {
+		token  string
+		method string
+		want   codes.Code
+	}{
+		{"", ffstream_grpc.FFStream_GetBitRates_FullMethodName, codes.Unauthenticated},
+		{"wrong", ffstream_grpc.FFStream_GetBitRates_FullMethodName, codes.Unauthenticated},
+		{"viewer", ffstream_grpc.FFStream_GetBitRates_FullMethodName, codes.OK},
+		{"viewer", ffstream_grpc.FFStream_SubscribeEvents_FullMethodName, codes.OK},
+		{"viewer", ffstream_grpc.FFStream_End_FullMethodName, codes.PermissionDenied},
+		{"viewer", ffstream_grpc.FFStream_SetStopInput_FullMethodName, codes.PermissionDenied},
+		{"admin", ffstream_grpc.FFStream_GetBitRates_FullMethodName, codes.OK},
+		{"admin", ffstream_grpc.FFStream_End_FullMethodName, codes.OK},
+	} {
+		if got := status.Code(auth.Authorize(tc.token, tc.method)); got != tc.want {
+			t.Errorf("token %q, method %s: expected %v, got %v", tc.token, tc.method, tc.want, got)
+		}
+	}
+
+	var disabled *Auth
+	if err := disabled.Authorize("", ffstream_grpc.FFStream_End_FullMethodName); err != nil {
+		t.Errorf("expected no restrictions without tokens, got %v", err)
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	for in, want := range map[string]string{
+		"Bearer abc":  "abc",
+		"bearer  abc": "abc",
+		"Basic abc":   "",
+		"abc":         "",
+		"":            "",
+	} {
+		if got := BearerToken(in); got != want {
+			t.Errorf("BearerToken(%q): expected %q, got %q", in, want, got)
+		}
+	}
+}
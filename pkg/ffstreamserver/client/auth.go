@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// tokenCredentials sends the token of Client.Token with each request.
+type tokenCredentials struct {
+	Token string
+}
+
+var _ credentials.PerRPCCredentials = tokenCredentials{}
+
+func (c tokenCredentials) GetRequestMetadata(
+	context.Context,
+	...string,
+) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.Token}, nil
+}
+
+// RequireTransportSecurity returns false, since the control socket
+// is often a UNIX socket or a TCP socket on localhost.
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -2,7 +2,6 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,11 +9,13 @@ import (
 	"strings"
 
 	"github.com/facebookincubator/go-belt/tool/logger"
+	loggertypes "github.com/facebookincubator/go-belt/tool/logger/types"
 	quality "github.com/xaionaro-go/avpipeline/packetorframe/filter/quality/types"
 	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
 	avpipeline_proto "github.com/xaionaro-go/avpipeline/protobuf/avpipeline"
 	goconvavp "github.com/xaionaro-go/avpipeline/protobuf/goconv/avpipelinenolibav"
 	avptypes "github.com/xaionaro-go/avpipeline/types"
+	"github.com/xaionaro-go/ffstream/pkg/cert"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
 	"github.com/xaionaro-go/observability"
@@ -28,40 +29,59 @@ type InputID uint64
 type OutputID uint64
 
 type Client struct {
+	// Target is the address of the server: "unix:<path>",
+	// "tcp:<host>:<port>", "tls:<host>:<port>" (or "tcp+ssl:")
+	// or any other gRPC target.
 	Target string
+
+	// Token (if set) is sent as the bearer token with each request.
+	Token string
+
+	// TLS is the configuration of the "tls:" targets.
+	TLS cert.ClientConfig
+
+	// StreamID (if set) selects the stream of a server hosting many of
+	// them (see ffstreamd); the RPCs of FFStream are addressed to it.
+	StreamID string
 }
 
 func New(target string) *Client {
 	return &Client{Target: target}
 }
 
-func (c *Client) getGPRCDialParams() (target string, opts []grpc.DialOption) {
+func (c *Client) getGPRCDialParams() (target string, opts []grpc.DialOption, _ error) {
 	target = c.Target
-	opts = []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	transportCreds := insecure.NewCredentials()
+
+	scheme, hostPort, ok := strings.Cut(c.Target, ":")
+	switch {
+	case !ok:
+	case scheme == "tcp" || scheme == "tcp4" || scheme == "tcp6":
+		target = hostPort
+	case scheme == "tls" || scheme == "tcp+ssl":
+		tlsConfig, err := c.TLS.TLSConfig()
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to get the TLS config: %w", err)
+		}
+		transportCreds = credentials.NewTLS(tlsConfig)
+		target = hostPort
 	}
 
-	parts := strings.SplitN(c.Target, ":", 2)
-	if len(parts) < 2 {
-		return
+	opts = append(opts, grpc.WithTransportCredentials(transportCreds))
+	if c.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{Token: c.Token}))
 	}
-
-	switch parts[0] {
-	case "tcp+ssl":
-		opts = []grpc.DialOption{
-			grpc.WithTransportCredentials(
-				credentials.NewTLS(&tls.Config{
-					InsecureSkipVerify: true,
-				}),
-			),
-		}
-		target = parts[1]
+	if c.StreamID != "" {
+		opts = append(opts, streamIDDialOptions(c.StreamID)...)
 	}
-	return
+	return target, opts, nil
 }
 
 func (c *Client) grpcClient() (ffstream_grpc.FFStreamClient, *grpc.ClientConn, error) {
-	target, opts := c.getGPRCDialParams()
+	target, opts, err := c.getGPRCDialParams()
+	if err != nil {
+		return nil, nil, err
+	}
 	conn, err := grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to initialize a gRPC client: %w", err)
@@ -73,6 +93,8 @@ func (c *Client) grpcClient() (ffstream_grpc.FFStreamClient, *grpc.ClientConn, e
 
 func logLevelGo2Protobuf(logLevel logger.Level) ffstream_grpc.LoggingLevel {
 	switch logLevel {
+	case loggertypes.LevelNone:
+		return ffstream_grpc.LoggingLevel_LOGGING_LEVEL_NONE
 	case logger.LevelFatal:
 		return ffstream_grpc.LoggingLevel_LOGGING_LEVEL_FATAL
 	case logger.LevelPanic:
@@ -114,6 +136,7 @@ func (c *Client) SetLoggingLevel(
 func (c *Client) RemoveOutput(
 	ctx context.Context,
 	outputID OutputID,
+	dropOnClose bool,
 ) error {
 	client, conn, err := c.grpcClient()
 	if err != nil {
@@ -122,7 +145,8 @@ func (c *Client) RemoveOutput(
 	defer conn.Close()
 
 	_, err = client.RemoveOutput(ctx, &ffstream_grpc.RemoveOutputRequest{
-		Id: uint64(outputID),
+		Id:          uint64(outputID),
+		DropOnClose: dropOnClose,
 	})
 	if err != nil {
 		return fmt.Errorf("query error: %w", err)
@@ -131,6 +155,43 @@ func (c *Client) RemoveOutput(
 	return nil
 }
 
+func (c *Client) ListOutputs(
+	ctx context.Context,
+) ([]*ffstream_grpc.OutputInfo, error) {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	resp, err := client.ListOutputs(ctx, &ffstream_grpc.ListOutputsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	return resp.GetOutputs(), nil
+}
+
+func (c *Client) CreateOutput(
+	ctx context.Context,
+	senderKey *ffstream_grpc.SenderKey,
+) (OutputID, error) {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	resp, err := client.CreateOutput(ctx, &ffstream_grpc.CreateOutputRequest{
+		SenderKey: senderKey,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("query error: %w", err)
+	}
+
+	return OutputID(resp.GetId()), nil
+}
+
 func (c *Client) End(
 	ctx context.Context,
 ) error {
@@ -550,6 +611,78 @@ func (c *Client) SetStopInput(
 	return nil
 }
 
+// AddInput adds an input at the given fallback priority and returns
+// its number within the priority.
+func (c *Client) AddInput(
+	ctx context.Context,
+	url string,
+	inputPriority uint64,
+	customOptions []streammuxtypes.DictionaryItem,
+) (uint64, error) {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	reply, err := client.AddInput(ctx, &ffstream_grpc.AddInputRequest{
+		Url:           url,
+		InputPriority: inputPriority,
+		CustomOptions: goconv.CustomOptionsToGRPC(customOptions),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("query error: %w", err)
+	}
+
+	return reply.GetInputNum(), nil
+}
+
+func (c *Client) RemoveInput(
+	ctx context.Context,
+	inputPriority uint64,
+	inputNum uint64,
+) error {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.RemoveInput(ctx, &ffstream_grpc.RemoveInputRequest{
+		InputPriority: inputPriority,
+		InputNum:      inputNum,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ReplaceInputURL(
+	ctx context.Context,
+	inputPriority uint64,
+	inputNum uint64,
+	url string,
+) error {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.ReplaceInputURL(ctx, &ffstream_grpc.ReplaceInputURLRequest{
+		InputPriority: inputPriority,
+		InputNum:      inputNum,
+		Url:           url,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Client) SwitchOutputByProps(
 	ctx context.Context,
 	videoCodecName string,
@@ -589,3 +722,138 @@ func (c *Client) SwitchOutputByProps(
 
 	return nil
 }
+
+func (c *Client) SetFilterGraph(
+	ctx context.Context,
+	kind ffstream_grpc.FilterGraphKind,
+	description string,
+) error {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.SetFilterGraph(ctx, &ffstream_grpc.SetFilterGraphRequest{
+		Kind:        kind,
+		Description: description,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+
+	return nil
+}
+
+// WatchOutputSwitches returns the switches between the URLs of the output
+// fallback chains, until the context is cancelled.
+func (c *Client) WatchOutputSwitches(
+	ctx context.Context,
+) (<-chan *ffstream_grpc.OutputSwitchEvent, error) {
+	return xgrpc.UnwrapChan(ctx,
+		c,
+		func(
+			ctx context.Context,
+			client ffstream_grpc.FFStreamClient,
+		) (ffstream_grpc.FFStream_WatchOutputSwitchesClient, error) {
+			return client.WatchOutputSwitches(ctx, &ffstream_grpc.WatchOutputSwitchesRequest{})
+		},
+		func(
+			ctx context.Context,
+			ev *ffstream_grpc.OutputSwitchEvent,
+		) *ffstream_grpc.OutputSwitchEvent {
+			return ev
+		},
+	)
+}
+
+// SubscribeEvents returns the events of the stream (see ffstream.Event),
+// until the stream is finished or the context is cancelled.
+func (c *Client) SubscribeEvents(
+	ctx context.Context,
+) (<-chan *ffstream_grpc.Event, error) {
+	return xgrpc.UnwrapChan(ctx,
+		c,
+		func(
+			ctx context.Context,
+			client ffstream_grpc.FFStreamClient,
+		) (ffstream_grpc.FFStream_SubscribeEventsClient, error) {
+			return client.SubscribeEvents(ctx, &ffstream_grpc.SubscribeEventsRequest{})
+		},
+		func(
+			ctx context.Context,
+			ev *ffstream_grpc.Event,
+		) *ffstream_grpc.Event {
+			return ev
+		},
+	)
+}
+
+func (c *Client) GetRetryPolicy(
+	ctx context.Context,
+	target ffstream_grpc.RetryTarget,
+) (*ffstream_grpc.GetRetryPolicyReply, error) {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	resp, err := client.GetRetryPolicy(ctx, &ffstream_grpc.GetRetryPolicyRequest{
+		Target: target,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetRetryPolicy sets the retry policy of the inputs or outputs;
+// nil policy means the legacy behavior.
+func (c *Client) SetRetryPolicy(
+	ctx context.Context,
+	target ffstream_grpc.RetryTarget,
+	policy *ffstream_grpc.RetryPolicy,
+) error {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.SetRetryPolicy(ctx, &ffstream_grpc.SetRetryPolicyRequest{
+		Target: target,
+		Policy: policy,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyConfig applies the content of a configuration file to the running
+// stream; empty config means: reload the configuration file ffstream
+// was started with.
+func (c *Client) ApplyConfig(
+	ctx context.Context,
+	config []byte,
+	dryRun bool,
+) ([]*ffstream_grpc.ConfigChange, error) {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	reply, err := client.ApplyConfig(ctx, &ffstream_grpc.ApplyConfigRequest{
+		Config: config,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	return reply.GetChanges(), nil
+}
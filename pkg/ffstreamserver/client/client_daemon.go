@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc"
+)
+
+func (c *Client) grpcDaemonClient() (ffstream_grpc.FFStreamDaemonClient, *grpc.ClientConn, error) {
+	target, opts, err := c.getGPRCDialParams()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to initialize a gRPC client: %w", err)
+	}
+
+	client := ffstream_grpc.NewFFStreamDaemonClient(conn)
+	return client, conn, nil
+}
+
+// CreateStream creates a stream on ffstreamd from the arguments in the same
+// form as of the ffstream command (without the program name) and the content
+// of the configuration file (optional); if start is true, it is also started.
+func (c *Client) CreateStream(
+	ctx context.Context,
+	id string,
+	args []string,
+	config []byte,
+	start bool,
+) (*ffstream_grpc.StreamInfo, error) {
+	client, conn, err := c.grpcDaemonClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	reply, err := client.CreateStream(ctx, &ffstream_grpc.CreateStreamRequest{
+		Id:     id,
+		Args:   args,
+		Config: config,
+		Start:  start,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	return reply.GetStream(), nil
+}
+
+func (c *Client) StartStream(
+	ctx context.Context,
+	id string,
+) error {
+	client, conn, err := c.grpcDaemonClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.StartStream(ctx, &ffstream_grpc.StartStreamRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) StopStream(
+	ctx context.Context,
+	id string,
+) error {
+	client, conn, err := c.grpcDaemonClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.StopStream(ctx, &ffstream_grpc.StopStreamRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteStream(
+	ctx context.Context,
+	id string,
+) error {
+	client, conn, err := c.grpcDaemonClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.DeleteStream(ctx, &ffstream_grpc.DeleteStreamRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) ListStreams(
+	ctx context.Context,
+) ([]*ffstream_grpc.StreamInfo, error) {
+	client, conn, err := c.grpcDaemonClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	reply, err := client.ListStreams(ctx, &ffstream_grpc.ListStreamsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	return reply.GetStreams(), nil
+}
+
+// SetDaemonLoggingLevel sets the logging level of ffstreamd; the level is
+// process-wide, so it affects all the streams (unlike SetLoggingLevel, it is
+// not addressed to a stream).
+func (c *Client) SetDaemonLoggingLevel(
+	ctx context.Context,
+	logLevel logger.Level,
+) error {
+	client, conn, err := c.grpcDaemonClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.SetDaemonLoggingLevel(ctx, &ffstream_grpc.SetLoggingLevelRequest{
+		Level: logLevelGo2Protobuf(logLevel),
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
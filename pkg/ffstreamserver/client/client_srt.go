@@ -6,14 +6,25 @@ package client
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
 	"github.com/xaionaro-go/libsrt"
+	"github.com/xaionaro-go/xgrpc"
 )
 
+// ActiveOutputID may be used as the outputID in the SRT methods
+// to address the output the stream is currently sent to.
+//
+// The destination in the SRT methods is the index of the output template
+// of the output (see OutputInfo.Destinations), it is 0 for a single template.
+const ActiveOutputID = -1
+
 func (c *Client) GetOutputSRTStats(
 	ctx context.Context,
+	outputID int32,
+	destination uint32,
 ) (*libsrt.Tracebstats, error) {
 	client, conn, err := c.grpcClient()
 	if err != nil {
@@ -21,7 +32,10 @@ func (c *Client) GetOutputSRTStats(
 	}
 	defer conn.Close()
 
-	resp, err := client.GetOutputSRTStats(ctx, &ffstream_grpc.GetOutputSRTStatsRequest{})
+	resp, err := client.GetOutputSRTStats(ctx, &ffstream_grpc.GetOutputSRTStatsRequest{
+		OutputId:    outputID,
+		Destination: destination,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -29,8 +43,39 @@ func (c *Client) GetOutputSRTStats(
 	return goconv.OutputSRTStatsFromGRPC(resp), nil
 }
 
+// WatchOutputSRTStats returns the SRT statistics of the output every interval,
+// until the context is cancelled.
+func (c *Client) WatchOutputSRTStats(
+	ctx context.Context,
+	outputID int32,
+	destination uint32,
+	interval time.Duration,
+) (<-chan *libsrt.Tracebstats, error) {
+	return xgrpc.UnwrapChan(ctx,
+		c,
+		func(
+			ctx context.Context,
+			client ffstream_grpc.FFStreamClient,
+		) (ffstream_grpc.FFStream_WatchOutputSRTStatsClient, error) {
+			return client.WatchOutputSRTStats(ctx, &ffstream_grpc.WatchOutputSRTStatsRequest{
+				OutputId:    outputID,
+				Destination: destination,
+				Interval:    uint64(goconv.DurationToGRPC(interval)),
+			})
+		},
+		func(
+			ctx context.Context,
+			ev *ffstream_grpc.GetOutputSRTStatsReply,
+		) *libsrt.Tracebstats {
+			return goconv.OutputSRTStatsFromGRPC(ev)
+		},
+	)
+}
+
 func (c *Client) GetSRTFlagInt(
 	ctx context.Context,
+	outputID int32,
+	destination uint32,
 	flag libsrt.Sockopt,
 ) (int64, error) {
 	client, conn, err := c.grpcClient()
@@ -45,7 +90,9 @@ func (c *Client) GetSRTFlagInt(
 	}
 
 	resp, err := client.GetSRTFlagInt(ctx, &ffstream_grpc.GetSRTFlagIntRequest{
-		Flag: flagID,
+		OutputId:    outputID,
+		Destination: destination,
+		Flag:        flagID,
 	})
 	if err != nil {
 		return 0, fmt.Errorf("query error: %w", err)
@@ -56,6 +103,8 @@ func (c *Client) GetSRTFlagInt(
 
 func (c *Client) SetSRTFlagInt(
 	ctx context.Context,
+	outputID int32,
+	destination uint32,
 	flag libsrt.Sockopt,
 	value int64,
 ) error {
@@ -71,6 +120,100 @@ func (c *Client) SetSRTFlagInt(
 	}
 
 	_, err = client.SetSRTFlagInt(ctx, &ffstream_grpc.SetSRTFlagIntRequest{
+		OutputId:    outputID,
+		Destination: destination,
+		Flag:        flagID,
+		Value:       value,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+
+	return nil
+}
+
+// InputAddress addresses an input either by its ID (see GetInputsInfo),
+// or, if the ID is zero, by its fallback priority and number.
+type InputAddress struct {
+	ID       InputID
+	Priority uint64
+	Num      uint64
+}
+
+func (a InputAddress) toGRPC() *ffstream_grpc.InputAddress {
+	return &ffstream_grpc.InputAddress{
+		Id:            uint64(a.ID),
+		InputPriority: a.Priority,
+		InputNum:      a.Num,
+	}
+}
+
+func (c *Client) GetInputSRTStats(
+	ctx context.Context,
+	input InputAddress,
+) (*libsrt.Tracebstats, error) {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	resp, err := client.GetInputSRTStats(ctx, &ffstream_grpc.GetInputSRTStatsRequest{
+		Input: input.toGRPC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	return goconv.OutputSRTStatsFromGRPC(resp), nil
+}
+
+func (c *Client) GetInputSRTFlagInt(
+	ctx context.Context,
+	input InputAddress,
+	flag libsrt.Sockopt,
+) (int64, error) {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	flagID := goconv.SRTSockoptIntToGRPC(flag)
+	if flagID == ffstream_grpc.SRTFlagInt_SRT_FLAG_INT_UNDEFINED {
+		return 0, fmt.Errorf("unknown flag: %v", flag)
+	}
+
+	resp, err := client.GetInputSRTFlagInt(ctx, &ffstream_grpc.GetInputSRTFlagIntRequest{
+		Input: input.toGRPC(),
+		Flag:  flagID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("query error: %w", err)
+	}
+
+	return resp.GetValue(), nil
+}
+
+func (c *Client) SetInputSRTFlagInt(
+	ctx context.Context,
+	input InputAddress,
+	flag libsrt.Sockopt,
+	value int64,
+) error {
+	client, conn, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	flagID := goconv.SRTSockoptIntToGRPC(flag)
+	if flagID == ffstream_grpc.SRTFlagInt_SRT_FLAG_INT_UNDEFINED {
+		return fmt.Errorf("unknown flag: %v", flag)
+	}
+
+	_, err = client.SetInputSRTFlagInt(ctx, &ffstream_grpc.SetInputSRTFlagIntRequest{
+		Input: input.toGRPC(),
 		Flag:  flagID,
 		Value: value,
 	})
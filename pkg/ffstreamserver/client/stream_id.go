@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// streamIDMetadataKey is ffstreamserver.StreamIDMetadataKey (the package
+// is not imported to keep the client free of the libav dependencies).
+const streamIDMetadataKey = "ffstream-stream-id"
+
+// streamIDDialOptions address each request to the stream of Client.StreamID.
+func streamIDDialOptions(streamID string) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(
+			ctx context.Context,
+			method string,
+			req, reply any,
+			cc *grpc.ClientConn,
+			invoker grpc.UnaryInvoker,
+			opts ...grpc.CallOption,
+		) error {
+			ctx = metadata.AppendToOutgoingContext(ctx, streamIDMetadataKey, streamID)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(
+			ctx context.Context,
+			desc *grpc.StreamDesc,
+			cc *grpc.ClientConn,
+			method string,
+			streamer grpc.Streamer,
+			opts ...grpc.CallOption,
+		) (grpc.ClientStream, error) {
+			ctx = metadata.AppendToOutgoingContext(ctx, streamIDMetadataKey, streamID)
+			return streamer(ctx, desc, cc, method, opts...)
+		}),
+	}
+}
@@ -0,0 +1,21 @@
+package ffstreamserver
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed dashboard
+var dashboardFS embed.FS
+
+// DashboardHandler returns the handler of the web dashboard, a single page
+// using the HTTP/JSON gateway (see HTTPGateway) at the relative path
+// HTTPGatewayPathPrefix (without the leading slash).
+func DashboardHandler() http.Handler {
+	root, err := fs.Sub(dashboardFS, "dashboard")
+	if err != nil {
+		panic(err)
+	}
+	return http.FileServerFS(root)
+}
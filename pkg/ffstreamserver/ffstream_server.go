@@ -16,6 +16,12 @@ import (
 
 type FFStreamServer struct {
 	ffStream *ffstream.FFStream
+
+	// ConfigApplier (if set) implements the ApplyConfig RPC.
+	ConfigApplier ConfigApplier
+
+	// Auth (if set) restricts the access to the control API.
+	Auth *Auth
 }
 
 func New(ffStream *ffstream.FFStream) *FFStreamServer {
@@ -27,6 +33,21 @@ func New(ffStream *ffstream.FFStream) *FFStreamServer {
 func (s *FFStreamServer) ServeContext(
 	ctx context.Context,
 	listener net.Listener,
+) error {
+	ffstreamGRPC := NewGRPCServer(ctx, s.ffStream)
+	ffstreamGRPC.ConfigApplier = s.ConfigApplier
+	return ServeGRPC(ctx, listener, s.Auth, func(r grpc.ServiceRegistrar) {
+		ffstream_grpc.RegisterFFStreamServer(r, ffstreamGRPC)
+	})
+}
+
+// ServeGRPC serves the services registered by register on the listener
+// until ctx is cancelled; the requests are authorized by auth (if set).
+func ServeGRPC(
+	ctx context.Context,
+	listener net.Listener,
+	auth *Auth,
+	register func(grpc.ServiceRegistrar),
 ) error {
 	opts := []grpc_recovery.Option{
 		grpc_recovery.WithRecoveryHandler(func(p any) (err error) {
@@ -38,13 +59,14 @@ func (s *FFStreamServer) ServeContext(
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			grpc_recovery.UnaryServerInterceptor(opts...),
+			auth.UnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
 			grpc_recovery.StreamServerInterceptor(opts...),
+			auth.StreamServerInterceptor(),
 		),
 	)
-	ffstreamGRPC := NewGRPCServer(ctx, s.ffStream)
-	ffstream_grpc.RegisterFFStreamServer(grpcServer, ffstreamGRPC)
+	register(grpcServer)
 
 	ctx, cancelFn := context.WithCancel(ctx)
 	defer cancelFn()
@@ -132,6 +132,260 @@ func (SRTFlagInt) EnumDescriptor() ([]byte, []int) {
 	return file_ffstream_proto_rawDescGZIP(), []int{1}
 }
 
+type FilterGraphKind int32
+
+const (
+	FilterGraphKind_FILTER_GRAPH_KIND_UNDEFINED FilterGraphKind = 0
+	FilterGraphKind_FILTER_GRAPH_KIND_VIDEO     FilterGraphKind = 1
+	FilterGraphKind_FILTER_GRAPH_KIND_AUDIO     FilterGraphKind = 2
+	FilterGraphKind_FILTER_GRAPH_KIND_COMPLEX   FilterGraphKind = 3
+)
+
+// Enum value maps for FilterGraphKind.
+var (
+	FilterGraphKind_name = map[int32]string{
+		0: "FILTER_GRAPH_KIND_UNDEFINED",
+		1: "FILTER_GRAPH_KIND_VIDEO",
+		2: "FILTER_GRAPH_KIND_AUDIO",
+		3: "FILTER_GRAPH_KIND_COMPLEX",
+	}
+	FilterGraphKind_value = map[string]int32{
+		"FILTER_GRAPH_KIND_UNDEFINED": 0,
+		"FILTER_GRAPH_KIND_VIDEO":     1,
+		"FILTER_GRAPH_KIND_AUDIO":     2,
+		"FILTER_GRAPH_KIND_COMPLEX":   3,
+	}
+)
+
+func (x FilterGraphKind) Enum() *FilterGraphKind {
+	p := new(FilterGraphKind)
+	*p = x
+	return p
+}
+
+func (x FilterGraphKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterGraphKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ffstream_proto_enumTypes[2].Descriptor()
+}
+
+func (FilterGraphKind) Type() protoreflect.EnumType {
+	return &file_ffstream_proto_enumTypes[2]
+}
+
+func (x FilterGraphKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterGraphKind.Descriptor instead.
+func (FilterGraphKind) EnumDescriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{2}
+}
+
+type OutputState int32
+
+const (
+	OutputState_OUTPUT_STATE_UNDEFINED OutputState = 0
+	OutputState_OUTPUT_STATE_ACTIVE    OutputState = 1
+	OutputState_OUTPUT_STATE_STANDBY   OutputState = 2
+)
+
+// Enum value maps for OutputState.
+var (
+	OutputState_name = map[int32]string{
+		0: "OUTPUT_STATE_UNDEFINED",
+		1: "OUTPUT_STATE_ACTIVE",
+		2: "OUTPUT_STATE_STANDBY",
+	}
+	OutputState_value = map[string]int32{
+		"OUTPUT_STATE_UNDEFINED": 0,
+		"OUTPUT_STATE_ACTIVE":    1,
+		"OUTPUT_STATE_STANDBY":   2,
+	}
+)
+
+func (x OutputState) Enum() *OutputState {
+	p := new(OutputState)
+	*p = x
+	return p
+}
+
+func (x OutputState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutputState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ffstream_proto_enumTypes[3].Descriptor()
+}
+
+func (OutputState) Type() protoreflect.EnumType {
+	return &file_ffstream_proto_enumTypes[3]
+}
+
+func (x OutputState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutputState.Descriptor instead.
+func (OutputState) EnumDescriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{3}
+}
+
+type RetryTarget int32
+
+const (
+	RetryTarget_RETRY_TARGET_UNDEFINED RetryTarget = 0
+	RetryTarget_RETRY_TARGET_INPUT     RetryTarget = 1
+	RetryTarget_RETRY_TARGET_OUTPUT    RetryTarget = 2
+)
+
+// Enum value maps for RetryTarget.
+var (
+	RetryTarget_name = map[int32]string{
+		0: "RETRY_TARGET_UNDEFINED",
+		1: "RETRY_TARGET_INPUT",
+		2: "RETRY_TARGET_OUTPUT",
+	}
+	RetryTarget_value = map[string]int32{
+		"RETRY_TARGET_UNDEFINED": 0,
+		"RETRY_TARGET_INPUT":     1,
+		"RETRY_TARGET_OUTPUT":    2,
+	}
+)
+
+func (x RetryTarget) Enum() *RetryTarget {
+	p := new(RetryTarget)
+	*p = x
+	return p
+}
+
+func (x RetryTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetryTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_ffstream_proto_enumTypes[4].Descriptor()
+}
+
+func (RetryTarget) Type() protoreflect.EnumType {
+	return &file_ffstream_proto_enumTypes[4]
+}
+
+func (x RetryTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetryTarget.Descriptor instead.
+func (RetryTarget) EnumDescriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{4}
+}
+
+type RetryExhaustedAction int32
+
+const (
+	RetryExhaustedAction_RETRY_EXHAUSTED_ACTION_UNDEFINED RetryExhaustedAction = 0
+	RetryExhaustedAction_RETRY_EXHAUSTED_ACTION_STOP      RetryExhaustedAction = 1
+	RetryExhaustedAction_RETRY_EXHAUSTED_ACTION_FALLBACK  RetryExhaustedAction = 2
+	RetryExhaustedAction_RETRY_EXHAUSTED_ACTION_CONTINUE  RetryExhaustedAction = 3
+)
+
+// Enum value maps for RetryExhaustedAction.
+var (
+	RetryExhaustedAction_name = map[int32]string{
+		0: "RETRY_EXHAUSTED_ACTION_UNDEFINED",
+		1: "RETRY_EXHAUSTED_ACTION_STOP",
+		2: "RETRY_EXHAUSTED_ACTION_FALLBACK",
+		3: "RETRY_EXHAUSTED_ACTION_CONTINUE",
+	}
+	RetryExhaustedAction_value = map[string]int32{
+		"RETRY_EXHAUSTED_ACTION_UNDEFINED": 0,
+		"RETRY_EXHAUSTED_ACTION_STOP":      1,
+		"RETRY_EXHAUSTED_ACTION_FALLBACK":  2,
+		"RETRY_EXHAUSTED_ACTION_CONTINUE":  3,
+	}
+)
+
+func (x RetryExhaustedAction) Enum() *RetryExhaustedAction {
+	p := new(RetryExhaustedAction)
+	*p = x
+	return p
+}
+
+func (x RetryExhaustedAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetryExhaustedAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ffstream_proto_enumTypes[5].Descriptor()
+}
+
+func (RetryExhaustedAction) Type() protoreflect.EnumType {
+	return &file_ffstream_proto_enumTypes[5]
+}
+
+func (x RetryExhaustedAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetryExhaustedAction.Descriptor instead.
+func (RetryExhaustedAction) EnumDescriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{5}
+}
+
+type StreamState int32
+
+const (
+	StreamState_STREAM_STATE_UNDEFINED StreamState = 0
+	StreamState_STREAM_STATE_CREATED   StreamState = 1
+	StreamState_STREAM_STATE_RUNNING   StreamState = 2
+	StreamState_STREAM_STATE_STOPPED   StreamState = 3
+)
+
+// Enum value maps for StreamState.
+var (
+	StreamState_name = map[int32]string{
+		0: "STREAM_STATE_UNDEFINED",
+		1: "STREAM_STATE_CREATED",
+		2: "STREAM_STATE_RUNNING",
+		3: "STREAM_STATE_STOPPED",
+	}
+	StreamState_value = map[string]int32{
+		"STREAM_STATE_UNDEFINED": 0,
+		"STREAM_STATE_CREATED":   1,
+		"STREAM_STATE_RUNNING":   2,
+		"STREAM_STATE_STOPPED":   3,
+	}
+)
+
+func (x StreamState) Enum() *StreamState {
+	p := new(StreamState)
+	*p = x
+	return p
+}
+
+func (x StreamState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StreamState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ffstream_proto_enumTypes[6].Descriptor()
+}
+
+func (StreamState) Type() protoreflect.EnumType {
+	return &file_ffstream_proto_enumTypes[6]
+}
+
+func (x StreamState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamState.Descriptor instead.
+func (StreamState) EnumDescriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{6}
+}
+
 type SetLoggingLevelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Level         LoggingLevel           `protobuf:"varint,1,opt,name=level,proto3,enum=ffstream_grpc.LoggingLevel" json:"level,omitempty"`
@@ -215,6 +469,7 @@ func (*SetLoggingLevelReply) Descriptor() ([]byte, []int) {
 type RemoveOutputRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DropOnClose   bool                   `protobuf:"varint,2,opt,name=drop_on_close,json=dropOnClose,proto3" json:"drop_on_close,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -256,6 +511,13 @@ func (x *RemoveOutputRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *RemoveOutputRequest) GetDropOnClose() bool {
+	if x != nil {
+		return x.DropOnClose
+	}
+	return false
+}
+
 type RemoveOutputReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -557,12 +819,16 @@ func (*GetCurrentOutputRequest) Descriptor() ([]byte, []int) {
 }
 
 type GetCurrentOutputReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Config        *TranscoderConfig      `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	MaxBitRate    uint64                 `protobuf:"varint,3,opt,name=max_bit_rate,json=maxBitRate,proto3" json:"max_bit_rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the ID of the active output; it is meaningful only if
+	// has_active_output is set (there is no active output until the first
+	// output is opened), while the config is always returned
+	Id              uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Config          *TranscoderConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	MaxBitRate      uint64            `protobuf:"varint,3,opt,name=max_bit_rate,json=maxBitRate,proto3" json:"max_bit_rate,omitempty"`
+	HasActiveOutput bool              `protobuf:"varint,4,opt,name=has_active_output,json=hasActiveOutput,proto3" json:"has_active_output,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetCurrentOutputReply) Reset() {
@@ -616,6 +882,13 @@ func (x *GetCurrentOutputReply) GetMaxBitRate() uint64 {
 	return 0
 }
 
+func (x *GetCurrentOutputReply) GetHasActiveOutput() bool {
+	if x != nil {
+		return x.HasActiveOutput
+	}
+	return false
+}
+
 type SwitchOutputByPropsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Config        *TranscoderConfig      `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
@@ -784,9 +1057,13 @@ func (x *GetStatsReply) GetNodeCounters() *avpipeline.NodeCounters {
 	return nil
 }
 
+// a negative output_id means the active output (the same for the other SRT requests)
 type GetOutputSRTStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OutputId      int32                  `protobuf:"varint,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OutputId int32                  `protobuf:"varint,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
+	// destination is the index of the output template
+	// (see OutputInfo.destinations)
+	Destination   uint32 `protobuf:"varint,2,opt,name=destination,proto3" json:"destination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -828,6 +1105,13 @@ func (x *GetOutputSRTStatsRequest) GetOutputId() int32 {
 	return 0
 }
 
+func (x *GetOutputSRTStatsRequest) GetDestination() uint32 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
 type GetOutputSRTStatsReply struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	MsTimeStamp             int64                  `protobuf:"varint,1,opt,name=ms_time_stamp,json=msTimeStamp,proto3" json:"ms_time_stamp,omitempty"`
@@ -1521,9 +1805,12 @@ func (x *GetOutputSRTStatsReply) GetByteRecvUnique() uint64 {
 }
 
 type GetSRTFlagIntRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OutputId      int32                  `protobuf:"varint,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
-	Flag          SRTFlagInt             `protobuf:"varint,2,opt,name=flag,proto3,enum=ffstream_grpc.SRTFlagInt" json:"flag,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OutputId int32                  `protobuf:"varint,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
+	Flag     SRTFlagInt             `protobuf:"varint,2,opt,name=flag,proto3,enum=ffstream_grpc.SRTFlagInt" json:"flag,omitempty"`
+	// destination is the index of the output template
+	// (see OutputInfo.destinations)
+	Destination   uint32 `protobuf:"varint,3,opt,name=destination,proto3" json:"destination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1572,6 +1859,13 @@ func (x *GetSRTFlagIntRequest) GetFlag() SRTFlagInt {
 	return SRTFlagInt_SRT_FLAG_INT_UNDEFINED
 }
 
+func (x *GetSRTFlagIntRequest) GetDestination() uint32 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
 type GetSRTFlagIntReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -1617,10 +1911,13 @@ func (x *GetSRTFlagIntReply) GetValue() int64 {
 }
 
 type SetSRTFlagIntRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OutputId      int32                  `protobuf:"varint,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
-	Flag          SRTFlagInt             `protobuf:"varint,2,opt,name=flag,proto3,enum=ffstream_grpc.SRTFlagInt" json:"flag,omitempty"`
-	Value         int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OutputId int32                  `protobuf:"varint,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
+	Flag     SRTFlagInt             `protobuf:"varint,2,opt,name=flag,proto3,enum=ffstream_grpc.SRTFlagInt" json:"flag,omitempty"`
+	Value    int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// destination is the index of the output template
+	// (see OutputInfo.destinations)
+	Destination   uint32 `protobuf:"varint,4,opt,name=destination,proto3" json:"destination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1676,6 +1973,13 @@ func (x *SetSRTFlagIntRequest) GetValue() int64 {
 	return 0
 }
 
+func (x *SetSRTFlagIntRequest) GetDestination() uint32 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
 type SetSRTFlagIntReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -3157,7 +3461,9 @@ func (x *GetInputsInfoReply) GetInputs() []*InputInfo {
 }
 
 type InputInfo struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the object ID of the input kernel; it is 0 if the input
+	// is not opened (yet), e.g. right after it is added.
 	Id            uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Priority      uint64                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
 	Num           uint64                  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
@@ -3432,670 +3738,4574 @@ func (*SetStopInputReply) Descriptor() ([]byte, []int) {
 	return file_ffstream_proto_rawDescGZIP(), []int{56}
 }
 
-var File_ffstream_proto protoreflect.FileDescriptor
+type SetFilterGraphRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          FilterGraphKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=ffstream_grpc.FilterGraphKind" json:"kind,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_ffstream_proto_rawDesc = string([]byte{
-	0x0a, 0x0e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0d, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x1a,
-	0x2c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x76, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a,
-	0x16, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65,
-	0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe8,
-	0x01, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76,
-	0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a,
-	0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
-	0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0xd9, 0x02, 0x0a, 0x10, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
-	0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69,
-	0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x42, 0x69, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
-	0x65, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x61, 0x75,
-	0x64, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43,
-	0x6f, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69,
-	0x6f, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69,
-	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61,
-	0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x77, 0x0a, 0x1a, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x4e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
-	0x22, 0x37, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x64, 0x22, 0xfc, 0x1a, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x73, 0x54,
-	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6b, 0x74, 0x5f,
-	0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24,
-	0x0a, 0x0e, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f,
-	0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x6c, 0x6f, 0x73,
-	0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70,
-	0x6b, 0x74, 0x52, 0x63, 0x76, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a,
-	0x0a, 0x11, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x52, 0x65,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b,
-	0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41,
-	0x63, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x72,
-	0x65, 0x63, 0x76, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x41, 0x63, 0x6b, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6b, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x6e, 0x61,
-	0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70,
-	0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x4e, 0x61, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31,
-	0x0a, 0x15, 0x75, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x75,
-	0x73, 0x53, 0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x72, 0x6f,
-	0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70,
-	0x6b, 0x74, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b,
-	0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x52,
-	0x63, 0x76, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x70,
-	0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x70, 0x6b,
-	0x74, 0x52, 0x63, 0x76, 0x55, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74,
-	0x65, 0x53, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x79,
-	0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x63, 0x76, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x13, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x6c,
-	0x6f, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x62,
-	0x79, 0x74, 0x65, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x2d, 0x0a, 0x13, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x72, 0x6f, 0x70,
-	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x62, 0x79,
-	0x74, 0x65, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d,
-	0x0a, 0x13, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x62, 0x79, 0x74,
-	0x65, 0x52, 0x63, 0x76, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a,
-	0x18, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x15, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x55, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65,
-	0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x12, 0x20, 0x0a, 0x0c,
-	0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x20,
-	0x0a, 0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x19,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x4c, 0x6f, 0x73, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x72, 0x65, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b, 0x74, 0x52,
-	0x63, 0x76, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74,
-	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x70,
-	0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x1d, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x41, 0x63, 0x6b, 0x12, 0x20, 0x0a,
-	0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6b, 0x18, 0x1e, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6b, 0x12,
-	0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x6e, 0x61, 0x6b, 0x18,
-	0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x4e, 0x61,
-	0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x62, 0x70, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x62, 0x70, 0x73, 0x53,
-	0x65, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x62, 0x70, 0x73, 0x5f,
-	0x72, 0x65, 0x63, 0x76, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0c, 0x6d, 0x62, 0x70, 0x73, 0x52, 0x65, 0x63, 0x76, 0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
-	0x0f, 0x75, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x73, 0x53, 0x6e, 0x64, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x23, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x18, 0x70, 0x6b, 0x74, 0x5f, 0x72,
-	0x63, 0x76, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x62, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x70, 0x6b, 0x74, 0x52, 0x63,
-	0x76, 0x41, 0x76, 0x67, 0x42, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x65, 0x6c, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76,
-	0x42, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x73,
-	0x6e, 0x64, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x26, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70,
-	0x6b, 0x74, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74,
-	0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x27, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x70,
-	0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x55, 0x6e,
-	0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x5f,
-	0x73, 0x65, 0x6e, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65,
-	0x53, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63,
-	0x76, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x76, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x6c, 0x6f,
-	0x73, 0x73, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63,
-	0x76, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74,
-	0x65, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65,
-	0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x62, 0x79, 0x74, 0x65, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x22, 0x0a, 0x0d,
-	0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x2e, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x44, 0x72, 0x6f, 0x70,
-	0x12, 0x2c, 0x0a, 0x12, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x64,
-	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x62, 0x79,
-	0x74, 0x65, 0x52, 0x63, 0x76, 0x55, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x29,
-	0x0a, 0x11, 0x75, 0x73, 0x5f, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x30, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x75, 0x73, 0x50, 0x6b, 0x74,
-	0x53, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74,
-	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x31, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x32, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x13, 0x70, 0x6b, 0x74, 0x43, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x66, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x70, 0x6b, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a,
-	0x06, 0x6d, 0x73, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x34, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d,
-	0x73, 0x52, 0x74, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x62, 0x70, 0x73, 0x5f, 0x62, 0x61, 0x6e,
-	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x35, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x62,
-	0x70, 0x73, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x12, 0x62,
-	0x79, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x62, 0x75,
-	0x66, 0x18, 0x36, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x53, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x12, 0x2b, 0x0a, 0x12, 0x62, 0x79, 0x74, 0x65,
-	0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66, 0x18, 0x37,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x52,
-	0x63, 0x76, 0x42, 0x75, 0x66, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x62, 0x70, 0x73, 0x5f, 0x6d, 0x61,
-	0x78, 0x5f, 0x62, 0x77, 0x18, 0x38, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x62, 0x70, 0x73,
-	0x4d, 0x61, 0x78, 0x42, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6d, 0x73,
-	0x73, 0x18, 0x39, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x4d, 0x73, 0x73,
-	0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x66, 0x18,
-	0x3a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x42, 0x75, 0x66,
-	0x12, 0x20, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x66,
-	0x18, 0x3b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x53, 0x6e, 0x64, 0x42,
-	0x75, 0x66, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x66,
-	0x18, 0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x73, 0x53, 0x6e, 0x64, 0x42, 0x75, 0x66,
-	0x12, 0x2c, 0x0a, 0x13, 0x6d, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x74, 0x73, 0x62, 0x5f, 0x70,
-	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
-	0x73, 0x53, 0x6e, 0x64, 0x54, 0x73, 0x62, 0x50, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1e,
-	0x0a, 0x0b, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66, 0x18, 0x3e, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x42, 0x75, 0x66, 0x12, 0x20,
-	0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66, 0x18, 0x3f,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x42, 0x75, 0x66,
-	0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x73, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66, 0x18, 0x40,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x73, 0x52, 0x63, 0x76, 0x42, 0x75, 0x66, 0x12, 0x2c,
-	0x0a, 0x13, 0x6d, 0x73, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x74, 0x73, 0x62, 0x5f, 0x70, 0x64, 0x5f,
-	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x41, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x73, 0x52,
-	0x63, 0x76, 0x54, 0x73, 0x62, 0x50, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x1a,
-	0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x42, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x16, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x1a, 0x70, 0x6b, 0x74, 0x5f,
-	0x72, 0x63, 0x76, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x43, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x6b,
-	0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x1b, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x44, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x70, 0x6b, 0x74, 0x52, 0x63,
-	0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x38, 0x0a, 0x19, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x45, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x14,
-	0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x6b, 0x74, 0x53,
-	0x6e, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x2f, 0x0a,
-	0x14, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x47, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x6b, 0x74,
-	0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x31,
-	0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x48, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70,
-	0x6b, 0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x49, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
-	0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x73, 0x73,
-	0x12, 0x32, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6c, 0x65, 0x72,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74,
-	0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x4b, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x72,
-	0x65, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x4c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x55,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x79,
-	0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x62, 0x79, 0x74, 0x65,
-	0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x33, 0x0a, 0x16, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x4e, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x13, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x63, 0x76, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74,
-	0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x4f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70,
-	0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0f,
-	0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18,
-	0x50, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e,
-	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x51, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x62, 0x79, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x28,
-	0x0a, 0x10, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x18, 0x52, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65,
-	0x63, 0x76, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0x62, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
-	0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a,
-	0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x66, 0x66,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x52, 0x54, 0x46,
-	0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x2a, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x78, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53,
-	0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a,
-	0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x66, 0x66,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x52, 0x54, 0x46,
-	0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67,
-	0x49, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0d, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0b, 0x0a, 0x09, 0x57, 0x61, 0x69, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x0a, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
-	0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x76,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5e, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x76,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x0a, 0x24, 0x47, 0x65, 0x74,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x67, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74,
-	0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a,
-	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x24, 0x53, 0x65,
-	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6e,
-	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a,
-	0x03, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x64, 0x65, 0x6e, 0x22,
-	0x3b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x64, 0x65, 0x6e, 0x22, 0x15, 0x0a, 0x13,
-	0x53, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x4f, 0x0a, 0x0b, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f,
-	0x74, 0x68, 0x65, 0x72, 0x22, 0xd6, 0x01, 0x0a, 0x08, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x40, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x69, 0x74, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x62,
-	0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69,
-	0x74, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x14, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x62, 0x69, 0x74, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x08, 0x62, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x15, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x6c, 0x61, 0x74,
-	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
-	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x61, 0x74,
-	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x22, 0x75, 0x0a, 0x09, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33,
-	0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x05, 0x61, 0x75,
-	0x64, 0x69, 0x6f, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70,
-	0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x12, 0x31, 0x0a, 0x15,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x22, 0x18, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32,
-	0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x75, 0x64,
-	0x69, 0x6f, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x69, 0x6e, 0x75, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x6c,
-	0x61, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x64, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44,
-	0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x51,
-	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x16,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
-	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0xb4,
-	0x01, 0x0a, 0x09, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x3a, 0x0a, 0x0c,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x50,
-	0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70,
-	0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2a, 0xd3, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e,
-	0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
-	0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49,
-	0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x02,
-	0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45,
-	0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x47,
-	0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10,
-	0x04, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56,
-	0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47,
-	0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47,
-	0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x07, 0x2a, 0x42, 0x0a, 0x0a, 0x53,
-	0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x52, 0x54,
-	0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49,
-	0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x52, 0x54, 0x5f, 0x46, 0x4c, 0x41,
-	0x47, 0x5f, 0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x01, 0x32,
-	0x85, 0x13, 0x0a, 0x08, 0x46, 0x46, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x5f, 0x0a, 0x0f,
-	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x22, 0x2e,
-	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x26, 0x2e, 0x66, 0x66, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x13, 0x53, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73,
-	0x12, 0x29, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x50,
-	0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66, 0x66,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x65, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53,
-	0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x66, 0x66, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x52,
-	0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c,
-	0x61, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67,
-	0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x66, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x52,
-	0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x08, 0x57, 0x61, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x12, 0x1a, 0x2e, 0x66, 0x66,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x66,
-	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x73, 0x12, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x66, 0x66, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x19, 0x53, 0x65,
-	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1d, 0x47, 0x65,
-	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x2e, 0x66, 0x66,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x66,
-	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74,
+func (x *SetFilterGraphRequest) Reset() {
+	*x = SetFilterGraphRequest{}
+	mi := &file_ffstream_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFilterGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFilterGraphRequest) ProtoMessage() {}
+
+func (x *SetFilterGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFilterGraphRequest.ProtoReflect.Descriptor instead.
+func (*SetFilterGraphRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetFilterGraphRequest) GetKind() FilterGraphKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FilterGraphKind_FILTER_GRAPH_KIND_UNDEFINED
+}
+
+func (x *SetFilterGraphRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetFilterGraphReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFilterGraphReply) Reset() {
+	*x = SetFilterGraphReply{}
+	mi := &file_ffstream_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFilterGraphReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFilterGraphReply) ProtoMessage() {}
+
+func (x *SetFilterGraphReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFilterGraphReply.ProtoReflect.Descriptor instead.
+func (*SetFilterGraphReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{58}
+}
+
+type SenderKey struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AudioCodec      string                 `protobuf:"bytes,1,opt,name=audio_codec,json=audioCodec,proto3" json:"audio_codec,omitempty"`
+	AudioSampleRate uint32                 `protobuf:"varint,2,opt,name=audio_sample_rate,json=audioSampleRate,proto3" json:"audio_sample_rate,omitempty"`
+	VideoCodec      string                 `protobuf:"bytes,3,opt,name=video_codec,json=videoCodec,proto3" json:"video_codec,omitempty"`
+	VideoWidth      uint32                 `protobuf:"varint,4,opt,name=video_width,json=videoWidth,proto3" json:"video_width,omitempty"`
+	VideoHeight     uint32                 `protobuf:"varint,5,opt,name=video_height,json=videoHeight,proto3" json:"video_height,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SenderKey) Reset() {
+	*x = SenderKey{}
+	mi := &file_ffstream_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SenderKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SenderKey) ProtoMessage() {}
+
+func (x *SenderKey) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SenderKey.ProtoReflect.Descriptor instead.
+func (*SenderKey) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SenderKey) GetAudioCodec() string {
+	if x != nil {
+		return x.AudioCodec
+	}
+	return ""
+}
+
+func (x *SenderKey) GetAudioSampleRate() uint32 {
+	if x != nil {
+		return x.AudioSampleRate
+	}
+	return 0
+}
+
+func (x *SenderKey) GetVideoCodec() string {
+	if x != nil {
+		return x.VideoCodec
+	}
+	return ""
+}
+
+func (x *SenderKey) GetVideoWidth() uint32 {
+	if x != nil {
+		return x.VideoWidth
+	}
+	return 0
+}
+
+func (x *SenderKey) GetVideoHeight() uint32 {
+	if x != nil {
+		return x.VideoHeight
+	}
+	return 0
+}
+
+type OutputInfo struct {
+	state     protoimpl.MessageState   `protogen:"open.v1"`
+	Id        uint64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderKey *SenderKey               `protobuf:"bytes,2,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	Urls      []string                 `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
+	State     OutputState              `protobuf:"varint,4,opt,name=state,proto3,enum=ffstream_grpc.OutputState" json:"state,omitempty"`
+	Counters  *avpipeline.NodeCounters `protobuf:"bytes,5,opt,name=counters,proto3" json:"counters,omitempty"`
+	// destinations are the destinations of the output, one per output
+	// template (with a single template it is the output node itself).
+	Destinations  []*OutputDestinationInfo `protobuf:"bytes,6,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputInfo) Reset() {
+	*x = OutputInfo{}
+	mi := &file_ffstream_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputInfo) ProtoMessage() {}
+
+func (x *OutputInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputInfo.ProtoReflect.Descriptor instead.
+func (*OutputInfo) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *OutputInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OutputInfo) GetSenderKey() *SenderKey {
+	if x != nil {
+		return x.SenderKey
+	}
+	return nil
+}
+
+func (x *OutputInfo) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *OutputInfo) GetState() OutputState {
+	if x != nil {
+		return x.State
+	}
+	return OutputState_OUTPUT_STATE_UNDEFINED
+}
+
+func (x *OutputInfo) GetCounters() *avpipeline.NodeCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *OutputInfo) GetDestinations() []*OutputDestinationInfo {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+type OutputDestinationInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index is the index of the output template.
+	Index    uint32                   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Urls     []string                 `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Counters *avpipeline.NodeCounters `protobuf:"bytes,3,opt,name=counters,proto3" json:"counters,omitempty"`
+	// error is set if the destination failed; the failed destination is
+	// detached and the stream is sent to the rest of destinations.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputDestinationInfo) Reset() {
+	*x = OutputDestinationInfo{}
+	mi := &file_ffstream_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputDestinationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputDestinationInfo) ProtoMessage() {}
+
+func (x *OutputDestinationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputDestinationInfo.ProtoReflect.Descriptor instead.
+func (*OutputDestinationInfo) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OutputDestinationInfo) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *OutputDestinationInfo) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *OutputDestinationInfo) GetCounters() *avpipeline.NodeCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *OutputDestinationInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListOutputsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutputsRequest) Reset() {
+	*x = ListOutputsRequest{}
+	mi := &file_ffstream_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutputsRequest) ProtoMessage() {}
+
+func (x *ListOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutputsRequest.ProtoReflect.Descriptor instead.
+func (*ListOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{62}
+}
+
+type ListOutputsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outputs       []*OutputInfo          `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutputsReply) Reset() {
+	*x = ListOutputsReply{}
+	mi := &file_ffstream_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutputsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutputsReply) ProtoMessage() {}
+
+func (x *ListOutputsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutputsReply.ProtoReflect.Descriptor instead.
+func (*ListOutputsReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListOutputsReply) GetOutputs() []*OutputInfo {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type CreateOutputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderKey     *SenderKey             `protobuf:"bytes,1,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOutputRequest) Reset() {
+	*x = CreateOutputRequest{}
+	mi := &file_ffstream_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOutputRequest) ProtoMessage() {}
+
+func (x *CreateOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOutputRequest.ProtoReflect.Descriptor instead.
+func (*CreateOutputRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateOutputRequest) GetSenderKey() *SenderKey {
+	if x != nil {
+		return x.SenderKey
+	}
+	return nil
+}
+
+type CreateOutputReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOutputReply) Reset() {
+	*x = CreateOutputReply{}
+	mi := &file_ffstream_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOutputReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOutputReply) ProtoMessage() {}
+
+func (x *CreateOutputReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOutputReply.ProtoReflect.Descriptor instead.
+func (*CreateOutputReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateOutputReply) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AddInputRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Url           string                     `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	InputPriority uint64                     `protobuf:"varint,2,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	CustomOptions []*avpipeline.CustomOption `protobuf:"bytes,3,rep,name=custom_options,json=customOptions,proto3" json:"custom_options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddInputRequest) Reset() {
+	*x = AddInputRequest{}
+	mi := &file_ffstream_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddInputRequest) ProtoMessage() {}
+
+func (x *AddInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddInputRequest.ProtoReflect.Descriptor instead.
+func (*AddInputRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddInputRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddInputRequest) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+func (x *AddInputRequest) GetCustomOptions() []*avpipeline.CustomOption {
+	if x != nil {
+		return x.CustomOptions
+	}
+	return nil
+}
+
+type AddInputReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputNum      uint64                 `protobuf:"varint,1,opt,name=input_num,json=inputNum,proto3" json:"input_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddInputReply) Reset() {
+	*x = AddInputReply{}
+	mi := &file_ffstream_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddInputReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddInputReply) ProtoMessage() {}
+
+func (x *AddInputReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddInputReply.ProtoReflect.Descriptor instead.
+func (*AddInputReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AddInputReply) GetInputNum() uint64 {
+	if x != nil {
+		return x.InputNum
+	}
+	return 0
+}
+
+type RemoveInputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputPriority uint64                 `protobuf:"varint,1,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	InputNum      uint64                 `protobuf:"varint,2,opt,name=input_num,json=inputNum,proto3" json:"input_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveInputRequest) Reset() {
+	*x = RemoveInputRequest{}
+	mi := &file_ffstream_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveInputRequest) ProtoMessage() {}
+
+func (x *RemoveInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveInputRequest.ProtoReflect.Descriptor instead.
+func (*RemoveInputRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveInputRequest) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+func (x *RemoveInputRequest) GetInputNum() uint64 {
+	if x != nil {
+		return x.InputNum
+	}
+	return 0
+}
+
+type RemoveInputReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveInputReply) Reset() {
+	*x = RemoveInputReply{}
+	mi := &file_ffstream_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveInputReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveInputReply) ProtoMessage() {}
+
+func (x *RemoveInputReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveInputReply.ProtoReflect.Descriptor instead.
+func (*RemoveInputReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{69}
+}
+
+type ReplaceInputURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputPriority uint64                 `protobuf:"varint,1,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	InputNum      uint64                 `protobuf:"varint,2,opt,name=input_num,json=inputNum,proto3" json:"input_num,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceInputURLRequest) Reset() {
+	*x = ReplaceInputURLRequest{}
+	mi := &file_ffstream_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceInputURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceInputURLRequest) ProtoMessage() {}
+
+func (x *ReplaceInputURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceInputURLRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceInputURLRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReplaceInputURLRequest) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+func (x *ReplaceInputURLRequest) GetInputNum() uint64 {
+	if x != nil {
+		return x.InputNum
+	}
+	return 0
+}
+
+func (x *ReplaceInputURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ReplaceInputURLReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceInputURLReply) Reset() {
+	*x = ReplaceInputURLReply{}
+	mi := &file_ffstream_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceInputURLReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceInputURLReply) ProtoMessage() {}
+
+func (x *ReplaceInputURLReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceInputURLReply.ProtoReflect.Descriptor instead.
+func (*ReplaceInputURLReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{71}
+}
+
+type WatchOutputSRTStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// a negative output_id means the active output
+	OutputId int32 `protobuf:"varint,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
+	// in nanoseconds; zero means one second
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// destination is the index of the output template
+	// (see OutputInfo.destinations)
+	Destination   uint32 `protobuf:"varint,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOutputSRTStatsRequest) Reset() {
+	*x = WatchOutputSRTStatsRequest{}
+	mi := &file_ffstream_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOutputSRTStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOutputSRTStatsRequest) ProtoMessage() {}
+
+func (x *WatchOutputSRTStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOutputSRTStatsRequest.ProtoReflect.Descriptor instead.
+func (*WatchOutputSRTStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *WatchOutputSRTStatsRequest) GetOutputId() int32 {
+	if x != nil {
+		return x.OutputId
+	}
+	return 0
+}
+
+func (x *WatchOutputSRTStatsRequest) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *WatchOutputSRTStatsRequest) GetDestination() uint32 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
+// InputAddress addresses an input either by its ID (see InputInfo.id),
+// or, if the ID is zero, by its priority and num.
+type InputAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InputPriority uint64                 `protobuf:"varint,2,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	InputNum      uint64                 `protobuf:"varint,3,opt,name=input_num,json=inputNum,proto3" json:"input_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputAddress) Reset() {
+	*x = InputAddress{}
+	mi := &file_ffstream_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputAddress) ProtoMessage() {}
+
+func (x *InputAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputAddress.ProtoReflect.Descriptor instead.
+func (*InputAddress) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *InputAddress) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InputAddress) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+func (x *InputAddress) GetInputNum() uint64 {
+	if x != nil {
+		return x.InputNum
+	}
+	return 0
+}
+
+type GetInputSRTStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *InputAddress          `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInputSRTStatsRequest) Reset() {
+	*x = GetInputSRTStatsRequest{}
+	mi := &file_ffstream_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInputSRTStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInputSRTStatsRequest) ProtoMessage() {}
+
+func (x *GetInputSRTStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInputSRTStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetInputSRTStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetInputSRTStatsRequest) GetInput() *InputAddress {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type GetInputSRTFlagIntRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *InputAddress          `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Flag          SRTFlagInt             `protobuf:"varint,2,opt,name=flag,proto3,enum=ffstream_grpc.SRTFlagInt" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInputSRTFlagIntRequest) Reset() {
+	*x = GetInputSRTFlagIntRequest{}
+	mi := &file_ffstream_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInputSRTFlagIntRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInputSRTFlagIntRequest) ProtoMessage() {}
+
+func (x *GetInputSRTFlagIntRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInputSRTFlagIntRequest.ProtoReflect.Descriptor instead.
+func (*GetInputSRTFlagIntRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetInputSRTFlagIntRequest) GetInput() *InputAddress {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *GetInputSRTFlagIntRequest) GetFlag() SRTFlagInt {
+	if x != nil {
+		return x.Flag
+	}
+	return SRTFlagInt_SRT_FLAG_INT_UNDEFINED
+}
+
+type SetInputSRTFlagIntRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *InputAddress          `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Flag          SRTFlagInt             `protobuf:"varint,2,opt,name=flag,proto3,enum=ffstream_grpc.SRTFlagInt" json:"flag,omitempty"`
+	Value         int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInputSRTFlagIntRequest) Reset() {
+	*x = SetInputSRTFlagIntRequest{}
+	mi := &file_ffstream_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInputSRTFlagIntRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInputSRTFlagIntRequest) ProtoMessage() {}
+
+func (x *SetInputSRTFlagIntRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInputSRTFlagIntRequest.ProtoReflect.Descriptor instead.
+func (*SetInputSRTFlagIntRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetInputSRTFlagIntRequest) GetInput() *InputAddress {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *SetInputSRTFlagIntRequest) GetFlag() SRTFlagInt {
+	if x != nil {
+		return x.Flag
+	}
+	return SRTFlagInt_SRT_FLAG_INT_UNDEFINED
+}
+
+func (x *SetInputSRTFlagIntRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type WatchOutputSwitchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOutputSwitchesRequest) Reset() {
+	*x = WatchOutputSwitchesRequest{}
+	mi := &file_ffstream_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOutputSwitchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOutputSwitchesRequest) ProtoMessage() {}
+
+func (x *WatchOutputSwitchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOutputSwitchesRequest.ProtoReflect.Descriptor instead.
+func (*WatchOutputSwitchesRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{77}
+}
+
+// OutputSwitchEvent is sent when an output switches between the URLs of
+// its fallback chain (see "output_fallback_priority").
+type OutputSwitchEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UnixNano  int64                  `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+	SenderKey *SenderKey             `protobuf:"bytes,2,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	// the index of the primary output
+	Destination   uint64 `protobuf:"varint,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	FromPriority  uint64 `protobuf:"varint,4,opt,name=from_priority,json=fromPriority,proto3" json:"from_priority,omitempty"`
+	FromUrl       string `protobuf:"bytes,5,opt,name=from_url,json=fromUrl,proto3" json:"from_url,omitempty"`
+	ToPriority    uint64 `protobuf:"varint,6,opt,name=to_priority,json=toPriority,proto3" json:"to_priority,omitempty"`
+	ToUrl         string `protobuf:"bytes,7,opt,name=to_url,json=toUrl,proto3" json:"to_url,omitempty"`
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputSwitchEvent) Reset() {
+	*x = OutputSwitchEvent{}
+	mi := &file_ffstream_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputSwitchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputSwitchEvent) ProtoMessage() {}
+
+func (x *OutputSwitchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputSwitchEvent.ProtoReflect.Descriptor instead.
+func (*OutputSwitchEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *OutputSwitchEvent) GetUnixNano() int64 {
+	if x != nil {
+		return x.UnixNano
+	}
+	return 0
+}
+
+func (x *OutputSwitchEvent) GetSenderKey() *SenderKey {
+	if x != nil {
+		return x.SenderKey
+	}
+	return nil
+}
+
+func (x *OutputSwitchEvent) GetDestination() uint64 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
+func (x *OutputSwitchEvent) GetFromPriority() uint64 {
+	if x != nil {
+		return x.FromPriority
+	}
+	return 0
+}
+
+func (x *OutputSwitchEvent) GetFromUrl() string {
+	if x != nil {
+		return x.FromUrl
+	}
+	return ""
+}
+
+func (x *OutputSwitchEvent) GetToPriority() uint64 {
+	if x != nil {
+		return x.ToPriority
+	}
+	return 0
+}
+
+func (x *OutputSwitchEvent) GetToUrl() string {
+	if x != nil {
+		return x.ToUrl
+	}
+	return ""
+}
+
+func (x *OutputSwitchEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RetryPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in nanoseconds
+	InitialInterval uint64 `protobuf:"varint,1,opt,name=initial_interval,json=initialInterval,proto3" json:"initial_interval,omitempty"`
+	// in nanoseconds
+	MaxInterval uint64  `protobuf:"varint,2,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	Multiplier  float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Jitter      float64 `protobuf:"fixed64,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// zero means no limit
+	MaxAttempts uint64 `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// in nanoseconds; zero means no limit
+	MaxElapsedTime uint64               `protobuf:"varint,6,opt,name=max_elapsed_time,json=maxElapsedTime,proto3" json:"max_elapsed_time,omitempty"`
+	OnExhausted    RetryExhaustedAction `protobuf:"varint,7,opt,name=on_exhausted,json=onExhausted,proto3,enum=ffstream_grpc.RetryExhaustedAction" json:"on_exhausted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_ffstream_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RetryPolicy) GetInitialInterval() uint64 {
+	if x != nil {
+		return x.InitialInterval
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxInterval() uint64 {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxAttempts() uint64 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxElapsedTime() uint64 {
+	if x != nil {
+		return x.MaxElapsedTime
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetOnExhausted() RetryExhaustedAction {
+	if x != nil {
+		return x.OnExhausted
+	}
+	return RetryExhaustedAction_RETRY_EXHAUSTED_ACTION_UNDEFINED
+}
+
+type RetryCounters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempts      uint64                 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Successes     uint64                 `protobuf:"varint,2,opt,name=successes,proto3" json:"successes,omitempty"`
+	Exhausted     uint64                 `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryCounters) Reset() {
+	*x = RetryCounters{}
+	mi := &file_ffstream_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryCounters) ProtoMessage() {}
+
+func (x *RetryCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryCounters.ProtoReflect.Descriptor instead.
+func (*RetryCounters) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RetryCounters) GetAttempts() uint64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *RetryCounters) GetSuccesses() uint64 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *RetryCounters) GetExhausted() uint64 {
+	if x != nil {
+		return x.Exhausted
+	}
+	return 0
+}
+
+type GetRetryPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        RetryTarget            `protobuf:"varint,1,opt,name=target,proto3,enum=ffstream_grpc.RetryTarget" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRetryPolicyRequest) Reset() {
+	*x = GetRetryPolicyRequest{}
+	mi := &file_ffstream_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRetryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetryPolicyRequest) ProtoMessage() {}
+
+func (x *GetRetryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetRetryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetRetryPolicyRequest) GetTarget() RetryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return RetryTarget_RETRY_TARGET_UNDEFINED
+}
+
+type GetRetryPolicyReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unset means the legacy behavior (see -retry_input_timeout_on_failure
+	// and -retry_output_timeout_on_failure)
+	Policy        *RetryPolicy   `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Counters      *RetryCounters `protobuf:"bytes,2,opt,name=counters,proto3" json:"counters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRetryPolicyReply) Reset() {
+	*x = GetRetryPolicyReply{}
+	mi := &file_ffstream_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRetryPolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetryPolicyReply) ProtoMessage() {}
+
+func (x *GetRetryPolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetryPolicyReply.ProtoReflect.Descriptor instead.
+func (*GetRetryPolicyReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetRetryPolicyReply) GetPolicy() *RetryPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *GetRetryPolicyReply) GetCounters() *RetryCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type SetRetryPolicyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target RetryTarget            `protobuf:"varint,1,opt,name=target,proto3,enum=ffstream_grpc.RetryTarget" json:"target,omitempty"`
+	// unset means the legacy behavior
+	Policy        *RetryPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRetryPolicyRequest) Reset() {
+	*x = SetRetryPolicyRequest{}
+	mi := &file_ffstream_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRetryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetryPolicyRequest) ProtoMessage() {}
+
+func (x *SetRetryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetRetryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetRetryPolicyRequest) GetTarget() RetryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return RetryTarget_RETRY_TARGET_UNDEFINED
+}
+
+func (x *SetRetryPolicyRequest) GetPolicy() *RetryPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetRetryPolicyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRetryPolicyReply) Reset() {
+	*x = SetRetryPolicyReply{}
+	mi := &file_ffstream_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRetryPolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetryPolicyReply) ProtoMessage() {}
+
+func (x *SetRetryPolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetryPolicyReply.ProtoReflect.Descriptor instead.
+func (*SetRetryPolicyReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{84}
+}
+
+type ApplyConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the content of the configuration file (see `ffstream -config`);
+	// empty means: reload the configuration file ffstream was started with
+	Config []byte `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// only compute the changes, do not apply them
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigRequest) Reset() {
+	*x = ApplyConfigRequest{}
+	mi := &file_ffstream_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigRequest) ProtoMessage() {}
+
+func (x *ApplyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ApplyConfigRequest) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ApplyConfigRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ConfigChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	IsLive        bool                   `protobuf:"varint,3,opt,name=is_live,json=isLive,proto3" json:"is_live,omitempty"`
+	IsApplied     bool                   `protobuf:"varint,4,opt,name=is_applied,json=isApplied,proto3" json:"is_applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigChange) Reset() {
+	*x = ConfigChange{}
+	mi := &file_ffstream_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChange) ProtoMessage() {}
+
+func (x *ConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigChange.ProtoReflect.Descriptor instead.
+func (*ConfigChange) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ConfigChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetIsLive() bool {
+	if x != nil {
+		return x.IsLive
+	}
+	return false
+}
+
+func (x *ConfigChange) GetIsApplied() bool {
+	if x != nil {
+		return x.IsApplied
+	}
+	return false
+}
+
+type ApplyConfigReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*ConfigChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigReply) Reset() {
+	*x = ApplyConfigReply{}
+	mi := &file_ffstream_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigReply) ProtoMessage() {}
+
+func (x *ApplyConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigReply.ProtoReflect.Descriptor instead.
+func (*ApplyConfigReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ApplyConfigReply) GetChanges() []*ConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_ffstream_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{88}
+}
+
+type InputActivatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputPriority uint64                 `protobuf:"varint,1,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputActivatedEvent) Reset() {
+	*x = InputActivatedEvent{}
+	mi := &file_ffstream_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputActivatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputActivatedEvent) ProtoMessage() {}
+
+func (x *InputActivatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputActivatedEvent.ProtoReflect.Descriptor instead.
+func (*InputActivatedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *InputActivatedEvent) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+type InputDeactivatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputPriority uint64                 `protobuf:"varint,1,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputDeactivatedEvent) Reset() {
+	*x = InputDeactivatedEvent{}
+	mi := &file_ffstream_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputDeactivatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputDeactivatedEvent) ProtoMessage() {}
+
+func (x *InputDeactivatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputDeactivatedEvent.ProtoReflect.Descriptor instead.
+func (*InputDeactivatedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *InputDeactivatedEvent) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+type InputReconnectingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputPriority uint64                 `protobuf:"varint,1,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	// zero if no retry policy is set
+	Attempt uint64 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// in nanoseconds
+	Delay         uint64 `protobuf:"varint,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputReconnectingEvent) Reset() {
+	*x = InputReconnectingEvent{}
+	mi := &file_ffstream_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputReconnectingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputReconnectingEvent) ProtoMessage() {}
+
+func (x *InputReconnectingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputReconnectingEvent.ProtoReflect.Descriptor instead.
+func (*InputReconnectingEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *InputReconnectingEvent) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+func (x *InputReconnectingEvent) GetAttempt() uint64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *InputReconnectingEvent) GetDelay() uint64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type InputDemotedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputPriority uint64                 `protobuf:"varint,1,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	// for how long the inputs are kept stopped, in nanoseconds
+	Hold uint64 `protobuf:"varint,2,opt,name=hold,proto3" json:"hold,omitempty"`
+	// the violated thresholds of the health policy
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputDemotedEvent) Reset() {
+	*x = InputDemotedEvent{}
+	mi := &file_ffstream_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputDemotedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputDemotedEvent) ProtoMessage() {}
+
+func (x *InputDemotedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputDemotedEvent.ProtoReflect.Descriptor instead.
+func (*InputDemotedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *InputDemotedEvent) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+func (x *InputDemotedEvent) GetHold() uint64 {
+	if x != nil {
+		return x.Hold
+	}
+	return 0
+}
+
+func (x *InputDemotedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type InputPromotedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputPriority uint64                 `protobuf:"varint,1,opt,name=input_priority,json=inputPriority,proto3" json:"input_priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputPromotedEvent) Reset() {
+	*x = InputPromotedEvent{}
+	mi := &file_ffstream_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputPromotedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputPromotedEvent) ProtoMessage() {}
+
+func (x *InputPromotedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputPromotedEvent.ProtoReflect.Descriptor instead.
+func (*InputPromotedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *InputPromotedEvent) GetInputPriority() uint64 {
+	if x != nil {
+		return x.InputPriority
+	}
+	return 0
+}
+
+type OutputCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderKey     *SenderKey             `protobuf:"bytes,2,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	Urls          []string               `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputCreatedEvent) Reset() {
+	*x = OutputCreatedEvent{}
+	mi := &file_ffstream_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputCreatedEvent) ProtoMessage() {}
+
+func (x *OutputCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputCreatedEvent.ProtoReflect.Descriptor instead.
+func (*OutputCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *OutputCreatedEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OutputCreatedEvent) GetSenderKey() *SenderKey {
+	if x != nil {
+		return x.SenderKey
+	}
+	return nil
+}
+
+func (x *OutputCreatedEvent) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type OutputRemovedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderKey     *SenderKey             `protobuf:"bytes,2,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputRemovedEvent) Reset() {
+	*x = OutputRemovedEvent{}
+	mi := &file_ffstream_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputRemovedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputRemovedEvent) ProtoMessage() {}
+
+func (x *OutputRemovedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputRemovedEvent.ProtoReflect.Descriptor instead.
+func (*OutputRemovedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *OutputRemovedEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OutputRemovedEvent) GetSenderKey() *SenderKey {
+	if x != nil {
+		return x.SenderKey
+	}
+	return nil
+}
+
+type OutputReconnectingEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SenderKey *SenderKey             `protobuf:"bytes,1,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	// the index of the primary output
+	Destination uint64 `protobuf:"varint,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Url         string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// in nanoseconds
+	Delay         uint64 `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputReconnectingEvent) Reset() {
+	*x = OutputReconnectingEvent{}
+	mi := &file_ffstream_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputReconnectingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputReconnectingEvent) ProtoMessage() {}
+
+func (x *OutputReconnectingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputReconnectingEvent.ProtoReflect.Descriptor instead.
+func (*OutputReconnectingEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *OutputReconnectingEvent) GetSenderKey() *SenderKey {
+	if x != nil {
+		return x.SenderKey
+	}
+	return nil
+}
+
+func (x *OutputReconnectingEvent) GetDestination() uint64 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
+func (x *OutputReconnectingEvent) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *OutputReconnectingEvent) GetDelay() uint64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *OutputReconnectingEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type VideoEncoderChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromWidth     uint32                 `protobuf:"varint,1,opt,name=from_width,json=fromWidth,proto3" json:"from_width,omitempty"`
+	FromHeight    uint32                 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	FromBitRate   uint64                 `protobuf:"varint,3,opt,name=from_bit_rate,json=fromBitRate,proto3" json:"from_bit_rate,omitempty"`
+	ToWidth       uint32                 `protobuf:"varint,4,opt,name=to_width,json=toWidth,proto3" json:"to_width,omitempty"`
+	ToHeight      uint32                 `protobuf:"varint,5,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	ToBitRate     uint64                 `protobuf:"varint,6,opt,name=to_bit_rate,json=toBitRate,proto3" json:"to_bit_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoEncoderChangedEvent) Reset() {
+	*x = VideoEncoderChangedEvent{}
+	mi := &file_ffstream_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoEncoderChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoEncoderChangedEvent) ProtoMessage() {}
+
+func (x *VideoEncoderChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoEncoderChangedEvent.ProtoReflect.Descriptor instead.
+func (*VideoEncoderChangedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *VideoEncoderChangedEvent) GetFromWidth() uint32 {
+	if x != nil {
+		return x.FromWidth
+	}
+	return 0
+}
+
+func (x *VideoEncoderChangedEvent) GetFromHeight() uint32 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *VideoEncoderChangedEvent) GetFromBitRate() uint64 {
+	if x != nil {
+		return x.FromBitRate
+	}
+	return 0
+}
+
+func (x *VideoEncoderChangedEvent) GetToWidth() uint32 {
+	if x != nil {
+		return x.ToWidth
+	}
+	return 0
+}
+
+func (x *VideoEncoderChangedEvent) GetToHeight() uint32 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+func (x *VideoEncoderChangedEvent) GetToBitRate() uint64 {
+	if x != nil {
+		return x.ToBitRate
+	}
+	return 0
+}
+
+type BypassChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsBypass      bool                   `protobuf:"varint,1,opt,name=is_bypass,json=isBypass,proto3" json:"is_bypass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BypassChangedEvent) Reset() {
+	*x = BypassChangedEvent{}
+	mi := &file_ffstream_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BypassChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BypassChangedEvent) ProtoMessage() {}
+
+func (x *BypassChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BypassChangedEvent.ProtoReflect.Descriptor instead.
+func (*BypassChangedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *BypassChangedEvent) GetIsBypass() bool {
+	if x != nil {
+		return x.IsBypass
+	}
+	return false
+}
+
+type FPSFractionChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Num           uint32                 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Den           uint32                 `protobuf:"varint,2,opt,name=den,proto3" json:"den,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FPSFractionChangedEvent) Reset() {
+	*x = FPSFractionChangedEvent{}
+	mi := &file_ffstream_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FPSFractionChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FPSFractionChangedEvent) ProtoMessage() {}
+
+func (x *FPSFractionChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FPSFractionChangedEvent.ProtoReflect.Descriptor instead.
+func (*FPSFractionChangedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FPSFractionChangedEvent) GetNum() uint32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *FPSFractionChangedEvent) GetDen() uint32 {
+	if x != nil {
+		return x.Den
+	}
+	return 0
+}
+
+type RetriesExhaustedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        RetryTarget            `protobuf:"varint,1,opt,name=target,proto3,enum=ffstream_grpc.RetryTarget" json:"target,omitempty"`
+	OnExhausted   RetryExhaustedAction   `protobuf:"varint,2,opt,name=on_exhausted,json=onExhausted,proto3,enum=ffstream_grpc.RetryExhaustedAction" json:"on_exhausted,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetriesExhaustedEvent) Reset() {
+	*x = RetriesExhaustedEvent{}
+	mi := &file_ffstream_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetriesExhaustedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetriesExhaustedEvent) ProtoMessage() {}
+
+func (x *RetriesExhaustedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetriesExhaustedEvent.ProtoReflect.Descriptor instead.
+func (*RetriesExhaustedEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RetriesExhaustedEvent) GetTarget() RetryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return RetryTarget_RETRY_TARGET_UNDEFINED
+}
+
+func (x *RetriesExhaustedEvent) GetOnExhausted() RetryExhaustedAction {
+	if x != nil {
+		return x.OnExhausted
+	}
+	return RetryExhaustedAction_RETRY_EXHAUSTED_ACTION_UNDEFINED
+}
+
+func (x *RetriesExhaustedEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ErrorEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorEvent) Reset() {
+	*x = ErrorEvent{}
+	mi := &file_ffstream_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorEvent) ProtoMessage() {}
+
+func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorEvent.ProtoReflect.Descriptor instead.
+func (*ErrorEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ErrorEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EndOfStreamEvent is the last event; the error is empty if the stream
+// is finished normally.
+type EndOfStreamEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndOfStreamEvent) Reset() {
+	*x = EndOfStreamEvent{}
+	mi := &file_ffstream_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndOfStreamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndOfStreamEvent) ProtoMessage() {}
+
+func (x *EndOfStreamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndOfStreamEvent.ProtoReflect.Descriptor instead.
+func (*EndOfStreamEvent) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *EndOfStreamEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Event struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UnixNano int64                  `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*Event_InputActivated
+	//	*Event_InputDeactivated
+	//	*Event_InputReconnecting
+	//	*Event_OutputCreated
+	//	*Event_OutputRemoved
+	//	*Event_OutputReconnecting
+	//	*Event_OutputSwitch
+	//	*Event_VideoEncoderChanged
+	//	*Event_BypassChanged
+	//	*Event_FpsFractionChanged
+	//	*Event_Error
+	//	*Event_EndOfStream
+	//	*Event_RetriesExhausted
+	//	*Event_InputDemoted
+	//	*Event_InputPromoted
+	Event         isEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_ffstream_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Event) GetUnixNano() int64 {
+	if x != nil {
+		return x.UnixNano
+	}
+	return 0
+}
+
+func (x *Event) GetEvent() isEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Event) GetInputActivated() *InputActivatedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_InputActivated); ok {
+			return x.InputActivated
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetInputDeactivated() *InputDeactivatedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_InputDeactivated); ok {
+			return x.InputDeactivated
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetInputReconnecting() *InputReconnectingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_InputReconnecting); ok {
+			return x.InputReconnecting
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetOutputCreated() *OutputCreatedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_OutputCreated); ok {
+			return x.OutputCreated
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetOutputRemoved() *OutputRemovedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_OutputRemoved); ok {
+			return x.OutputRemoved
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetOutputReconnecting() *OutputReconnectingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_OutputReconnecting); ok {
+			return x.OutputReconnecting
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetOutputSwitch() *OutputSwitchEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_OutputSwitch); ok {
+			return x.OutputSwitch
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetVideoEncoderChanged() *VideoEncoderChangedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_VideoEncoderChanged); ok {
+			return x.VideoEncoderChanged
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetBypassChanged() *BypassChangedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_BypassChanged); ok {
+			return x.BypassChanged
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetFpsFractionChanged() *FPSFractionChangedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_FpsFractionChanged); ok {
+			return x.FpsFractionChanged
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetError() *ErrorEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetEndOfStream() *EndOfStreamEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_EndOfStream); ok {
+			return x.EndOfStream
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetRetriesExhausted() *RetriesExhaustedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_RetriesExhausted); ok {
+			return x.RetriesExhausted
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetInputDemoted() *InputDemotedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_InputDemoted); ok {
+			return x.InputDemoted
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetInputPromoted() *InputPromotedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_InputPromoted); ok {
+			return x.InputPromoted
+		}
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_InputActivated struct {
+	InputActivated *InputActivatedEvent `protobuf:"bytes,2,opt,name=input_activated,json=inputActivated,proto3,oneof"`
+}
+
+type Event_InputDeactivated struct {
+	InputDeactivated *InputDeactivatedEvent `protobuf:"bytes,3,opt,name=input_deactivated,json=inputDeactivated,proto3,oneof"`
+}
+
+type Event_InputReconnecting struct {
+	InputReconnecting *InputReconnectingEvent `protobuf:"bytes,4,opt,name=input_reconnecting,json=inputReconnecting,proto3,oneof"`
+}
+
+type Event_OutputCreated struct {
+	OutputCreated *OutputCreatedEvent `protobuf:"bytes,5,opt,name=output_created,json=outputCreated,proto3,oneof"`
+}
+
+type Event_OutputRemoved struct {
+	OutputRemoved *OutputRemovedEvent `protobuf:"bytes,6,opt,name=output_removed,json=outputRemoved,proto3,oneof"`
+}
+
+type Event_OutputReconnecting struct {
+	OutputReconnecting *OutputReconnectingEvent `protobuf:"bytes,7,opt,name=output_reconnecting,json=outputReconnecting,proto3,oneof"`
+}
+
+type Event_OutputSwitch struct {
+	OutputSwitch *OutputSwitchEvent `protobuf:"bytes,8,opt,name=output_switch,json=outputSwitch,proto3,oneof"`
+}
+
+type Event_VideoEncoderChanged struct {
+	VideoEncoderChanged *VideoEncoderChangedEvent `protobuf:"bytes,9,opt,name=video_encoder_changed,json=videoEncoderChanged,proto3,oneof"`
+}
+
+type Event_BypassChanged struct {
+	BypassChanged *BypassChangedEvent `protobuf:"bytes,10,opt,name=bypass_changed,json=bypassChanged,proto3,oneof"`
+}
+
+type Event_FpsFractionChanged struct {
+	FpsFractionChanged *FPSFractionChangedEvent `protobuf:"bytes,11,opt,name=fps_fraction_changed,json=fpsFractionChanged,proto3,oneof"`
+}
+
+type Event_Error struct {
+	Error *ErrorEvent `protobuf:"bytes,12,opt,name=error,proto3,oneof"`
+}
+
+type Event_EndOfStream struct {
+	EndOfStream *EndOfStreamEvent `protobuf:"bytes,13,opt,name=end_of_stream,json=endOfStream,proto3,oneof"`
+}
+
+type Event_RetriesExhausted struct {
+	RetriesExhausted *RetriesExhaustedEvent `protobuf:"bytes,14,opt,name=retries_exhausted,json=retriesExhausted,proto3,oneof"`
+}
+
+type Event_InputDemoted struct {
+	InputDemoted *InputDemotedEvent `protobuf:"bytes,15,opt,name=input_demoted,json=inputDemoted,proto3,oneof"`
+}
+
+type Event_InputPromoted struct {
+	InputPromoted *InputPromotedEvent `protobuf:"bytes,16,opt,name=input_promoted,json=inputPromoted,proto3,oneof"`
+}
+
+func (*Event_InputActivated) isEvent_Event() {}
+
+func (*Event_InputDeactivated) isEvent_Event() {}
+
+func (*Event_InputReconnecting) isEvent_Event() {}
+
+func (*Event_OutputCreated) isEvent_Event() {}
+
+func (*Event_OutputRemoved) isEvent_Event() {}
+
+func (*Event_OutputReconnecting) isEvent_Event() {}
+
+func (*Event_OutputSwitch) isEvent_Event() {}
+
+func (*Event_VideoEncoderChanged) isEvent_Event() {}
+
+func (*Event_BypassChanged) isEvent_Event() {}
+
+func (*Event_FpsFractionChanged) isEvent_Event() {}
+
+func (*Event_Error) isEvent_Event() {}
+
+func (*Event_EndOfStream) isEvent_Event() {}
+
+func (*Event_RetriesExhausted) isEvent_Event() {}
+
+func (*Event_InputDemoted) isEvent_Event() {}
+
+func (*Event_InputPromoted) isEvent_Event() {}
+
+type StreamInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the arguments of the stream (in the same form as of the ffstream command)
+	Args  []string    `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	State StreamState `protobuf:"varint,3,opt,name=state,proto3,enum=ffstream_grpc.StreamState" json:"state,omitempty"`
+	// the error the stream stopped with, if any
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamInfo) Reset() {
+	*x = StreamInfo{}
+	mi := &file_ffstream_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInfo) ProtoMessage() {}
+
+func (x *StreamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInfo.ProtoReflect.Descriptor instead.
+func (*StreamInfo) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StreamInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *StreamInfo) GetState() StreamState {
+	if x != nil {
+		return x.State
+	}
+	return StreamState_STREAM_STATE_UNDEFINED
+}
+
+func (x *StreamInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CreateStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the arguments of the stream (in the same form as of the ffstream
+	// command, without the program name), e.g. ["-i", "<input>", "<output>"]
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// the content of the configuration file (see `ffstream -config`), optional
+	Config []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// start the stream right after creating it
+	Start         bool `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStreamRequest) Reset() {
+	*x = CreateStreamRequest{}
+	mi := &file_ffstream_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStreamRequest) ProtoMessage() {}
+
+func (x *CreateStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStreamRequest.ProtoReflect.Descriptor instead.
+func (*CreateStreamRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CreateStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateStreamRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *CreateStreamRequest) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateStreamRequest) GetStart() bool {
+	if x != nil {
+		return x.Start
+	}
+	return false
+}
+
+type CreateStreamReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stream        *StreamInfo            `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStreamReply) Reset() {
+	*x = CreateStreamReply{}
+	mi := &file_ffstream_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStreamReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStreamReply) ProtoMessage() {}
+
+func (x *CreateStreamReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStreamReply.ProtoReflect.Descriptor instead.
+func (*CreateStreamReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreateStreamReply) GetStream() *StreamInfo {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+type StartStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartStreamRequest) Reset() {
+	*x = StartStreamRequest{}
+	mi := &file_ffstream_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStreamRequest) ProtoMessage() {}
+
+func (x *StartStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStreamRequest.ProtoReflect.Descriptor instead.
+func (*StartStreamRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *StartStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StartStreamReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartStreamReply) Reset() {
+	*x = StartStreamReply{}
+	mi := &file_ffstream_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStreamReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStreamReply) ProtoMessage() {}
+
+func (x *StartStreamReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStreamReply.ProtoReflect.Descriptor instead.
+func (*StartStreamReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{108}
+}
+
+type StopStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopStreamRequest) Reset() {
+	*x = StopStreamRequest{}
+	mi := &file_ffstream_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopStreamRequest) ProtoMessage() {}
+
+func (x *StopStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopStreamRequest.ProtoReflect.Descriptor instead.
+func (*StopStreamRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *StopStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopStreamReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopStreamReply) Reset() {
+	*x = StopStreamReply{}
+	mi := &file_ffstream_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopStreamReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopStreamReply) ProtoMessage() {}
+
+func (x *StopStreamReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopStreamReply.ProtoReflect.Descriptor instead.
+func (*StopStreamReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{110}
+}
+
+type DeleteStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStreamRequest) Reset() {
+	*x = DeleteStreamRequest{}
+	mi := &file_ffstream_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStreamRequest) ProtoMessage() {}
+
+func (x *DeleteStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStreamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStreamRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DeleteStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteStreamReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStreamReply) Reset() {
+	*x = DeleteStreamReply{}
+	mi := &file_ffstream_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStreamReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStreamReply) ProtoMessage() {}
+
+func (x *DeleteStreamReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStreamReply.ProtoReflect.Descriptor instead.
+func (*DeleteStreamReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{112}
+}
+
+type ListStreamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStreamsRequest) Reset() {
+	*x = ListStreamsRequest{}
+	mi := &file_ffstream_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStreamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStreamsRequest) ProtoMessage() {}
+
+func (x *ListStreamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStreamsRequest.ProtoReflect.Descriptor instead.
+func (*ListStreamsRequest) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{113}
+}
+
+type ListStreamsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streams       []*StreamInfo          `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStreamsReply) Reset() {
+	*x = ListStreamsReply{}
+	mi := &file_ffstream_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStreamsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStreamsReply) ProtoMessage() {}
+
+func (x *ListStreamsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_ffstream_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStreamsReply.ProtoReflect.Descriptor instead.
+func (*ListStreamsReply) Descriptor() ([]byte, []int) {
+	return file_ffstream_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListStreamsReply) GetStreams() []*StreamInfo {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+var File_ffstream_proto protoreflect.FileDescriptor
+
+var file_ffstream_proto_rawDesc = string([]byte{
+	0x0a, 0x0e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0d, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x1a,
+	0x2c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x76, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x72, 0x6f,
+	0x70, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x4f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0x13, 0x0a,
+	0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0xe8, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x63,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x69, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0xd9, 0x02,
+	0x0a, 0x10, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x61, 0x72, 0x64, 0x77,
+	0x61, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x61, 0x72,
+	0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35,
+	0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x19, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x68, 0x61, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x77, 0x0a, 0x1a, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0x59, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfc, 0x1a, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x73, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d,
+	0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6b,
+	0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63,
+	0x76, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e,
+	0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x6c,
+	0x6f, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74,
+	0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12,
+	0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74,
+	0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x41, 0x63,
+	0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6b, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f,
+	0x6e, 0x61, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x4e, 0x61, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x31, 0x0a, 0x15, 0x75, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x75, 0x73, 0x53, 0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64,
+	0x72, 0x6f, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x2b, 0x0a, 0x12, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f, 0x70,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b,
+	0x74, 0x52, 0x63, 0x76, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a,
+	0x17, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x55, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62,
+	0x79, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f,
+	0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x63, 0x76, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x13, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x2d, 0x0a, 0x13, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x72,
+	0x6f, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x62, 0x79, 0x74, 0x65, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x2d, 0x0a, 0x13, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f,
+	0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x62,
+	0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x37, 0x0a, 0x18, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x64, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x55, 0x6e, 0x64, 0x65, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6b, 0x74, 0x5f,
+	0x73, 0x65, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6b, 0x74, 0x53,
+	0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x12, 0x20,
+	0x0a, 0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x4c, 0x6f, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x4c, 0x6f,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b,
+	0x74, 0x52, 0x63, 0x76, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x70,
+	0x6b, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x20, 0x0a,
+	0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76, 0x41, 0x63, 0x6b, 0x12,
+	0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6b, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x6e, 0x61,
+	0x6b, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76,
+	0x4e, 0x61, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x62, 0x70, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x62, 0x70,
+	0x73, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x62, 0x70,
+	0x73, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x6d, 0x62, 0x70, 0x73, 0x52, 0x65, 0x63, 0x76, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x75, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x73, 0x53, 0x6e, 0x64, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6b, 0x74, 0x5f, 0x72,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x23, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x18, 0x70, 0x6b, 0x74,
+	0x5f, 0x72, 0x63, 0x76, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x62, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x70, 0x6b, 0x74,
+	0x52, 0x63, 0x76, 0x41, 0x76, 0x67, 0x42, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b, 0x74, 0x52,
+	0x63, 0x76, 0x42, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6b, 0x74,
+	0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x26, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x70,
+	0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x27, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x2a, 0x0a,
+	0x11, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76,
+	0x55, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72,
+	0x65, 0x63, 0x76, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x76, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f,
+	0x6c, 0x6f, 0x73, 0x73, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65,
+	0x52, 0x63, 0x76, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x79, 0x74, 0x65, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x79,
+	0x74, 0x65, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x2d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x53, 0x6e, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x22,
+	0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18,
+	0x2e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x44, 0x72,
+	0x6f, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x75,
+	0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x55, 0x6e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x12, 0x29, 0x0a, 0x11, 0x75, 0x73, 0x5f, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x30, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x75, 0x73, 0x50,
+	0x6b, 0x74, 0x53, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70,
+	0x6b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x31,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x70, 0x6b, 0x74, 0x43, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x70, 0x6b, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x6d, 0x73, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x34, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x6d, 0x73, 0x52, 0x74, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x62, 0x70, 0x73, 0x5f, 0x62,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x35, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x6d, 0x62, 0x70, 0x73, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x2b, 0x0a,
+	0x12, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x6e, 0x64, 0x5f,
+	0x62, 0x75, 0x66, 0x18, 0x36, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x53, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x12, 0x2b, 0x0a, 0x12, 0x62, 0x79,
+	0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66,
+	0x18, 0x37, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x52, 0x63, 0x76, 0x42, 0x75, 0x66, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x62, 0x70, 0x73, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x77, 0x18, 0x38, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x62,
+	0x70, 0x73, 0x4d, 0x61, 0x78, 0x42, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x5f,
+	0x6d, 0x73, 0x73, 0x18, 0x39, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x4d,
+	0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x62, 0x75,
+	0x66, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x42,
+	0x75, 0x66, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x62,
+	0x75, 0x66, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x53, 0x6e,
+	0x64, 0x42, 0x75, 0x66, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x62,
+	0x75, 0x66, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x73, 0x53, 0x6e, 0x64, 0x42,
+	0x75, 0x66, 0x12, 0x2c, 0x0a, 0x13, 0x6d, 0x73, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x74, 0x73, 0x62,
+	0x5f, 0x70, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6d, 0x73, 0x53, 0x6e, 0x64, 0x54, 0x73, 0x62, 0x50, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66, 0x18,
+	0x3e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x42, 0x75, 0x66,
+	0x12, 0x20, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66,
+	0x18, 0x3f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x52, 0x63, 0x76, 0x42,
+	0x75, 0x66, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x73, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66,
+	0x18, 0x40, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x73, 0x52, 0x63, 0x76, 0x42, 0x75, 0x66,
+	0x12, 0x2c, 0x0a, 0x13, 0x6d, 0x73, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x74, 0x73, 0x62, 0x5f, 0x70,
+	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x41, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
+	0x73, 0x52, 0x63, 0x76, 0x54, 0x73, 0x62, 0x50, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3a,
+	0x0a, 0x1a, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x42, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x70, 0x6b, 0x74, 0x53, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x1a, 0x70, 0x6b,
+	0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x43, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x1b, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63,
+	0x76, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x44, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x70, 0x6b, 0x74,
+	0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x19, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x45, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f,
+	0x0a, 0x14, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x6e, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x6b,
+	0x74, 0x53, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12,
+	0x2f, 0x0a, 0x14, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x47, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70,
+	0x6b, 0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x12, 0x31, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x48, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x49, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x70, 0x6b, 0x74, 0x52, 0x63, 0x76, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x6f,
+	0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65,
+	0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x4b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x6b, 0x74,
+	0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63,
+	0x76, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x16,
+	0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x62, 0x79,
+	0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x4e, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x63, 0x76, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x73, 0x65,
+	0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x4f, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x70, 0x6b, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x6b, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6b, 0x74, 0x52, 0x65, 0x63, 0x76,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73,
+	0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x51, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x18, 0x52, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x76, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x64,
+	0x12, 0x2d, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9a, 0x01,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x66, 0x6c,
+	0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x0d, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x0b, 0x0a, 0x09, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0c, 0x0a, 0x0a,
+	0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0a, 0x0a, 0x08, 0x45, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x22, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5c, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x5e, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x20, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x26, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x67, 0x0a, 0x22, 0x47, 0x65, 0x74,
 	0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x5c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x50, 0x53,
-	0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x5c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x66,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x41, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x69, 0x0a, 0x24, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75,
+	0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f,
+	0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x24, 0x0a,
+	0x22, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x64, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x46, 0x50,
+	0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6e,
+	0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x64, 0x65, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4f, 0x0a, 0x0b, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x22, 0xd6, 0x01, 0x0a,
+	0x08, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x42, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x69, 0x74, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x69,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x34, 0x0a, 0x09, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x62, 0x69, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x36, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x09,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x09, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x33, 0x0a, 0x05, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x70, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x55, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50,
+	0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x55, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7e,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x89,
+	0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x69, 0x74, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x64, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32,
+	0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6e,
+	0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x76, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x89, 0x01,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4e, 0x75,
+	0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x50, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53,
+	0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6d, 0x0a,
+	0x15, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12,
+	0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x57, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x21, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x9b, 0x02, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66,
+	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x22, 0x4e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x22, 0x23, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4e, 0x75,
+	0x6d, 0x22, 0x58, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4e, 0x75, 0x6d, 0x22, 0x12, 0x0a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x6e, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
+	0x16, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x77, 0x0a, 0x1a, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x62, 0x0a, 0x0c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x4e, 0x75, 0x6d, 0x22, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x22, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x52,
+	0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x66, 0x6c, 0x61,
+	0x67, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x52,
+	0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x66, 0x6c, 0x61,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9b, 0x02, 0x0a, 0x11, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x75, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d,
+	0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0xa8, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x68,
+	0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x66,
+	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x6f, 0x6e, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x67,
+	0x0a, 0x0d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x68,
+	0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78,
+	0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66,
+	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x7f, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x45, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x7a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x69, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0x18, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x13, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3e, 0x0a, 0x15, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x6f, 0x0a, 0x16, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x66, 0x0a, 0x11, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x3b, 0x0a, 0x12, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x71,
+	0x0a, 0x12, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x22, 0x5d, 0x0a, 0x12, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x22, 0xb2, 0x01, 0x0a, 0x17, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0a,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd6, 0x01, 0x0a, 0x18, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x6f, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e,
+	0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x31,
+	0x0a, 0x12, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x79, 0x70, 0x61, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x79, 0x70, 0x61, 0x73,
+	0x73, 0x22, 0x3d, 0x0a, 0x17, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x64, 0x65, 0x6e,
+	0x22, 0xa9, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x78, 0x68, 0x61,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x46,
+	0x0a, 0x0c, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x6e, 0x45, 0x78, 0x68,
+	0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x22, 0x0a, 0x0a,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x28, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd0, 0x09, 0x0a, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e,
+	0x6f, 0x12, 0x4d, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x53, 0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x12, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4a, 0x0a,
+	0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0e, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x47, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x5d, 0x0a, 0x15, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0e, 0x62, 0x79, 0x70, 0x61,
+	0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x14, 0x66, 0x70, 0x73, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x66, 0x70,
+	0x73, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x31, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x64, 0x4f, 0x66,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x65,
+	0x6e, 0x64, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x53, 0x0a, 0x11, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x78, 0x68,
+	0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x47, 0x0a, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x64,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0e, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x78, 0x0a,
+	0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x22, 0x46, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x24, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x12,
+	0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x23, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x33, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x2a, 0xd3, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e,
+	0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49,
+	0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x47,
+	0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10,
+	0x04, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47,
+	0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x07, 0x2a, 0x42, 0x0a, 0x0a, 0x53,
+	0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x52, 0x54,
+	0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x52, 0x54, 0x5f, 0x46, 0x4c, 0x41,
+	0x47, 0x5f, 0x49, 0x4e, 0x54, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x01, 0x2a,
+	0x8b, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52,
+	0x41, 0x50, 0x48, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x47,
+	0x52, 0x41, 0x50, 0x48, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x50,
+	0x48, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x10, 0x02, 0x12, 0x1d,
+	0x0a, 0x19, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x58, 0x10, 0x03, 0x2a, 0x5c, 0x0a,
+	0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x44,
+	0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x55, 0x54, 0x50,
+	0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x42, 0x59, 0x10, 0x02, 0x2a, 0x5a, 0x0a, 0x0b, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45,
+	0x54, 0x52, 0x59, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f,
+	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x4f,
+	0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x02, 0x2a, 0xa7, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53,
+	0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f,
+	0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x54, 0x52, 0x59,
+	0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f,
+	0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x10,
+	0x03, 0x2a, 0x77, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x32, 0xfb, 0x1d, 0x0a, 0x08, 0x46,
+	0x46, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x5f, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66,
+	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x26, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66,
 	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x56, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x12, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x13, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x29, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x4a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e,
+	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c,
+	0x61, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67,
+	0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x52,
+	0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74,
+	0x12, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67,
+	0x49, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x08, 0x57, 0x61,
+	0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x12, 0x1a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x3b, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x22, 0x2e,
 	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61,
-	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12,
-	0x26, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x76, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f,
+	0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f,
+	0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41,
+	0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x89, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x33, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x41, 0x75, 0x74, 0x6f, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x66,
+	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74,
+	0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x50, 0x53, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e,
+	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x26, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x07, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x76, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x59, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
 	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x66, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x6e, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x56, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
-	0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x12, 0x5a, 0x10, 0x67, 0x6f, 0x2f, 0x66, 0x66,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0c, 0x53, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x12, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x08, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1e, 0x2e, 0x66, 0x66, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x66, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0b, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x52,
+	0x4c, 0x12, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x6b, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52,
+	0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x26, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x53, 0x52, 0x54, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54,
+	0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x66,
+	0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61, 0x67, 0x49, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x52, 0x54, 0x46, 0x6c, 0x61,
+	0x67, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x13, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x29, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x24, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x66, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x53, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x32, 0xa3, 0x04, 0x0a, 0x0e, 0x46, 0x46, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x2e, 0x66, 0x66,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x2e, 0x66, 0x66, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x21, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x25, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69,
+	0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x12,
+	0x5a, 0x10, 0x67, 0x6f, 0x2f, 0x66, 0x66, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -4110,161 +8320,311 @@ func file_ffstream_proto_rawDescGZIP() []byte {
 	return file_ffstream_proto_rawDescData
 }
 
-var file_ffstream_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_ffstream_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_ffstream_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_ffstream_proto_msgTypes = make([]protoimpl.MessageInfo, 115)
 var file_ffstream_proto_goTypes = []any{
 	(LoggingLevel)(0),                            // 0: ffstream_grpc.LoggingLevel
 	(SRTFlagInt)(0),                              // 1: ffstream_grpc.SRTFlagInt
-	(*SetLoggingLevelRequest)(nil),               // 2: ffstream_grpc.SetLoggingLevelRequest
-	(*SetLoggingLevelReply)(nil),                 // 3: ffstream_grpc.SetLoggingLevelReply
-	(*RemoveOutputRequest)(nil),                  // 4: ffstream_grpc.RemoveOutputRequest
-	(*RemoveOutputReply)(nil),                    // 5: ffstream_grpc.RemoveOutputReply
-	(*AudioCodecConfig)(nil),                     // 6: ffstream_grpc.AudioCodecConfig
-	(*VideoCodecConfig)(nil),                     // 7: ffstream_grpc.VideoCodecConfig
-	(*TranscoderConfig)(nil),                     // 8: ffstream_grpc.TranscoderConfig
-	(*GetCurrentOutputRequest)(nil),              // 9: ffstream_grpc.GetCurrentOutputRequest
-	(*GetCurrentOutputReply)(nil),                // 10: ffstream_grpc.GetCurrentOutputReply
-	(*SwitchOutputByPropsRequest)(nil),           // 11: ffstream_grpc.SwitchOutputByPropsRequest
-	(*SwitchOutputByPropsReply)(nil),             // 12: ffstream_grpc.SwitchOutputByPropsReply
-	(*GetStatsRequest)(nil),                      // 13: ffstream_grpc.GetStatsRequest
-	(*GetStatsReply)(nil),                        // 14: ffstream_grpc.GetStatsReply
-	(*GetOutputSRTStatsRequest)(nil),             // 15: ffstream_grpc.GetOutputSRTStatsRequest
-	(*GetOutputSRTStatsReply)(nil),               // 16: ffstream_grpc.GetOutputSRTStatsReply
-	(*GetSRTFlagIntRequest)(nil),                 // 17: ffstream_grpc.GetSRTFlagIntRequest
-	(*GetSRTFlagIntReply)(nil),                   // 18: ffstream_grpc.GetSRTFlagIntReply
-	(*SetSRTFlagIntRequest)(nil),                 // 19: ffstream_grpc.SetSRTFlagIntRequest
-	(*SetSRTFlagIntReply)(nil),                   // 20: ffstream_grpc.SetSRTFlagIntReply
-	(*WaitRequest)(nil),                          // 21: ffstream_grpc.WaitRequest
-	(*WaitReply)(nil),                            // 22: ffstream_grpc.WaitReply
-	(*EndRequest)(nil),                           // 23: ffstream_grpc.EndRequest
-	(*EndReply)(nil),                             // 24: ffstream_grpc.EndReply
-	(*GetPipelinesRequest)(nil),                  // 25: ffstream_grpc.GetPipelinesRequest
-	(*GetPipelinesResponse)(nil),                 // 26: ffstream_grpc.GetPipelinesResponse
-	(*GetVideoAutoBitRateConfigRequest)(nil),     // 27: ffstream_grpc.GetVideoAutoBitRateConfigRequest
-	(*GetVideoAutoBitRateConfigReply)(nil),       // 28: ffstream_grpc.GetVideoAutoBitRateConfigReply
-	(*SetVideoAutoBitRateConfigRequest)(nil),     // 29: ffstream_grpc.SetVideoAutoBitRateConfigRequest
-	(*SetVideoAutoBitRateConfigReply)(nil),       // 30: ffstream_grpc.SetVideoAutoBitRateConfigReply
-	(*GetVideoAutoBitRateCalculatorRequest)(nil), // 31: ffstream_grpc.GetVideoAutoBitRateCalculatorRequest
-	(*GetVideoAutoBitRateCalculatorReply)(nil),   // 32: ffstream_grpc.GetVideoAutoBitRateCalculatorReply
-	(*SetVideoAutoBitRateCalculatorRequest)(nil), // 33: ffstream_grpc.SetVideoAutoBitRateCalculatorRequest
-	(*SetVideoAutoBitRateCalculatorReply)(nil),   // 34: ffstream_grpc.SetVideoAutoBitRateCalculatorReply
-	(*GetFPSFractionRequest)(nil),                // 35: ffstream_grpc.GetFPSFractionRequest
-	(*GetFPSFractionReply)(nil),                  // 36: ffstream_grpc.GetFPSFractionReply
-	(*SetFPSFractionRequest)(nil),                // 37: ffstream_grpc.SetFPSFractionRequest
-	(*SetFPSFractionReply)(nil),                  // 38: ffstream_grpc.SetFPSFractionReply
-	(*BitRateInfo)(nil),                          // 39: ffstream_grpc.BitRateInfo
-	(*BitRates)(nil),                             // 40: ffstream_grpc.BitRates
-	(*GetBitRatesRequest)(nil),                   // 41: ffstream_grpc.GetBitRatesRequest
-	(*GetBitRatesReply)(nil),                     // 42: ffstream_grpc.GetBitRatesReply
-	(*GetLatenciesRequest)(nil),                  // 43: ffstream_grpc.GetLatenciesRequest
-	(*GetLatenciesReply)(nil),                    // 44: ffstream_grpc.GetLatenciesReply
-	(*Latencies)(nil),                            // 45: ffstream_grpc.Latencies
-	(*TrackLatencies)(nil),                       // 46: ffstream_grpc.TrackLatencies
-	(*GetInputQualityRequest)(nil),               // 47: ffstream_grpc.GetInputQualityRequest
-	(*GetInputQualityReply)(nil),                 // 48: ffstream_grpc.GetInputQualityReply
-	(*StreamQuality)(nil),                        // 49: ffstream_grpc.StreamQuality
-	(*GetOutputQualityRequest)(nil),              // 50: ffstream_grpc.GetOutputQualityRequest
-	(*GetOutputQualityReply)(nil),                // 51: ffstream_grpc.GetOutputQualityReply
-	(*GetInputsInfoRequest)(nil),                 // 52: ffstream_grpc.GetInputsInfoRequest
-	(*GetInputsInfoReply)(nil),                   // 53: ffstream_grpc.GetInputsInfoReply
-	(*InputInfo)(nil),                            // 54: ffstream_grpc.InputInfo
-	(*SetInputCustomOptionRequest)(nil),          // 55: ffstream_grpc.SetInputCustomOptionRequest
-	(*SetInputCustomOptionReply)(nil),            // 56: ffstream_grpc.SetInputCustomOptionReply
-	(*SetStopInputRequest)(nil),                  // 57: ffstream_grpc.SetStopInputRequest
-	(*SetStopInputReply)(nil),                    // 58: ffstream_grpc.SetStopInputReply
-	(*avpipeline.CustomOption)(nil),              // 59: avpipeline.CustomOption
-	(*avpipeline.NodeCounters)(nil),              // 60: avpipeline.NodeCounters
-	(*avpipeline.Node)(nil),                      // 61: avpipeline.Node
-	(*avpipeline.AutoBitRateVideoConfig)(nil),    // 62: avpipeline.AutoBitRateVideoConfig
-	(*avpipeline.AutoBitrateCalculator)(nil),     // 63: avpipeline.AutoBitrateCalculator
-	(*avpipeline.InputConfig)(nil),               // 64: avpipeline.InputConfig
-	(*avpipeline.MonitorRequest)(nil),            // 65: avpipeline.MonitorRequest
-	(*avpipeline.MonitorEvent)(nil),              // 66: avpipeline.MonitorEvent
+	(FilterGraphKind)(0),                         // 2: ffstream_grpc.FilterGraphKind
+	(OutputState)(0),                             // 3: ffstream_grpc.OutputState
+	(RetryTarget)(0),                             // 4: ffstream_grpc.RetryTarget
+	(RetryExhaustedAction)(0),                    // 5: ffstream_grpc.RetryExhaustedAction
+	(StreamState)(0),                             // 6: ffstream_grpc.StreamState
+	(*SetLoggingLevelRequest)(nil),               // 7: ffstream_grpc.SetLoggingLevelRequest
+	(*SetLoggingLevelReply)(nil),                 // 8: ffstream_grpc.SetLoggingLevelReply
+	(*RemoveOutputRequest)(nil),                  // 9: ffstream_grpc.RemoveOutputRequest
+	(*RemoveOutputReply)(nil),                    // 10: ffstream_grpc.RemoveOutputReply
+	(*AudioCodecConfig)(nil),                     // 11: ffstream_grpc.AudioCodecConfig
+	(*VideoCodecConfig)(nil),                     // 12: ffstream_grpc.VideoCodecConfig
+	(*TranscoderConfig)(nil),                     // 13: ffstream_grpc.TranscoderConfig
+	(*GetCurrentOutputRequest)(nil),              // 14: ffstream_grpc.GetCurrentOutputRequest
+	(*GetCurrentOutputReply)(nil),                // 15: ffstream_grpc.GetCurrentOutputReply
+	(*SwitchOutputByPropsRequest)(nil),           // 16: ffstream_grpc.SwitchOutputByPropsRequest
+	(*SwitchOutputByPropsReply)(nil),             // 17: ffstream_grpc.SwitchOutputByPropsReply
+	(*GetStatsRequest)(nil),                      // 18: ffstream_grpc.GetStatsRequest
+	(*GetStatsReply)(nil),                        // 19: ffstream_grpc.GetStatsReply
+	(*GetOutputSRTStatsRequest)(nil),             // 20: ffstream_grpc.GetOutputSRTStatsRequest
+	(*GetOutputSRTStatsReply)(nil),               // 21: ffstream_grpc.GetOutputSRTStatsReply
+	(*GetSRTFlagIntRequest)(nil),                 // 22: ffstream_grpc.GetSRTFlagIntRequest
+	(*GetSRTFlagIntReply)(nil),                   // 23: ffstream_grpc.GetSRTFlagIntReply
+	(*SetSRTFlagIntRequest)(nil),                 // 24: ffstream_grpc.SetSRTFlagIntRequest
+	(*SetSRTFlagIntReply)(nil),                   // 25: ffstream_grpc.SetSRTFlagIntReply
+	(*WaitRequest)(nil),                          // 26: ffstream_grpc.WaitRequest
+	(*WaitReply)(nil),                            // 27: ffstream_grpc.WaitReply
+	(*EndRequest)(nil),                           // 28: ffstream_grpc.EndRequest
+	(*EndReply)(nil),                             // 29: ffstream_grpc.EndReply
+	(*GetPipelinesRequest)(nil),                  // 30: ffstream_grpc.GetPipelinesRequest
+	(*GetPipelinesResponse)(nil),                 // 31: ffstream_grpc.GetPipelinesResponse
+	(*GetVideoAutoBitRateConfigRequest)(nil),     // 32: ffstream_grpc.GetVideoAutoBitRateConfigRequest
+	(*GetVideoAutoBitRateConfigReply)(nil),       // 33: ffstream_grpc.GetVideoAutoBitRateConfigReply
+	(*SetVideoAutoBitRateConfigRequest)(nil),     // 34: ffstream_grpc.SetVideoAutoBitRateConfigRequest
+	(*SetVideoAutoBitRateConfigReply)(nil),       // 35: ffstream_grpc.SetVideoAutoBitRateConfigReply
+	(*GetVideoAutoBitRateCalculatorRequest)(nil), // 36: ffstream_grpc.GetVideoAutoBitRateCalculatorRequest
+	(*GetVideoAutoBitRateCalculatorReply)(nil),   // 37: ffstream_grpc.GetVideoAutoBitRateCalculatorReply
+	(*SetVideoAutoBitRateCalculatorRequest)(nil), // 38: ffstream_grpc.SetVideoAutoBitRateCalculatorRequest
+	(*SetVideoAutoBitRateCalculatorReply)(nil),   // 39: ffstream_grpc.SetVideoAutoBitRateCalculatorReply
+	(*GetFPSFractionRequest)(nil),                // 40: ffstream_grpc.GetFPSFractionRequest
+	(*GetFPSFractionReply)(nil),                  // 41: ffstream_grpc.GetFPSFractionReply
+	(*SetFPSFractionRequest)(nil),                // 42: ffstream_grpc.SetFPSFractionRequest
+	(*SetFPSFractionReply)(nil),                  // 43: ffstream_grpc.SetFPSFractionReply
+	(*BitRateInfo)(nil),                          // 44: ffstream_grpc.BitRateInfo
+	(*BitRates)(nil),                             // 45: ffstream_grpc.BitRates
+	(*GetBitRatesRequest)(nil),                   // 46: ffstream_grpc.GetBitRatesRequest
+	(*GetBitRatesReply)(nil),                     // 47: ffstream_grpc.GetBitRatesReply
+	(*GetLatenciesRequest)(nil),                  // 48: ffstream_grpc.GetLatenciesRequest
+	(*GetLatenciesReply)(nil),                    // 49: ffstream_grpc.GetLatenciesReply
+	(*Latencies)(nil),                            // 50: ffstream_grpc.Latencies
+	(*TrackLatencies)(nil),                       // 51: ffstream_grpc.TrackLatencies
+	(*GetInputQualityRequest)(nil),               // 52: ffstream_grpc.GetInputQualityRequest
+	(*GetInputQualityReply)(nil),                 // 53: ffstream_grpc.GetInputQualityReply
+	(*StreamQuality)(nil),                        // 54: ffstream_grpc.StreamQuality
+	(*GetOutputQualityRequest)(nil),              // 55: ffstream_grpc.GetOutputQualityRequest
+	(*GetOutputQualityReply)(nil),                // 56: ffstream_grpc.GetOutputQualityReply
+	(*GetInputsInfoRequest)(nil),                 // 57: ffstream_grpc.GetInputsInfoRequest
+	(*GetInputsInfoReply)(nil),                   // 58: ffstream_grpc.GetInputsInfoReply
+	(*InputInfo)(nil),                            // 59: ffstream_grpc.InputInfo
+	(*SetInputCustomOptionRequest)(nil),          // 60: ffstream_grpc.SetInputCustomOptionRequest
+	(*SetInputCustomOptionReply)(nil),            // 61: ffstream_grpc.SetInputCustomOptionReply
+	(*SetStopInputRequest)(nil),                  // 62: ffstream_grpc.SetStopInputRequest
+	(*SetStopInputReply)(nil),                    // 63: ffstream_grpc.SetStopInputReply
+	(*SetFilterGraphRequest)(nil),                // 64: ffstream_grpc.SetFilterGraphRequest
+	(*SetFilterGraphReply)(nil),                  // 65: ffstream_grpc.SetFilterGraphReply
+	(*SenderKey)(nil),                            // 66: ffstream_grpc.SenderKey
+	(*OutputInfo)(nil),                           // 67: ffstream_grpc.OutputInfo
+	(*OutputDestinationInfo)(nil),                // 68: ffstream_grpc.OutputDestinationInfo
+	(*ListOutputsRequest)(nil),                   // 69: ffstream_grpc.ListOutputsRequest
+	(*ListOutputsReply)(nil),                     // 70: ffstream_grpc.ListOutputsReply
+	(*CreateOutputRequest)(nil),                  // 71: ffstream_grpc.CreateOutputRequest
+	(*CreateOutputReply)(nil),                    // 72: ffstream_grpc.CreateOutputReply
+	(*AddInputRequest)(nil),                      // 73: ffstream_grpc.AddInputRequest
+	(*AddInputReply)(nil),                        // 74: ffstream_grpc.AddInputReply
+	(*RemoveInputRequest)(nil),                   // 75: ffstream_grpc.RemoveInputRequest
+	(*RemoveInputReply)(nil),                     // 76: ffstream_grpc.RemoveInputReply
+	(*ReplaceInputURLRequest)(nil),               // 77: ffstream_grpc.ReplaceInputURLRequest
+	(*ReplaceInputURLReply)(nil),                 // 78: ffstream_grpc.ReplaceInputURLReply
+	(*WatchOutputSRTStatsRequest)(nil),           // 79: ffstream_grpc.WatchOutputSRTStatsRequest
+	(*InputAddress)(nil),                         // 80: ffstream_grpc.InputAddress
+	(*GetInputSRTStatsRequest)(nil),              // 81: ffstream_grpc.GetInputSRTStatsRequest
+	(*GetInputSRTFlagIntRequest)(nil),            // 82: ffstream_grpc.GetInputSRTFlagIntRequest
+	(*SetInputSRTFlagIntRequest)(nil),            // 83: ffstream_grpc.SetInputSRTFlagIntRequest
+	(*WatchOutputSwitchesRequest)(nil),           // 84: ffstream_grpc.WatchOutputSwitchesRequest
+	(*OutputSwitchEvent)(nil),                    // 85: ffstream_grpc.OutputSwitchEvent
+	(*RetryPolicy)(nil),                          // 86: ffstream_grpc.RetryPolicy
+	(*RetryCounters)(nil),                        // 87: ffstream_grpc.RetryCounters
+	(*GetRetryPolicyRequest)(nil),                // 88: ffstream_grpc.GetRetryPolicyRequest
+	(*GetRetryPolicyReply)(nil),                  // 89: ffstream_grpc.GetRetryPolicyReply
+	(*SetRetryPolicyRequest)(nil),                // 90: ffstream_grpc.SetRetryPolicyRequest
+	(*SetRetryPolicyReply)(nil),                  // 91: ffstream_grpc.SetRetryPolicyReply
+	(*ApplyConfigRequest)(nil),                   // 92: ffstream_grpc.ApplyConfigRequest
+	(*ConfigChange)(nil),                         // 93: ffstream_grpc.ConfigChange
+	(*ApplyConfigReply)(nil),                     // 94: ffstream_grpc.ApplyConfigReply
+	(*SubscribeEventsRequest)(nil),               // 95: ffstream_grpc.SubscribeEventsRequest
+	(*InputActivatedEvent)(nil),                  // 96: ffstream_grpc.InputActivatedEvent
+	(*InputDeactivatedEvent)(nil),                // 97: ffstream_grpc.InputDeactivatedEvent
+	(*InputReconnectingEvent)(nil),               // 98: ffstream_grpc.InputReconnectingEvent
+	(*InputDemotedEvent)(nil),                    // 99: ffstream_grpc.InputDemotedEvent
+	(*InputPromotedEvent)(nil),                   // 100: ffstream_grpc.InputPromotedEvent
+	(*OutputCreatedEvent)(nil),                   // 101: ffstream_grpc.OutputCreatedEvent
+	(*OutputRemovedEvent)(nil),                   // 102: ffstream_grpc.OutputRemovedEvent
+	(*OutputReconnectingEvent)(nil),              // 103: ffstream_grpc.OutputReconnectingEvent
+	(*VideoEncoderChangedEvent)(nil),             // 104: ffstream_grpc.VideoEncoderChangedEvent
+	(*BypassChangedEvent)(nil),                   // 105: ffstream_grpc.BypassChangedEvent
+	(*FPSFractionChangedEvent)(nil),              // 106: ffstream_grpc.FPSFractionChangedEvent
+	(*RetriesExhaustedEvent)(nil),                // 107: ffstream_grpc.RetriesExhaustedEvent
+	(*ErrorEvent)(nil),                           // 108: ffstream_grpc.ErrorEvent
+	(*EndOfStreamEvent)(nil),                     // 109: ffstream_grpc.EndOfStreamEvent
+	(*Event)(nil),                                // 110: ffstream_grpc.Event
+	(*StreamInfo)(nil),                           // 111: ffstream_grpc.StreamInfo
+	(*CreateStreamRequest)(nil),                  // 112: ffstream_grpc.CreateStreamRequest
+	(*CreateStreamReply)(nil),                    // 113: ffstream_grpc.CreateStreamReply
+	(*StartStreamRequest)(nil),                   // 114: ffstream_grpc.StartStreamRequest
+	(*StartStreamReply)(nil),                     // 115: ffstream_grpc.StartStreamReply
+	(*StopStreamRequest)(nil),                    // 116: ffstream_grpc.StopStreamRequest
+	(*StopStreamReply)(nil),                      // 117: ffstream_grpc.StopStreamReply
+	(*DeleteStreamRequest)(nil),                  // 118: ffstream_grpc.DeleteStreamRequest
+	(*DeleteStreamReply)(nil),                    // 119: ffstream_grpc.DeleteStreamReply
+	(*ListStreamsRequest)(nil),                   // 120: ffstream_grpc.ListStreamsRequest
+	(*ListStreamsReply)(nil),                     // 121: ffstream_grpc.ListStreamsReply
+	(*avpipeline.CustomOption)(nil),              // 122: avpipeline.CustomOption
+	(*avpipeline.NodeCounters)(nil),              // 123: avpipeline.NodeCounters
+	(*avpipeline.Node)(nil),                      // 124: avpipeline.Node
+	(*avpipeline.AutoBitRateVideoConfig)(nil),    // 125: avpipeline.AutoBitRateVideoConfig
+	(*avpipeline.AutoBitrateCalculator)(nil),     // 126: avpipeline.AutoBitrateCalculator
+	(*avpipeline.InputConfig)(nil),               // 127: avpipeline.InputConfig
+	(*avpipeline.MonitorRequest)(nil),            // 128: avpipeline.MonitorRequest
+	(*avpipeline.MonitorEvent)(nil),              // 129: avpipeline.MonitorEvent
 }
 var file_ffstream_proto_depIdxs = []int32{
-	0,  // 0: ffstream_grpc.SetLoggingLevelRequest.level:type_name -> ffstream_grpc.LoggingLevel
-	59, // 1: ffstream_grpc.AudioCodecConfig.custom_options:type_name -> avpipeline.CustomOption
-	59, // 2: ffstream_grpc.VideoCodecConfig.custom_options:type_name -> avpipeline.CustomOption
-	6,  // 3: ffstream_grpc.TranscoderConfig.audio:type_name -> ffstream_grpc.AudioCodecConfig
-	7,  // 4: ffstream_grpc.TranscoderConfig.video:type_name -> ffstream_grpc.VideoCodecConfig
-	8,  // 5: ffstream_grpc.GetCurrentOutputReply.config:type_name -> ffstream_grpc.TranscoderConfig
-	8,  // 6: ffstream_grpc.SwitchOutputByPropsRequest.config:type_name -> ffstream_grpc.TranscoderConfig
-	60, // 7: ffstream_grpc.GetStatsReply.node_counters:type_name -> avpipeline.NodeCounters
-	1,  // 8: ffstream_grpc.GetSRTFlagIntRequest.flag:type_name -> ffstream_grpc.SRTFlagInt
-	1,  // 9: ffstream_grpc.SetSRTFlagIntRequest.flag:type_name -> ffstream_grpc.SRTFlagInt
-	61, // 10: ffstream_grpc.GetPipelinesResponse.nodes:type_name -> avpipeline.Node
-	62, // 11: ffstream_grpc.GetVideoAutoBitRateConfigReply.config:type_name -> avpipeline.AutoBitRateVideoConfig
-	62, // 12: ffstream_grpc.SetVideoAutoBitRateConfigRequest.config:type_name -> avpipeline.AutoBitRateVideoConfig
-	63, // 13: ffstream_grpc.GetVideoAutoBitRateCalculatorReply.calculator:type_name -> avpipeline.AutoBitrateCalculator
-	63, // 14: ffstream_grpc.SetVideoAutoBitRateCalculatorRequest.calculator:type_name -> avpipeline.AutoBitrateCalculator
-	39, // 15: ffstream_grpc.BitRates.input_bit_rate:type_name -> ffstream_grpc.BitRateInfo
-	39, // 16: ffstream_grpc.BitRates.encoded_bit_rate:type_name -> ffstream_grpc.BitRateInfo
-	39, // 17: ffstream_grpc.BitRates.output_bit_rate:type_name -> ffstream_grpc.BitRateInfo
-	40, // 18: ffstream_grpc.GetBitRatesReply.bit_rates:type_name -> ffstream_grpc.BitRates
-	45, // 19: ffstream_grpc.GetLatenciesReply.latencies:type_name -> ffstream_grpc.Latencies
-	46, // 20: ffstream_grpc.Latencies.audio:type_name -> ffstream_grpc.TrackLatencies
-	46, // 21: ffstream_grpc.Latencies.video:type_name -> ffstream_grpc.TrackLatencies
-	49, // 22: ffstream_grpc.GetInputQualityReply.audio:type_name -> ffstream_grpc.StreamQuality
-	49, // 23: ffstream_grpc.GetInputQualityReply.video:type_name -> ffstream_grpc.StreamQuality
-	49, // 24: ffstream_grpc.GetOutputQualityReply.audio:type_name -> ffstream_grpc.StreamQuality
-	49, // 25: ffstream_grpc.GetOutputQualityReply.video:type_name -> ffstream_grpc.StreamQuality
-	54, // 26: ffstream_grpc.GetInputsInfoReply.inputs:type_name -> ffstream_grpc.InputInfo
-	64, // 27: ffstream_grpc.InputInfo.input_config:type_name -> avpipeline.InputConfig
-	2,  // 28: ffstream_grpc.FFStream.SetLoggingLevel:input_type -> ffstream_grpc.SetLoggingLevelRequest
-	4,  // 29: ffstream_grpc.FFStream.RemoveOutput:input_type -> ffstream_grpc.RemoveOutputRequest
-	9,  // 30: ffstream_grpc.FFStream.GetCurrentOutput:input_type -> ffstream_grpc.GetCurrentOutputRequest
-	11, // 31: ffstream_grpc.FFStream.SwitchOutputByProps:input_type -> ffstream_grpc.SwitchOutputByPropsRequest
-	13, // 32: ffstream_grpc.FFStream.GetStats:input_type -> ffstream_grpc.GetStatsRequest
-	15, // 33: ffstream_grpc.FFStream.GetOutputSRTStats:input_type -> ffstream_grpc.GetOutputSRTStatsRequest
-	17, // 34: ffstream_grpc.FFStream.GetSRTFlagInt:input_type -> ffstream_grpc.GetSRTFlagIntRequest
-	19, // 35: ffstream_grpc.FFStream.SetSRTFlagInt:input_type -> ffstream_grpc.SetSRTFlagIntRequest
-	21, // 36: ffstream_grpc.FFStream.WaitChan:input_type -> ffstream_grpc.WaitRequest
-	23, // 37: ffstream_grpc.FFStream.End:input_type -> ffstream_grpc.EndRequest
-	25, // 38: ffstream_grpc.FFStream.GetPipelines:input_type -> ffstream_grpc.GetPipelinesRequest
-	27, // 39: ffstream_grpc.FFStream.GetVideoAutoBitRateConfig:input_type -> ffstream_grpc.GetVideoAutoBitRateConfigRequest
-	29, // 40: ffstream_grpc.FFStream.SetVideoAutoBitRateConfig:input_type -> ffstream_grpc.SetVideoAutoBitRateConfigRequest
-	31, // 41: ffstream_grpc.FFStream.GetVideoAutoBitRateCalculator:input_type -> ffstream_grpc.GetVideoAutoBitRateCalculatorRequest
-	33, // 42: ffstream_grpc.FFStream.SetVideoAutoBitRateCalculator:input_type -> ffstream_grpc.SetVideoAutoBitRateCalculatorRequest
-	35, // 43: ffstream_grpc.FFStream.GetFPSFraction:input_type -> ffstream_grpc.GetFPSFractionRequest
-	37, // 44: ffstream_grpc.FFStream.SetFPSFraction:input_type -> ffstream_grpc.SetFPSFractionRequest
-	41, // 45: ffstream_grpc.FFStream.GetBitRates:input_type -> ffstream_grpc.GetBitRatesRequest
-	43, // 46: ffstream_grpc.FFStream.GetLatencies:input_type -> ffstream_grpc.GetLatenciesRequest
-	47, // 47: ffstream_grpc.FFStream.GetInputQuality:input_type -> ffstream_grpc.GetInputQualityRequest
-	50, // 48: ffstream_grpc.FFStream.GetOutputQuality:input_type -> ffstream_grpc.GetOutputQualityRequest
-	65, // 49: ffstream_grpc.FFStream.Monitor:input_type -> avpipeline.MonitorRequest
-	52, // 50: ffstream_grpc.FFStream.GetInputsInfo:input_type -> ffstream_grpc.GetInputsInfoRequest
-	55, // 51: ffstream_grpc.FFStream.SetInputCustomOption:input_type -> ffstream_grpc.SetInputCustomOptionRequest
-	57, // 52: ffstream_grpc.FFStream.SetStopInput:input_type -> ffstream_grpc.SetStopInputRequest
-	3,  // 53: ffstream_grpc.FFStream.SetLoggingLevel:output_type -> ffstream_grpc.SetLoggingLevelReply
-	5,  // 54: ffstream_grpc.FFStream.RemoveOutput:output_type -> ffstream_grpc.RemoveOutputReply
-	10, // 55: ffstream_grpc.FFStream.GetCurrentOutput:output_type -> ffstream_grpc.GetCurrentOutputReply
-	12, // 56: ffstream_grpc.FFStream.SwitchOutputByProps:output_type -> ffstream_grpc.SwitchOutputByPropsReply
-	14, // 57: ffstream_grpc.FFStream.GetStats:output_type -> ffstream_grpc.GetStatsReply
-	16, // 58: ffstream_grpc.FFStream.GetOutputSRTStats:output_type -> ffstream_grpc.GetOutputSRTStatsReply
-	18, // 59: ffstream_grpc.FFStream.GetSRTFlagInt:output_type -> ffstream_grpc.GetSRTFlagIntReply
-	20, // 60: ffstream_grpc.FFStream.SetSRTFlagInt:output_type -> ffstream_grpc.SetSRTFlagIntReply
-	22, // 61: ffstream_grpc.FFStream.WaitChan:output_type -> ffstream_grpc.WaitReply
-	24, // 62: ffstream_grpc.FFStream.End:output_type -> ffstream_grpc.EndReply
-	26, // 63: ffstream_grpc.FFStream.GetPipelines:output_type -> ffstream_grpc.GetPipelinesResponse
-	28, // 64: ffstream_grpc.FFStream.GetVideoAutoBitRateConfig:output_type -> ffstream_grpc.GetVideoAutoBitRateConfigReply
-	30, // 65: ffstream_grpc.FFStream.SetVideoAutoBitRateConfig:output_type -> ffstream_grpc.SetVideoAutoBitRateConfigReply
-	32, // 66: ffstream_grpc.FFStream.GetVideoAutoBitRateCalculator:output_type -> ffstream_grpc.GetVideoAutoBitRateCalculatorReply
-	34, // 67: ffstream_grpc.FFStream.SetVideoAutoBitRateCalculator:output_type -> ffstream_grpc.SetVideoAutoBitRateCalculatorReply
-	36, // 68: ffstream_grpc.FFStream.GetFPSFraction:output_type -> ffstream_grpc.GetFPSFractionReply
-	38, // 69: ffstream_grpc.FFStream.SetFPSFraction:output_type -> ffstream_grpc.SetFPSFractionReply
-	42, // 70: ffstream_grpc.FFStream.GetBitRates:output_type -> ffstream_grpc.GetBitRatesReply
-	44, // 71: ffstream_grpc.FFStream.GetLatencies:output_type -> ffstream_grpc.GetLatenciesReply
-	48, // 72: ffstream_grpc.FFStream.GetInputQuality:output_type -> ffstream_grpc.GetInputQualityReply
-	51, // 73: ffstream_grpc.FFStream.GetOutputQuality:output_type -> ffstream_grpc.GetOutputQualityReply
-	66, // 74: ffstream_grpc.FFStream.Monitor:output_type -> avpipeline.MonitorEvent
-	53, // 75: ffstream_grpc.FFStream.GetInputsInfo:output_type -> ffstream_grpc.GetInputsInfoReply
-	56, // 76: ffstream_grpc.FFStream.SetInputCustomOption:output_type -> ffstream_grpc.SetInputCustomOptionReply
-	58, // 77: ffstream_grpc.FFStream.SetStopInput:output_type -> ffstream_grpc.SetStopInputReply
-	53, // [53:78] is the sub-list for method output_type
-	28, // [28:53] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	0,   // 0: ffstream_grpc.SetLoggingLevelRequest.level:type_name -> ffstream_grpc.LoggingLevel
+	122, // 1: ffstream_grpc.AudioCodecConfig.custom_options:type_name -> avpipeline.CustomOption
+	122, // 2: ffstream_grpc.VideoCodecConfig.custom_options:type_name -> avpipeline.CustomOption
+	11,  // 3: ffstream_grpc.TranscoderConfig.audio:type_name -> ffstream_grpc.AudioCodecConfig
+	12,  // 4: ffstream_grpc.TranscoderConfig.video:type_name -> ffstream_grpc.VideoCodecConfig
+	13,  // 5: ffstream_grpc.GetCurrentOutputReply.config:type_name -> ffstream_grpc.TranscoderConfig
+	13,  // 6: ffstream_grpc.SwitchOutputByPropsRequest.config:type_name -> ffstream_grpc.TranscoderConfig
+	123, // 7: ffstream_grpc.GetStatsReply.node_counters:type_name -> avpipeline.NodeCounters
+	1,   // 8: ffstream_grpc.GetSRTFlagIntRequest.flag:type_name -> ffstream_grpc.SRTFlagInt
+	1,   // 9: ffstream_grpc.SetSRTFlagIntRequest.flag:type_name -> ffstream_grpc.SRTFlagInt
+	124, // 10: ffstream_grpc.GetPipelinesResponse.nodes:type_name -> avpipeline.Node
+	125, // 11: ffstream_grpc.GetVideoAutoBitRateConfigReply.config:type_name -> avpipeline.AutoBitRateVideoConfig
+	125, // 12: ffstream_grpc.SetVideoAutoBitRateConfigRequest.config:type_name -> avpipeline.AutoBitRateVideoConfig
+	126, // 13: ffstream_grpc.GetVideoAutoBitRateCalculatorReply.calculator:type_name -> avpipeline.AutoBitrateCalculator
+	126, // 14: ffstream_grpc.SetVideoAutoBitRateCalculatorRequest.calculator:type_name -> avpipeline.AutoBitrateCalculator
+	44,  // 15: ffstream_grpc.BitRates.input_bit_rate:type_name -> ffstream_grpc.BitRateInfo
+	44,  // 16: ffstream_grpc.BitRates.encoded_bit_rate:type_name -> ffstream_grpc.BitRateInfo
+	44,  // 17: ffstream_grpc.BitRates.output_bit_rate:type_name -> ffstream_grpc.BitRateInfo
+	45,  // 18: ffstream_grpc.GetBitRatesReply.bit_rates:type_name -> ffstream_grpc.BitRates
+	50,  // 19: ffstream_grpc.GetLatenciesReply.latencies:type_name -> ffstream_grpc.Latencies
+	51,  // 20: ffstream_grpc.Latencies.audio:type_name -> ffstream_grpc.TrackLatencies
+	51,  // 21: ffstream_grpc.Latencies.video:type_name -> ffstream_grpc.TrackLatencies
+	54,  // 22: ffstream_grpc.GetInputQualityReply.audio:type_name -> ffstream_grpc.StreamQuality
+	54,  // 23: ffstream_grpc.GetInputQualityReply.video:type_name -> ffstream_grpc.StreamQuality
+	54,  // 24: ffstream_grpc.GetOutputQualityReply.audio:type_name -> ffstream_grpc.StreamQuality
+	54,  // 25: ffstream_grpc.GetOutputQualityReply.video:type_name -> ffstream_grpc.StreamQuality
+	59,  // 26: ffstream_grpc.GetInputsInfoReply.inputs:type_name -> ffstream_grpc.InputInfo
+	127, // 27: ffstream_grpc.InputInfo.input_config:type_name -> avpipeline.InputConfig
+	2,   // 28: ffstream_grpc.SetFilterGraphRequest.kind:type_name -> ffstream_grpc.FilterGraphKind
+	66,  // 29: ffstream_grpc.OutputInfo.sender_key:type_name -> ffstream_grpc.SenderKey
+	3,   // 30: ffstream_grpc.OutputInfo.state:type_name -> ffstream_grpc.OutputState
+	123, // 31: ffstream_grpc.OutputInfo.counters:type_name -> avpipeline.NodeCounters
+	68,  // 32: ffstream_grpc.OutputInfo.destinations:type_name -> ffstream_grpc.OutputDestinationInfo
+	123, // 33: ffstream_grpc.OutputDestinationInfo.counters:type_name -> avpipeline.NodeCounters
+	67,  // 34: ffstream_grpc.ListOutputsReply.outputs:type_name -> ffstream_grpc.OutputInfo
+	66,  // 35: ffstream_grpc.CreateOutputRequest.sender_key:type_name -> ffstream_grpc.SenderKey
+	122, // 36: ffstream_grpc.AddInputRequest.custom_options:type_name -> avpipeline.CustomOption
+	80,  // 37: ffstream_grpc.GetInputSRTStatsRequest.input:type_name -> ffstream_grpc.InputAddress
+	80,  // 38: ffstream_grpc.GetInputSRTFlagIntRequest.input:type_name -> ffstream_grpc.InputAddress
+	1,   // 39: ffstream_grpc.GetInputSRTFlagIntRequest.flag:type_name -> ffstream_grpc.SRTFlagInt
+	80,  // 40: ffstream_grpc.SetInputSRTFlagIntRequest.input:type_name -> ffstream_grpc.InputAddress
+	1,   // 41: ffstream_grpc.SetInputSRTFlagIntRequest.flag:type_name -> ffstream_grpc.SRTFlagInt
+	66,  // 42: ffstream_grpc.OutputSwitchEvent.sender_key:type_name -> ffstream_grpc.SenderKey
+	5,   // 43: ffstream_grpc.RetryPolicy.on_exhausted:type_name -> ffstream_grpc.RetryExhaustedAction
+	4,   // 44: ffstream_grpc.GetRetryPolicyRequest.target:type_name -> ffstream_grpc.RetryTarget
+	86,  // 45: ffstream_grpc.GetRetryPolicyReply.policy:type_name -> ffstream_grpc.RetryPolicy
+	87,  // 46: ffstream_grpc.GetRetryPolicyReply.counters:type_name -> ffstream_grpc.RetryCounters
+	4,   // 47: ffstream_grpc.SetRetryPolicyRequest.target:type_name -> ffstream_grpc.RetryTarget
+	86,  // 48: ffstream_grpc.SetRetryPolicyRequest.policy:type_name -> ffstream_grpc.RetryPolicy
+	93,  // 49: ffstream_grpc.ApplyConfigReply.changes:type_name -> ffstream_grpc.ConfigChange
+	66,  // 50: ffstream_grpc.OutputCreatedEvent.sender_key:type_name -> ffstream_grpc.SenderKey
+	66,  // 51: ffstream_grpc.OutputRemovedEvent.sender_key:type_name -> ffstream_grpc.SenderKey
+	66,  // 52: ffstream_grpc.OutputReconnectingEvent.sender_key:type_name -> ffstream_grpc.SenderKey
+	4,   // 53: ffstream_grpc.RetriesExhaustedEvent.target:type_name -> ffstream_grpc.RetryTarget
+	5,   // 54: ffstream_grpc.RetriesExhaustedEvent.on_exhausted:type_name -> ffstream_grpc.RetryExhaustedAction
+	96,  // 55: ffstream_grpc.Event.input_activated:type_name -> ffstream_grpc.InputActivatedEvent
+	97,  // 56: ffstream_grpc.Event.input_deactivated:type_name -> ffstream_grpc.InputDeactivatedEvent
+	98,  // 57: ffstream_grpc.Event.input_reconnecting:type_name -> ffstream_grpc.InputReconnectingEvent
+	101, // 58: ffstream_grpc.Event.output_created:type_name -> ffstream_grpc.OutputCreatedEvent
+	102, // 59: ffstream_grpc.Event.output_removed:type_name -> ffstream_grpc.OutputRemovedEvent
+	103, // 60: ffstream_grpc.Event.output_reconnecting:type_name -> ffstream_grpc.OutputReconnectingEvent
+	85,  // 61: ffstream_grpc.Event.output_switch:type_name -> ffstream_grpc.OutputSwitchEvent
+	104, // 62: ffstream_grpc.Event.video_encoder_changed:type_name -> ffstream_grpc.VideoEncoderChangedEvent
+	105, // 63: ffstream_grpc.Event.bypass_changed:type_name -> ffstream_grpc.BypassChangedEvent
+	106, // 64: ffstream_grpc.Event.fps_fraction_changed:type_name -> ffstream_grpc.FPSFractionChangedEvent
+	108, // 65: ffstream_grpc.Event.error:type_name -> ffstream_grpc.ErrorEvent
+	109, // 66: ffstream_grpc.Event.end_of_stream:type_name -> ffstream_grpc.EndOfStreamEvent
+	107, // 67: ffstream_grpc.Event.retries_exhausted:type_name -> ffstream_grpc.RetriesExhaustedEvent
+	99,  // 68: ffstream_grpc.Event.input_demoted:type_name -> ffstream_grpc.InputDemotedEvent
+	100, // 69: ffstream_grpc.Event.input_promoted:type_name -> ffstream_grpc.InputPromotedEvent
+	6,   // 70: ffstream_grpc.StreamInfo.state:type_name -> ffstream_grpc.StreamState
+	111, // 71: ffstream_grpc.CreateStreamReply.stream:type_name -> ffstream_grpc.StreamInfo
+	111, // 72: ffstream_grpc.ListStreamsReply.streams:type_name -> ffstream_grpc.StreamInfo
+	7,   // 73: ffstream_grpc.FFStream.SetLoggingLevel:input_type -> ffstream_grpc.SetLoggingLevelRequest
+	9,   // 74: ffstream_grpc.FFStream.RemoveOutput:input_type -> ffstream_grpc.RemoveOutputRequest
+	14,  // 75: ffstream_grpc.FFStream.GetCurrentOutput:input_type -> ffstream_grpc.GetCurrentOutputRequest
+	16,  // 76: ffstream_grpc.FFStream.SwitchOutputByProps:input_type -> ffstream_grpc.SwitchOutputByPropsRequest
+	18,  // 77: ffstream_grpc.FFStream.GetStats:input_type -> ffstream_grpc.GetStatsRequest
+	20,  // 78: ffstream_grpc.FFStream.GetOutputSRTStats:input_type -> ffstream_grpc.GetOutputSRTStatsRequest
+	22,  // 79: ffstream_grpc.FFStream.GetSRTFlagInt:input_type -> ffstream_grpc.GetSRTFlagIntRequest
+	24,  // 80: ffstream_grpc.FFStream.SetSRTFlagInt:input_type -> ffstream_grpc.SetSRTFlagIntRequest
+	26,  // 81: ffstream_grpc.FFStream.WaitChan:input_type -> ffstream_grpc.WaitRequest
+	28,  // 82: ffstream_grpc.FFStream.End:input_type -> ffstream_grpc.EndRequest
+	30,  // 83: ffstream_grpc.FFStream.GetPipelines:input_type -> ffstream_grpc.GetPipelinesRequest
+	32,  // 84: ffstream_grpc.FFStream.GetVideoAutoBitRateConfig:input_type -> ffstream_grpc.GetVideoAutoBitRateConfigRequest
+	34,  // 85: ffstream_grpc.FFStream.SetVideoAutoBitRateConfig:input_type -> ffstream_grpc.SetVideoAutoBitRateConfigRequest
+	36,  // 86: ffstream_grpc.FFStream.GetVideoAutoBitRateCalculator:input_type -> ffstream_grpc.GetVideoAutoBitRateCalculatorRequest
+	38,  // 87: ffstream_grpc.FFStream.SetVideoAutoBitRateCalculator:input_type -> ffstream_grpc.SetVideoAutoBitRateCalculatorRequest
+	40,  // 88: ffstream_grpc.FFStream.GetFPSFraction:input_type -> ffstream_grpc.GetFPSFractionRequest
+	42,  // 89: ffstream_grpc.FFStream.SetFPSFraction:input_type -> ffstream_grpc.SetFPSFractionRequest
+	46,  // 90: ffstream_grpc.FFStream.GetBitRates:input_type -> ffstream_grpc.GetBitRatesRequest
+	48,  // 91: ffstream_grpc.FFStream.GetLatencies:input_type -> ffstream_grpc.GetLatenciesRequest
+	52,  // 92: ffstream_grpc.FFStream.GetInputQuality:input_type -> ffstream_grpc.GetInputQualityRequest
+	55,  // 93: ffstream_grpc.FFStream.GetOutputQuality:input_type -> ffstream_grpc.GetOutputQualityRequest
+	128, // 94: ffstream_grpc.FFStream.Monitor:input_type -> avpipeline.MonitorRequest
+	57,  // 95: ffstream_grpc.FFStream.GetInputsInfo:input_type -> ffstream_grpc.GetInputsInfoRequest
+	60,  // 96: ffstream_grpc.FFStream.SetInputCustomOption:input_type -> ffstream_grpc.SetInputCustomOptionRequest
+	62,  // 97: ffstream_grpc.FFStream.SetStopInput:input_type -> ffstream_grpc.SetStopInputRequest
+	64,  // 98: ffstream_grpc.FFStream.SetFilterGraph:input_type -> ffstream_grpc.SetFilterGraphRequest
+	69,  // 99: ffstream_grpc.FFStream.ListOutputs:input_type -> ffstream_grpc.ListOutputsRequest
+	71,  // 100: ffstream_grpc.FFStream.CreateOutput:input_type -> ffstream_grpc.CreateOutputRequest
+	73,  // 101: ffstream_grpc.FFStream.AddInput:input_type -> ffstream_grpc.AddInputRequest
+	75,  // 102: ffstream_grpc.FFStream.RemoveInput:input_type -> ffstream_grpc.RemoveInputRequest
+	77,  // 103: ffstream_grpc.FFStream.ReplaceInputURL:input_type -> ffstream_grpc.ReplaceInputURLRequest
+	79,  // 104: ffstream_grpc.FFStream.WatchOutputSRTStats:input_type -> ffstream_grpc.WatchOutputSRTStatsRequest
+	81,  // 105: ffstream_grpc.FFStream.GetInputSRTStats:input_type -> ffstream_grpc.GetInputSRTStatsRequest
+	82,  // 106: ffstream_grpc.FFStream.GetInputSRTFlagInt:input_type -> ffstream_grpc.GetInputSRTFlagIntRequest
+	83,  // 107: ffstream_grpc.FFStream.SetInputSRTFlagInt:input_type -> ffstream_grpc.SetInputSRTFlagIntRequest
+	84,  // 108: ffstream_grpc.FFStream.WatchOutputSwitches:input_type -> ffstream_grpc.WatchOutputSwitchesRequest
+	88,  // 109: ffstream_grpc.FFStream.GetRetryPolicy:input_type -> ffstream_grpc.GetRetryPolicyRequest
+	90,  // 110: ffstream_grpc.FFStream.SetRetryPolicy:input_type -> ffstream_grpc.SetRetryPolicyRequest
+	92,  // 111: ffstream_grpc.FFStream.ApplyConfig:input_type -> ffstream_grpc.ApplyConfigRequest
+	95,  // 112: ffstream_grpc.FFStream.SubscribeEvents:input_type -> ffstream_grpc.SubscribeEventsRequest
+	112, // 113: ffstream_grpc.FFStreamDaemon.CreateStream:input_type -> ffstream_grpc.CreateStreamRequest
+	114, // 114: ffstream_grpc.FFStreamDaemon.StartStream:input_type -> ffstream_grpc.StartStreamRequest
+	116, // 115: ffstream_grpc.FFStreamDaemon.StopStream:input_type -> ffstream_grpc.StopStreamRequest
+	118, // 116: ffstream_grpc.FFStreamDaemon.DeleteStream:input_type -> ffstream_grpc.DeleteStreamRequest
+	120, // 117: ffstream_grpc.FFStreamDaemon.ListStreams:input_type -> ffstream_grpc.ListStreamsRequest
+	7,   // 118: ffstream_grpc.FFStreamDaemon.SetDaemonLoggingLevel:input_type -> ffstream_grpc.SetLoggingLevelRequest
+	8,   // 119: ffstream_grpc.FFStream.SetLoggingLevel:output_type -> ffstream_grpc.SetLoggingLevelReply
+	10,  // 120: ffstream_grpc.FFStream.RemoveOutput:output_type -> ffstream_grpc.RemoveOutputReply
+	15,  // 121: ffstream_grpc.FFStream.GetCurrentOutput:output_type -> ffstream_grpc.GetCurrentOutputReply
+	17,  // 122: ffstream_grpc.FFStream.SwitchOutputByProps:output_type -> ffstream_grpc.SwitchOutputByPropsReply
+	19,  // 123: ffstream_grpc.FFStream.GetStats:output_type -> ffstream_grpc.GetStatsReply
+	21,  // 124: ffstream_grpc.FFStream.GetOutputSRTStats:output_type -> ffstream_grpc.GetOutputSRTStatsReply
+	23,  // 125: ffstream_grpc.FFStream.GetSRTFlagInt:output_type -> ffstream_grpc.GetSRTFlagIntReply
+	25,  // 126: ffstream_grpc.FFStream.SetSRTFlagInt:output_type -> ffstream_grpc.SetSRTFlagIntReply
+	27,  // 127: ffstream_grpc.FFStream.WaitChan:output_type -> ffstream_grpc.WaitReply
+	29,  // 128: ffstream_grpc.FFStream.End:output_type -> ffstream_grpc.EndReply
+	31,  // 129: ffstream_grpc.FFStream.GetPipelines:output_type -> ffstream_grpc.GetPipelinesResponse
+	33,  // 130: ffstream_grpc.FFStream.GetVideoAutoBitRateConfig:output_type -> ffstream_grpc.GetVideoAutoBitRateConfigReply
+	35,  // 131: ffstream_grpc.FFStream.SetVideoAutoBitRateConfig:output_type -> ffstream_grpc.SetVideoAutoBitRateConfigReply
+	37,  // 132: ffstream_grpc.FFStream.GetVideoAutoBitRateCalculator:output_type -> ffstream_grpc.GetVideoAutoBitRateCalculatorReply
+	39,  // 133: ffstream_grpc.FFStream.SetVideoAutoBitRateCalculator:output_type -> ffstream_grpc.SetVideoAutoBitRateCalculatorReply
+	41,  // 134: ffstream_grpc.FFStream.GetFPSFraction:output_type -> ffstream_grpc.GetFPSFractionReply
+	43,  // 135: ffstream_grpc.FFStream.SetFPSFraction:output_type -> ffstream_grpc.SetFPSFractionReply
+	47,  // 136: ffstream_grpc.FFStream.GetBitRates:output_type -> ffstream_grpc.GetBitRatesReply
+	49,  // 137: ffstream_grpc.FFStream.GetLatencies:output_type -> ffstream_grpc.GetLatenciesReply
+	53,  // 138: ffstream_grpc.FFStream.GetInputQuality:output_type -> ffstream_grpc.GetInputQualityReply
+	56,  // 139: ffstream_grpc.FFStream.GetOutputQuality:output_type -> ffstream_grpc.GetOutputQualityReply
+	129, // 140: ffstream_grpc.FFStream.Monitor:output_type -> avpipeline.MonitorEvent
+	58,  // 141: ffstream_grpc.FFStream.GetInputsInfo:output_type -> ffstream_grpc.GetInputsInfoReply
+	61,  // 142: ffstream_grpc.FFStream.SetInputCustomOption:output_type -> ffstream_grpc.SetInputCustomOptionReply
+	63,  // 143: ffstream_grpc.FFStream.SetStopInput:output_type -> ffstream_grpc.SetStopInputReply
+	65,  // 144: ffstream_grpc.FFStream.SetFilterGraph:output_type -> ffstream_grpc.SetFilterGraphReply
+	70,  // 145: ffstream_grpc.FFStream.ListOutputs:output_type -> ffstream_grpc.ListOutputsReply
+	72,  // 146: ffstream_grpc.FFStream.CreateOutput:output_type -> ffstream_grpc.CreateOutputReply
+	74,  // 147: ffstream_grpc.FFStream.AddInput:output_type -> ffstream_grpc.AddInputReply
+	76,  // 148: ffstream_grpc.FFStream.RemoveInput:output_type -> ffstream_grpc.RemoveInputReply
+	78,  // 149: ffstream_grpc.FFStream.ReplaceInputURL:output_type -> ffstream_grpc.ReplaceInputURLReply
+	21,  // 150: ffstream_grpc.FFStream.WatchOutputSRTStats:output_type -> ffstream_grpc.GetOutputSRTStatsReply
+	21,  // 151: ffstream_grpc.FFStream.GetInputSRTStats:output_type -> ffstream_grpc.GetOutputSRTStatsReply
+	23,  // 152: ffstream_grpc.FFStream.GetInputSRTFlagInt:output_type -> ffstream_grpc.GetSRTFlagIntReply
+	25,  // 153: ffstream_grpc.FFStream.SetInputSRTFlagInt:output_type -> ffstream_grpc.SetSRTFlagIntReply
+	85,  // 154: ffstream_grpc.FFStream.WatchOutputSwitches:output_type -> ffstream_grpc.OutputSwitchEvent
+	89,  // 155: ffstream_grpc.FFStream.GetRetryPolicy:output_type -> ffstream_grpc.GetRetryPolicyReply
+	91,  // 156: ffstream_grpc.FFStream.SetRetryPolicy:output_type -> ffstream_grpc.SetRetryPolicyReply
+	94,  // 157: ffstream_grpc.FFStream.ApplyConfig:output_type -> ffstream_grpc.ApplyConfigReply
+	110, // 158: ffstream_grpc.FFStream.SubscribeEvents:output_type -> ffstream_grpc.Event
+	113, // 159: ffstream_grpc.FFStreamDaemon.CreateStream:output_type -> ffstream_grpc.CreateStreamReply
+	115, // 160: ffstream_grpc.FFStreamDaemon.StartStream:output_type -> ffstream_grpc.StartStreamReply
+	117, // 161: ffstream_grpc.FFStreamDaemon.StopStream:output_type -> ffstream_grpc.StopStreamReply
+	119, // 162: ffstream_grpc.FFStreamDaemon.DeleteStream:output_type -> ffstream_grpc.DeleteStreamReply
+	121, // 163: ffstream_grpc.FFStreamDaemon.ListStreams:output_type -> ffstream_grpc.ListStreamsReply
+	8,   // 164: ffstream_grpc.FFStreamDaemon.SetDaemonLoggingLevel:output_type -> ffstream_grpc.SetLoggingLevelReply
+	119, // [119:165] is the sub-list for method output_type
+	73,  // [73:119] is the sub-list for method input_type
+	73,  // [73:73] is the sub-list for extension type_name
+	73,  // [73:73] is the sub-list for extension extendee
+	0,   // [0:73] is the sub-list for field type_name
 }
 
 func init() { file_ffstream_proto_init() }
@@ -4272,15 +8632,32 @@ func file_ffstream_proto_init() {
 	if File_ffstream_proto != nil {
 		return
 	}
+	file_ffstream_proto_msgTypes[103].OneofWrappers = []any{
+		(*Event_InputActivated)(nil),
+		(*Event_InputDeactivated)(nil),
+		(*Event_InputReconnecting)(nil),
+		(*Event_OutputCreated)(nil),
+		(*Event_OutputRemoved)(nil),
+		(*Event_OutputReconnecting)(nil),
+		(*Event_OutputSwitch)(nil),
+		(*Event_VideoEncoderChanged)(nil),
+		(*Event_BypassChanged)(nil),
+		(*Event_FpsFractionChanged)(nil),
+		(*Event_Error)(nil),
+		(*Event_EndOfStream)(nil),
+		(*Event_RetriesExhausted)(nil),
+		(*Event_InputDemoted)(nil),
+		(*Event_InputPromoted)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ffstream_proto_rawDesc), len(file_ffstream_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   57,
+			NumEnums:      7,
+			NumMessages:   115,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_ffstream_proto_goTypes,
 		DependencyIndexes: file_ffstream_proto_depIdxs,
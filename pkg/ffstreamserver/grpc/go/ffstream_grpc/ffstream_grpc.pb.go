@@ -45,6 +45,21 @@ const (
 	FFStream_GetInputsInfo_FullMethodName                 = "/ffstream_grpc.FFStream/GetInputsInfo"
 	FFStream_SetInputCustomOption_FullMethodName          = "/ffstream_grpc.FFStream/SetInputCustomOption"
 	FFStream_SetStopInput_FullMethodName                  = "/ffstream_grpc.FFStream/SetStopInput"
+	FFStream_SetFilterGraph_FullMethodName                = "/ffstream_grpc.FFStream/SetFilterGraph"
+	FFStream_ListOutputs_FullMethodName                   = "/ffstream_grpc.FFStream/ListOutputs"
+	FFStream_CreateOutput_FullMethodName                  = "/ffstream_grpc.FFStream/CreateOutput"
+	FFStream_AddInput_FullMethodName                      = "/ffstream_grpc.FFStream/AddInput"
+	FFStream_RemoveInput_FullMethodName                   = "/ffstream_grpc.FFStream/RemoveInput"
+	FFStream_ReplaceInputURL_FullMethodName               = "/ffstream_grpc.FFStream/ReplaceInputURL"
+	FFStream_WatchOutputSRTStats_FullMethodName           = "/ffstream_grpc.FFStream/WatchOutputSRTStats"
+	FFStream_GetInputSRTStats_FullMethodName              = "/ffstream_grpc.FFStream/GetInputSRTStats"
+	FFStream_GetInputSRTFlagInt_FullMethodName            = "/ffstream_grpc.FFStream/GetInputSRTFlagInt"
+	FFStream_SetInputSRTFlagInt_FullMethodName            = "/ffstream_grpc.FFStream/SetInputSRTFlagInt"
+	FFStream_WatchOutputSwitches_FullMethodName           = "/ffstream_grpc.FFStream/WatchOutputSwitches"
+	FFStream_GetRetryPolicy_FullMethodName                = "/ffstream_grpc.FFStream/GetRetryPolicy"
+	FFStream_SetRetryPolicy_FullMethodName                = "/ffstream_grpc.FFStream/SetRetryPolicy"
+	FFStream_ApplyConfig_FullMethodName                   = "/ffstream_grpc.FFStream/ApplyConfig"
+	FFStream_SubscribeEvents_FullMethodName               = "/ffstream_grpc.FFStream/SubscribeEvents"
 )
 
 // FFStreamClient is the client API for FFStream service.
@@ -76,6 +91,21 @@ type FFStreamClient interface {
 	GetInputsInfo(ctx context.Context, in *GetInputsInfoRequest, opts ...grpc.CallOption) (*GetInputsInfoReply, error)
 	SetInputCustomOption(ctx context.Context, in *SetInputCustomOptionRequest, opts ...grpc.CallOption) (*SetInputCustomOptionReply, error)
 	SetStopInput(ctx context.Context, in *SetStopInputRequest, opts ...grpc.CallOption) (*SetStopInputReply, error)
+	SetFilterGraph(ctx context.Context, in *SetFilterGraphRequest, opts ...grpc.CallOption) (*SetFilterGraphReply, error)
+	ListOutputs(ctx context.Context, in *ListOutputsRequest, opts ...grpc.CallOption) (*ListOutputsReply, error)
+	CreateOutput(ctx context.Context, in *CreateOutputRequest, opts ...grpc.CallOption) (*CreateOutputReply, error)
+	AddInput(ctx context.Context, in *AddInputRequest, opts ...grpc.CallOption) (*AddInputReply, error)
+	RemoveInput(ctx context.Context, in *RemoveInputRequest, opts ...grpc.CallOption) (*RemoveInputReply, error)
+	ReplaceInputURL(ctx context.Context, in *ReplaceInputURLRequest, opts ...grpc.CallOption) (*ReplaceInputURLReply, error)
+	WatchOutputSRTStats(ctx context.Context, in *WatchOutputSRTStatsRequest, opts ...grpc.CallOption) (FFStream_WatchOutputSRTStatsClient, error)
+	GetInputSRTStats(ctx context.Context, in *GetInputSRTStatsRequest, opts ...grpc.CallOption) (*GetOutputSRTStatsReply, error)
+	GetInputSRTFlagInt(ctx context.Context, in *GetInputSRTFlagIntRequest, opts ...grpc.CallOption) (*GetSRTFlagIntReply, error)
+	SetInputSRTFlagInt(ctx context.Context, in *SetInputSRTFlagIntRequest, opts ...grpc.CallOption) (*SetSRTFlagIntReply, error)
+	WatchOutputSwitches(ctx context.Context, in *WatchOutputSwitchesRequest, opts ...grpc.CallOption) (FFStream_WatchOutputSwitchesClient, error)
+	GetRetryPolicy(ctx context.Context, in *GetRetryPolicyRequest, opts ...grpc.CallOption) (*GetRetryPolicyReply, error)
+	SetRetryPolicy(ctx context.Context, in *SetRetryPolicyRequest, opts ...grpc.CallOption) (*SetRetryPolicyReply, error)
+	ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigReply, error)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (FFStream_SubscribeEventsClient, error)
 }
 
 type fFStreamClient struct {
@@ -382,6 +412,225 @@ func (c *fFStreamClient) SetStopInput(ctx context.Context, in *SetStopInputReque
 	return out, nil
 }
 
+func (c *fFStreamClient) SetFilterGraph(ctx context.Context, in *SetFilterGraphRequest, opts ...grpc.CallOption) (*SetFilterGraphReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFilterGraphReply)
+	err := c.cc.Invoke(ctx, FFStream_SetFilterGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) ListOutputs(ctx context.Context, in *ListOutputsRequest, opts ...grpc.CallOption) (*ListOutputsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutputsReply)
+	err := c.cc.Invoke(ctx, FFStream_ListOutputs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) CreateOutput(ctx context.Context, in *CreateOutputRequest, opts ...grpc.CallOption) (*CreateOutputReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOutputReply)
+	err := c.cc.Invoke(ctx, FFStream_CreateOutput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) AddInput(ctx context.Context, in *AddInputRequest, opts ...grpc.CallOption) (*AddInputReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddInputReply)
+	err := c.cc.Invoke(ctx, FFStream_AddInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) RemoveInput(ctx context.Context, in *RemoveInputRequest, opts ...grpc.CallOption) (*RemoveInputReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveInputReply)
+	err := c.cc.Invoke(ctx, FFStream_RemoveInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) ReplaceInputURL(ctx context.Context, in *ReplaceInputURLRequest, opts ...grpc.CallOption) (*ReplaceInputURLReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceInputURLReply)
+	err := c.cc.Invoke(ctx, FFStream_ReplaceInputURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) WatchOutputSRTStats(ctx context.Context, in *WatchOutputSRTStatsRequest, opts ...grpc.CallOption) (FFStream_WatchOutputSRTStatsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FFStream_ServiceDesc.Streams[2], FFStream_WatchOutputSRTStats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fFStreamWatchOutputSRTStatsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FFStream_WatchOutputSRTStatsClient interface {
+	Recv() (*GetOutputSRTStatsReply, error)
+	grpc.ClientStream
+}
+
+type fFStreamWatchOutputSRTStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fFStreamWatchOutputSRTStatsClient) Recv() (*GetOutputSRTStatsReply, error) {
+	m := new(GetOutputSRTStatsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fFStreamClient) GetInputSRTStats(ctx context.Context, in *GetInputSRTStatsRequest, opts ...grpc.CallOption) (*GetOutputSRTStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutputSRTStatsReply)
+	err := c.cc.Invoke(ctx, FFStream_GetInputSRTStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) GetInputSRTFlagInt(ctx context.Context, in *GetInputSRTFlagIntRequest, opts ...grpc.CallOption) (*GetSRTFlagIntReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSRTFlagIntReply)
+	err := c.cc.Invoke(ctx, FFStream_GetInputSRTFlagInt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) SetInputSRTFlagInt(ctx context.Context, in *SetInputSRTFlagIntRequest, opts ...grpc.CallOption) (*SetSRTFlagIntReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSRTFlagIntReply)
+	err := c.cc.Invoke(ctx, FFStream_SetInputSRTFlagInt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) WatchOutputSwitches(ctx context.Context, in *WatchOutputSwitchesRequest, opts ...grpc.CallOption) (FFStream_WatchOutputSwitchesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FFStream_ServiceDesc.Streams[3], FFStream_WatchOutputSwitches_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fFStreamWatchOutputSwitchesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FFStream_WatchOutputSwitchesClient interface {
+	Recv() (*OutputSwitchEvent, error)
+	grpc.ClientStream
+}
+
+type fFStreamWatchOutputSwitchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *fFStreamWatchOutputSwitchesClient) Recv() (*OutputSwitchEvent, error) {
+	m := new(OutputSwitchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fFStreamClient) GetRetryPolicy(ctx context.Context, in *GetRetryPolicyRequest, opts ...grpc.CallOption) (*GetRetryPolicyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRetryPolicyReply)
+	err := c.cc.Invoke(ctx, FFStream_GetRetryPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) SetRetryPolicy(ctx context.Context, in *SetRetryPolicyRequest, opts ...grpc.CallOption) (*SetRetryPolicyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRetryPolicyReply)
+	err := c.cc.Invoke(ctx, FFStream_SetRetryPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyConfigReply)
+	err := c.cc.Invoke(ctx, FFStream_ApplyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (FFStream_SubscribeEventsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FFStream_ServiceDesc.Streams[4], FFStream_SubscribeEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fFStreamSubscribeEventsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FFStream_SubscribeEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type fFStreamSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fFStreamSubscribeEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FFStreamServer is the server API for FFStream service.
 // All implementations must embed UnimplementedFFStreamServer
 // for forward compatibility
@@ -411,6 +660,21 @@ type FFStreamServer interface {
 	GetInputsInfo(context.Context, *GetInputsInfoRequest) (*GetInputsInfoReply, error)
 	SetInputCustomOption(context.Context, *SetInputCustomOptionRequest) (*SetInputCustomOptionReply, error)
 	SetStopInput(context.Context, *SetStopInputRequest) (*SetStopInputReply, error)
+	SetFilterGraph(context.Context, *SetFilterGraphRequest) (*SetFilterGraphReply, error)
+	ListOutputs(context.Context, *ListOutputsRequest) (*ListOutputsReply, error)
+	CreateOutput(context.Context, *CreateOutputRequest) (*CreateOutputReply, error)
+	AddInput(context.Context, *AddInputRequest) (*AddInputReply, error)
+	RemoveInput(context.Context, *RemoveInputRequest) (*RemoveInputReply, error)
+	ReplaceInputURL(context.Context, *ReplaceInputURLRequest) (*ReplaceInputURLReply, error)
+	WatchOutputSRTStats(*WatchOutputSRTStatsRequest, FFStream_WatchOutputSRTStatsServer) error
+	GetInputSRTStats(context.Context, *GetInputSRTStatsRequest) (*GetOutputSRTStatsReply, error)
+	GetInputSRTFlagInt(context.Context, *GetInputSRTFlagIntRequest) (*GetSRTFlagIntReply, error)
+	SetInputSRTFlagInt(context.Context, *SetInputSRTFlagIntRequest) (*SetSRTFlagIntReply, error)
+	WatchOutputSwitches(*WatchOutputSwitchesRequest, FFStream_WatchOutputSwitchesServer) error
+	GetRetryPolicy(context.Context, *GetRetryPolicyRequest) (*GetRetryPolicyReply, error)
+	SetRetryPolicy(context.Context, *SetRetryPolicyRequest) (*SetRetryPolicyReply, error)
+	ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigReply, error)
+	SubscribeEvents(*SubscribeEventsRequest, FFStream_SubscribeEventsServer) error
 	mustEmbedUnimplementedFFStreamServer()
 }
 
@@ -493,6 +757,51 @@ func (UnimplementedFFStreamServer) SetInputCustomOption(context.Context, *SetInp
 func (UnimplementedFFStreamServer) SetStopInput(context.Context, *SetStopInputRequest) (*SetStopInputReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetStopInput not implemented")
 }
+func (UnimplementedFFStreamServer) SetFilterGraph(context.Context, *SetFilterGraphRequest) (*SetFilterGraphReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFilterGraph not implemented")
+}
+func (UnimplementedFFStreamServer) ListOutputs(context.Context, *ListOutputsRequest) (*ListOutputsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutputs not implemented")
+}
+func (UnimplementedFFStreamServer) CreateOutput(context.Context, *CreateOutputRequest) (*CreateOutputReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOutput not implemented")
+}
+func (UnimplementedFFStreamServer) AddInput(context.Context, *AddInputRequest) (*AddInputReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInput not implemented")
+}
+func (UnimplementedFFStreamServer) RemoveInput(context.Context, *RemoveInputRequest) (*RemoveInputReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInput not implemented")
+}
+func (UnimplementedFFStreamServer) ReplaceInputURL(context.Context, *ReplaceInputURLRequest) (*ReplaceInputURLReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceInputURL not implemented")
+}
+func (UnimplementedFFStreamServer) WatchOutputSRTStats(*WatchOutputSRTStatsRequest, FFStream_WatchOutputSRTStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOutputSRTStats not implemented")
+}
+func (UnimplementedFFStreamServer) GetInputSRTStats(context.Context, *GetInputSRTStatsRequest) (*GetOutputSRTStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInputSRTStats not implemented")
+}
+func (UnimplementedFFStreamServer) GetInputSRTFlagInt(context.Context, *GetInputSRTFlagIntRequest) (*GetSRTFlagIntReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInputSRTFlagInt not implemented")
+}
+func (UnimplementedFFStreamServer) SetInputSRTFlagInt(context.Context, *SetInputSRTFlagIntRequest) (*SetSRTFlagIntReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInputSRTFlagInt not implemented")
+}
+func (UnimplementedFFStreamServer) WatchOutputSwitches(*WatchOutputSwitchesRequest, FFStream_WatchOutputSwitchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOutputSwitches not implemented")
+}
+func (UnimplementedFFStreamServer) GetRetryPolicy(context.Context, *GetRetryPolicyRequest) (*GetRetryPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetryPolicy not implemented")
+}
+func (UnimplementedFFStreamServer) SetRetryPolicy(context.Context, *SetRetryPolicyRequest) (*SetRetryPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetryPolicy not implemented")
+}
+func (UnimplementedFFStreamServer) ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfig not implemented")
+}
+func (UnimplementedFFStreamServer) SubscribeEvents(*SubscribeEventsRequest, FFStream_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
 func (UnimplementedFFStreamServer) mustEmbedUnimplementedFFStreamServer() {}
 
 // UnsafeFFStreamServer may be embedded to opt out of forward compatibility for this service.
@@ -962,85 +1271,364 @@ func _FFStream_SetStopInput_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// FFStream_ServiceDesc is the grpc.ServiceDesc for FFStream service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var FFStream_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ffstream_grpc.FFStream",
-	HandlerType: (*FFStreamServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetLoggingLevel",
-			Handler:    _FFStream_SetLoggingLevel_Handler,
-		},
-		{
-			MethodName: "RemoveOutput",
-			Handler:    _FFStream_RemoveOutput_Handler,
-		},
-		{
-			MethodName: "GetCurrentOutput",
-			Handler:    _FFStream_GetCurrentOutput_Handler,
-		},
-		{
-			MethodName: "SwitchOutputByProps",
-			Handler:    _FFStream_SwitchOutputByProps_Handler,
-		},
-		{
-			MethodName: "GetStats",
-			Handler:    _FFStream_GetStats_Handler,
-		},
-		{
-			MethodName: "GetOutputSRTStats",
-			Handler:    _FFStream_GetOutputSRTStats_Handler,
-		},
-		{
-			MethodName: "GetSRTFlagInt",
-			Handler:    _FFStream_GetSRTFlagInt_Handler,
-		},
-		{
-			MethodName: "SetSRTFlagInt",
-			Handler:    _FFStream_SetSRTFlagInt_Handler,
-		},
-		{
-			MethodName: "End",
-			Handler:    _FFStream_End_Handler,
-		},
-		{
-			MethodName: "GetPipelines",
-			Handler:    _FFStream_GetPipelines_Handler,
-		},
-		{
-			MethodName: "GetVideoAutoBitRateConfig",
-			Handler:    _FFStream_GetVideoAutoBitRateConfig_Handler,
-		},
-		{
-			MethodName: "SetVideoAutoBitRateConfig",
-			Handler:    _FFStream_SetVideoAutoBitRateConfig_Handler,
-		},
-		{
-			MethodName: "GetVideoAutoBitRateCalculator",
-			Handler:    _FFStream_GetVideoAutoBitRateCalculator_Handler,
-		},
-		{
-			MethodName: "SetVideoAutoBitRateCalculator",
-			Handler:    _FFStream_SetVideoAutoBitRateCalculator_Handler,
-		},
-		{
-			MethodName: "GetFPSFraction",
-			Handler:    _FFStream_GetFPSFraction_Handler,
-		},
-		{
-			MethodName: "SetFPSFraction",
-			Handler:    _FFStream_SetFPSFraction_Handler,
-		},
-		{
-			MethodName: "GetBitRates",
-			Handler:    _FFStream_GetBitRates_Handler,
-		},
-		{
-			MethodName: "GetLatencies",
-			Handler:    _FFStream_GetLatencies_Handler,
-		},
+func _FFStream_SetFilterGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFilterGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).SetFilterGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_SetFilterGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).SetFilterGraph(ctx, req.(*SetFilterGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_ListOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).ListOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_ListOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).ListOutputs(ctx, req.(*ListOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_CreateOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).CreateOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_CreateOutput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).CreateOutput(ctx, req.(*CreateOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_AddInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).AddInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_AddInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).AddInput(ctx, req.(*AddInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_RemoveInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).RemoveInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_RemoveInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).RemoveInput(ctx, req.(*RemoveInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_ReplaceInputURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceInputURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).ReplaceInputURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_ReplaceInputURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).ReplaceInputURL(ctx, req.(*ReplaceInputURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_WatchOutputSRTStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOutputSRTStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FFStreamServer).WatchOutputSRTStats(m, &fFStreamWatchOutputSRTStatsServer{ServerStream: stream})
+}
+
+type FFStream_WatchOutputSRTStatsServer interface {
+	Send(*GetOutputSRTStatsReply) error
+	grpc.ServerStream
+}
+
+type fFStreamWatchOutputSRTStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fFStreamWatchOutputSRTStatsServer) Send(m *GetOutputSRTStatsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FFStream_GetInputSRTStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInputSRTStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).GetInputSRTStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_GetInputSRTStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).GetInputSRTStats(ctx, req.(*GetInputSRTStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_GetInputSRTFlagInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInputSRTFlagIntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).GetInputSRTFlagInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_GetInputSRTFlagInt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).GetInputSRTFlagInt(ctx, req.(*GetInputSRTFlagIntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_SetInputSRTFlagInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInputSRTFlagIntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).SetInputSRTFlagInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_SetInputSRTFlagInt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).SetInputSRTFlagInt(ctx, req.(*SetInputSRTFlagIntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_WatchOutputSwitches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOutputSwitchesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FFStreamServer).WatchOutputSwitches(m, &fFStreamWatchOutputSwitchesServer{ServerStream: stream})
+}
+
+type FFStream_WatchOutputSwitchesServer interface {
+	Send(*OutputSwitchEvent) error
+	grpc.ServerStream
+}
+
+type fFStreamWatchOutputSwitchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *fFStreamWatchOutputSwitchesServer) Send(m *OutputSwitchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FFStream_GetRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).GetRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_GetRetryPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).GetRetryPolicy(ctx, req.(*GetRetryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_SetRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).SetRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_SetRetryPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).SetRetryPolicy(ctx, req.(*SetRetryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_ApplyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamServer).ApplyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStream_ApplyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamServer).ApplyConfig(ctx, req.(*ApplyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStream_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FFStreamServer).SubscribeEvents(m, &fFStreamSubscribeEventsServer{ServerStream: stream})
+}
+
+type FFStream_SubscribeEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type fFStreamSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fFStreamSubscribeEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// FFStream_ServiceDesc is the grpc.ServiceDesc for FFStream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FFStream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ffstream_grpc.FFStream",
+	HandlerType: (*FFStreamServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetLoggingLevel",
+			Handler:    _FFStream_SetLoggingLevel_Handler,
+		},
+		{
+			MethodName: "RemoveOutput",
+			Handler:    _FFStream_RemoveOutput_Handler,
+		},
+		{
+			MethodName: "GetCurrentOutput",
+			Handler:    _FFStream_GetCurrentOutput_Handler,
+		},
+		{
+			MethodName: "SwitchOutputByProps",
+			Handler:    _FFStream_SwitchOutputByProps_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _FFStream_GetStats_Handler,
+		},
+		{
+			MethodName: "GetOutputSRTStats",
+			Handler:    _FFStream_GetOutputSRTStats_Handler,
+		},
+		{
+			MethodName: "GetSRTFlagInt",
+			Handler:    _FFStream_GetSRTFlagInt_Handler,
+		},
+		{
+			MethodName: "SetSRTFlagInt",
+			Handler:    _FFStream_SetSRTFlagInt_Handler,
+		},
+		{
+			MethodName: "End",
+			Handler:    _FFStream_End_Handler,
+		},
+		{
+			MethodName: "GetPipelines",
+			Handler:    _FFStream_GetPipelines_Handler,
+		},
+		{
+			MethodName: "GetVideoAutoBitRateConfig",
+			Handler:    _FFStream_GetVideoAutoBitRateConfig_Handler,
+		},
+		{
+			MethodName: "SetVideoAutoBitRateConfig",
+			Handler:    _FFStream_SetVideoAutoBitRateConfig_Handler,
+		},
+		{
+			MethodName: "GetVideoAutoBitRateCalculator",
+			Handler:    _FFStream_GetVideoAutoBitRateCalculator_Handler,
+		},
+		{
+			MethodName: "SetVideoAutoBitRateCalculator",
+			Handler:    _FFStream_SetVideoAutoBitRateCalculator_Handler,
+		},
+		{
+			MethodName: "GetFPSFraction",
+			Handler:    _FFStream_GetFPSFraction_Handler,
+		},
+		{
+			MethodName: "SetFPSFraction",
+			Handler:    _FFStream_SetFPSFraction_Handler,
+		},
+		{
+			MethodName: "GetBitRates",
+			Handler:    _FFStream_GetBitRates_Handler,
+		},
+		{
+			MethodName: "GetLatencies",
+			Handler:    _FFStream_GetLatencies_Handler,
+		},
 		{
 			MethodName: "GetInputQuality",
 			Handler:    _FFStream_GetInputQuality_Handler,
@@ -1061,6 +1649,54 @@ var FFStream_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetStopInput",
 			Handler:    _FFStream_SetStopInput_Handler,
 		},
+		{
+			MethodName: "SetFilterGraph",
+			Handler:    _FFStream_SetFilterGraph_Handler,
+		},
+		{
+			MethodName: "ListOutputs",
+			Handler:    _FFStream_ListOutputs_Handler,
+		},
+		{
+			MethodName: "CreateOutput",
+			Handler:    _FFStream_CreateOutput_Handler,
+		},
+		{
+			MethodName: "AddInput",
+			Handler:    _FFStream_AddInput_Handler,
+		},
+		{
+			MethodName: "RemoveInput",
+			Handler:    _FFStream_RemoveInput_Handler,
+		},
+		{
+			MethodName: "ReplaceInputURL",
+			Handler:    _FFStream_ReplaceInputURL_Handler,
+		},
+		{
+			MethodName: "GetInputSRTStats",
+			Handler:    _FFStream_GetInputSRTStats_Handler,
+		},
+		{
+			MethodName: "GetInputSRTFlagInt",
+			Handler:    _FFStream_GetInputSRTFlagInt_Handler,
+		},
+		{
+			MethodName: "SetInputSRTFlagInt",
+			Handler:    _FFStream_SetInputSRTFlagInt_Handler,
+		},
+		{
+			MethodName: "GetRetryPolicy",
+			Handler:    _FFStream_GetRetryPolicy_Handler,
+		},
+		{
+			MethodName: "SetRetryPolicy",
+			Handler:    _FFStream_SetRetryPolicy_Handler,
+		},
+		{
+			MethodName: "ApplyConfig",
+			Handler:    _FFStream_ApplyConfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1073,6 +1709,310 @@ var FFStream_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _FFStream_Monitor_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchOutputSRTStats",
+			Handler:       _FFStream_WatchOutputSRTStats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOutputSwitches",
+			Handler:       _FFStream_WatchOutputSwitches_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _FFStream_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ffstream.proto",
+}
+
+const (
+	FFStreamDaemon_CreateStream_FullMethodName          = "/ffstream_grpc.FFStreamDaemon/CreateStream"
+	FFStreamDaemon_StartStream_FullMethodName           = "/ffstream_grpc.FFStreamDaemon/StartStream"
+	FFStreamDaemon_StopStream_FullMethodName            = "/ffstream_grpc.FFStreamDaemon/StopStream"
+	FFStreamDaemon_DeleteStream_FullMethodName          = "/ffstream_grpc.FFStreamDaemon/DeleteStream"
+	FFStreamDaemon_ListStreams_FullMethodName           = "/ffstream_grpc.FFStreamDaemon/ListStreams"
+	FFStreamDaemon_SetDaemonLoggingLevel_FullMethodName = "/ffstream_grpc.FFStreamDaemon/SetDaemonLoggingLevel"
+)
+
+// FFStreamDaemonClient is the client API for FFStreamDaemon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FFStreamDaemon manages the streams hosted by ffstreamd; the RPCs of
+// FFStream are addressed to one of the streams by the "ffstream-stream-id"
+// metadata (see `ffstreamctl --stream`).
+type FFStreamDaemonClient interface {
+	CreateStream(ctx context.Context, in *CreateStreamRequest, opts ...grpc.CallOption) (*CreateStreamReply, error)
+	StartStream(ctx context.Context, in *StartStreamRequest, opts ...grpc.CallOption) (*StartStreamReply, error)
+	StopStream(ctx context.Context, in *StopStreamRequest, opts ...grpc.CallOption) (*StopStreamReply, error)
+	DeleteStream(ctx context.Context, in *DeleteStreamRequest, opts ...grpc.CallOption) (*DeleteStreamReply, error)
+	ListStreams(ctx context.Context, in *ListStreamsRequest, opts ...grpc.CallOption) (*ListStreamsReply, error)
+	SetDaemonLoggingLevel(ctx context.Context, in *SetLoggingLevelRequest, opts ...grpc.CallOption) (*SetLoggingLevelReply, error)
+}
+
+type fFStreamDaemonClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFFStreamDaemonClient(cc grpc.ClientConnInterface) FFStreamDaemonClient {
+	return &fFStreamDaemonClient{cc}
+}
+
+func (c *fFStreamDaemonClient) CreateStream(ctx context.Context, in *CreateStreamRequest, opts ...grpc.CallOption) (*CreateStreamReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStreamReply)
+	err := c.cc.Invoke(ctx, FFStreamDaemon_CreateStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamDaemonClient) StartStream(ctx context.Context, in *StartStreamRequest, opts ...grpc.CallOption) (*StartStreamReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartStreamReply)
+	err := c.cc.Invoke(ctx, FFStreamDaemon_StartStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamDaemonClient) StopStream(ctx context.Context, in *StopStreamRequest, opts ...grpc.CallOption) (*StopStreamReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopStreamReply)
+	err := c.cc.Invoke(ctx, FFStreamDaemon_StopStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamDaemonClient) DeleteStream(ctx context.Context, in *DeleteStreamRequest, opts ...grpc.CallOption) (*DeleteStreamReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStreamReply)
+	err := c.cc.Invoke(ctx, FFStreamDaemon_DeleteStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamDaemonClient) ListStreams(ctx context.Context, in *ListStreamsRequest, opts ...grpc.CallOption) (*ListStreamsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStreamsReply)
+	err := c.cc.Invoke(ctx, FFStreamDaemon_ListStreams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFStreamDaemonClient) SetDaemonLoggingLevel(ctx context.Context, in *SetLoggingLevelRequest, opts ...grpc.CallOption) (*SetLoggingLevelReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLoggingLevelReply)
+	err := c.cc.Invoke(ctx, FFStreamDaemon_SetDaemonLoggingLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FFStreamDaemonServer is the server API for FFStreamDaemon service.
+// All implementations must embed UnimplementedFFStreamDaemonServer
+// for forward compatibility
+//
+// FFStreamDaemon manages the streams hosted by ffstreamd; the RPCs of
+// FFStream are addressed to one of the streams by the "ffstream-stream-id"
+// metadata (see `ffstreamctl --stream`).
+type FFStreamDaemonServer interface {
+	CreateStream(context.Context, *CreateStreamRequest) (*CreateStreamReply, error)
+	StartStream(context.Context, *StartStreamRequest) (*StartStreamReply, error)
+	StopStream(context.Context, *StopStreamRequest) (*StopStreamReply, error)
+	DeleteStream(context.Context, *DeleteStreamRequest) (*DeleteStreamReply, error)
+	ListStreams(context.Context, *ListStreamsRequest) (*ListStreamsReply, error)
+	SetDaemonLoggingLevel(context.Context, *SetLoggingLevelRequest) (*SetLoggingLevelReply, error)
+	mustEmbedUnimplementedFFStreamDaemonServer()
+}
+
+// UnimplementedFFStreamDaemonServer must be embedded to have forward compatible implementations.
+type UnimplementedFFStreamDaemonServer struct {
+}
+
+func (UnimplementedFFStreamDaemonServer) CreateStream(context.Context, *CreateStreamRequest) (*CreateStreamReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+}
+func (UnimplementedFFStreamDaemonServer) StartStream(context.Context, *StartStreamRequest) (*StartStreamReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartStream not implemented")
+}
+func (UnimplementedFFStreamDaemonServer) StopStream(context.Context, *StopStreamRequest) (*StopStreamReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopStream not implemented")
+}
+func (UnimplementedFFStreamDaemonServer) DeleteStream(context.Context, *DeleteStreamRequest) (*DeleteStreamReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStream not implemented")
+}
+func (UnimplementedFFStreamDaemonServer) ListStreams(context.Context, *ListStreamsRequest) (*ListStreamsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStreams not implemented")
+}
+func (UnimplementedFFStreamDaemonServer) SetDaemonLoggingLevel(context.Context, *SetLoggingLevelRequest) (*SetLoggingLevelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDaemonLoggingLevel not implemented")
+}
+func (UnimplementedFFStreamDaemonServer) mustEmbedUnimplementedFFStreamDaemonServer() {}
+
+// UnsafeFFStreamDaemonServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FFStreamDaemonServer will
+// result in compilation errors.
+type UnsafeFFStreamDaemonServer interface {
+	mustEmbedUnimplementedFFStreamDaemonServer()
+}
+
+func RegisterFFStreamDaemonServer(s grpc.ServiceRegistrar, srv FFStreamDaemonServer) {
+	s.RegisterService(&FFStreamDaemon_ServiceDesc, srv)
+}
+
+func _FFStreamDaemon_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamDaemonServer).CreateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStreamDaemon_CreateStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamDaemonServer).CreateStream(ctx, req.(*CreateStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStreamDaemon_StartStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamDaemonServer).StartStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStreamDaemon_StartStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamDaemonServer).StartStream(ctx, req.(*StartStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStreamDaemon_StopStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamDaemonServer).StopStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStreamDaemon_StopStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamDaemonServer).StopStream(ctx, req.(*StopStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStreamDaemon_DeleteStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamDaemonServer).DeleteStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStreamDaemon_DeleteStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamDaemonServer).DeleteStream(ctx, req.(*DeleteStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStreamDaemon_ListStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamDaemonServer).ListStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStreamDaemon_ListStreams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamDaemonServer).ListStreams(ctx, req.(*ListStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFStreamDaemon_SetDaemonLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggingLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFStreamDaemonServer).SetDaemonLoggingLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FFStreamDaemon_SetDaemonLoggingLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFStreamDaemonServer).SetDaemonLoggingLevel(ctx, req.(*SetLoggingLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FFStreamDaemon_ServiceDesc is the grpc.ServiceDesc for FFStreamDaemon service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FFStreamDaemon_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ffstream_grpc.FFStreamDaemon",
+	HandlerType: (*FFStreamDaemonServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStream",
+			Handler:    _FFStreamDaemon_CreateStream_Handler,
+		},
+		{
+			MethodName: "StartStream",
+			Handler:    _FFStreamDaemon_StartStream_Handler,
+		},
+		{
+			MethodName: "StopStream",
+			Handler:    _FFStreamDaemon_StopStream_Handler,
+		},
+		{
+			MethodName: "DeleteStream",
+			Handler:    _FFStreamDaemon_DeleteStream_Handler,
+		},
+		{
+			MethodName: "ListStreams",
+			Handler:    _FFStreamDaemon_ListStreams_Handler,
+		},
+		{
+			MethodName: "SetDaemonLoggingLevel",
+			Handler:    _FFStreamDaemon_SetDaemonLoggingLevel_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "ffstream.proto",
 }
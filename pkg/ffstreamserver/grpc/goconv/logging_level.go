@@ -0,0 +1,31 @@
+package goconv
+
+import (
+	"github.com/facebookincubator/go-belt/tool/logger"
+	loggertypes "github.com/facebookincubator/go-belt/tool/logger/types"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+)
+
+func LoggingLevelFromGRPC(
+	level ffstream_grpc.LoggingLevel,
+) (logger.Level, bool) {
+	switch level {
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_NONE:
+		return loggertypes.LevelNone, true
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_FATAL:
+		return logger.LevelFatal, true
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_PANIC:
+		return logger.LevelPanic, true
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_ERROR:
+		return logger.LevelError, true
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_WARN:
+		return logger.LevelWarning, true
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_INFO:
+		return logger.LevelInfo, true
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_DEBUG:
+		return logger.LevelDebug, true
+	case ffstream_grpc.LoggingLevel_LOGGING_LEVEL_TRACE:
+		return logger.LevelTrace, true
+	}
+	return logger.LevelUndefined, false
+}
@@ -0,0 +1,34 @@
+package goconv
+
+import (
+	audiotypes "github.com/xaionaro-go/audio/pkg/audio/types"
+	codectypes "github.com/xaionaro-go/avpipeline/codec/types"
+	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+)
+
+func SenderKeyFromGRPC(
+	req *ffstream_grpc.SenderKey,
+) streammuxtypes.SenderKey {
+	return streammuxtypes.SenderKey{
+		AudioCodec:      codectypes.Name(req.GetAudioCodec()),
+		AudioSampleRate: audiotypes.SampleRate(req.GetAudioSampleRate()),
+		VideoCodec:      codectypes.Name(req.GetVideoCodec()),
+		VideoResolution: codectypes.Resolution{
+			Width:  req.GetVideoWidth(),
+			Height: req.GetVideoHeight(),
+		},
+	}
+}
+
+func SenderKeyToGRPC(
+	key streammuxtypes.SenderKey,
+) *ffstream_grpc.SenderKey {
+	return &ffstream_grpc.SenderKey{
+		AudioCodec:      string(key.AudioCodec),
+		AudioSampleRate: uint32(key.AudioSampleRate),
+		VideoCodec:      string(key.VideoCodec),
+		VideoWidth:      key.VideoResolution.Width,
+		VideoHeight:     key.VideoResolution.Height,
+	}
+}
@@ -3,12 +3,15 @@ package ffstreamserver
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"sync"
 
+	"github.com/asticode/go-astiav"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/facebookincubator/go-belt"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/avpipeline/kernel"
+	"github.com/xaionaro-go/avpipeline/preset/streammux"
 	streammuxtypes "github.com/xaionaro-go/avpipeline/preset/streammux/types"
 	avpipeline_grpc "github.com/xaionaro-go/avpipeline/protobuf/avpipeline"
 	goconvavp "github.com/xaionaro-go/avpipeline/protobuf/goconv/avpipeline"
@@ -16,7 +19,6 @@ import (
 	"github.com/xaionaro-go/ffstream/pkg/ffstream"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
-	"github.com/xaionaro-go/xsync"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -27,6 +29,9 @@ type GRPCServer struct {
 	Observability       *belt.Belt
 	locker              sync.Mutex
 	stopTranscodingFunc context.CancelFunc
+
+	// ConfigApplier (if set) implements ApplyConfig.
+	ConfigApplier ConfigApplier
 }
 
 func NewGRPCServer(
@@ -47,7 +52,18 @@ func (srv *GRPCServer) SetLoggingLevel(
 	ctx context.Context,
 	req *ffstream_grpc.SetLoggingLevelRequest,
 ) (*ffstream_grpc.SetLoggingLevelReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented, yet")
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "SetLoggingLevel: %v", req)
+	if id := StreamIDFromContext(ctx); id != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the logging level is process-wide, so it cannot be set for the stream %q; set it without selecting a stream (FFStreamDaemon.SetDaemonLoggingLevel)", id)
+	}
+	level, ok := goconv.LoggingLevelFromGRPC(req.GetLevel())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown logging level: %v", req.GetLevel())
+	}
+	SetProcessLoggingLevel(level)
+	logger.Infof(ctx, "the logging level is set to %v", level)
+	return &ffstream_grpc.SetLoggingLevelReply{}, nil
 }
 
 func (srv *GRPCServer) GetCurrentOutput(
@@ -56,11 +72,91 @@ func (srv *GRPCServer) GetCurrentOutput(
 ) (*ffstream_grpc.GetCurrentOutputReply, error) {
 	ctx = srv.ctx(ctx)
 	cfg := srv.FFStream.GetTranscoderConfig(ctx)
+	outputID, hasActiveOutput := srv.FFStream.GetActiveOutputID(ctx)
 	return &ffstream_grpc.GetCurrentOutputReply{
-		Config: goconv.TranscoderConfigToGRPC(cfg),
+		Id:              uint64(outputID),
+		Config:          goconv.TranscoderConfigToGRPC(cfg),
+		MaxBitRate:      srv.FFStream.GetMaxBitRate(ctx),
+		HasActiveOutput: hasActiveOutput,
 	}, nil
 }
 
+func (srv *GRPCServer) ListOutputs(
+	ctx context.Context,
+	req *ffstream_grpc.ListOutputsRequest,
+) (*ffstream_grpc.ListOutputsReply, error) {
+	ctx = srv.ctx(ctx)
+	outputs, err := srv.FFStream.ListOutputs(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to list the outputs: %v", err)
+	}
+	result := &ffstream_grpc.ListOutputsReply{}
+	for _, output := range outputs {
+		state := ffstream_grpc.OutputState_OUTPUT_STATE_STANDBY
+		if output.IsActive {
+			state = ffstream_grpc.OutputState_OUTPUT_STATE_ACTIVE
+		}
+		var destinations []*ffstream_grpc.OutputDestinationInfo
+		for _, dst := range output.Destinations {
+			dstInfo := &ffstream_grpc.OutputDestinationInfo{
+				Index: uint32(dst.Index),
+				Urls:  dst.URLs,
+				Counters: goconvavp.NodeCountersToGRPC(
+					dst.SendingNode.GetCountersPtr(),
+					dst.SendingNode.GetProcessor().CountersPtr(),
+				),
+			}
+			if dst.Err != nil {
+				dstInfo.Error = dst.Err.Error()
+			}
+			destinations = append(destinations, dstInfo)
+		}
+		result.Outputs = append(result.Outputs, &ffstream_grpc.OutputInfo{
+			Id:        uint64(output.ID),
+			SenderKey: goconv.SenderKeyToGRPC(output.SenderKey),
+			Urls:      output.URLs,
+			State:     state,
+			Counters: goconvavp.NodeCountersToGRPC(
+				output.Output.SendingNode.GetCountersPtr(),
+				output.Output.SendingNode.GetProcessor().CountersPtr(),
+			),
+			Destinations: destinations,
+		})
+	}
+	return result, nil
+}
+
+func (srv *GRPCServer) CreateOutput(
+	ctx context.Context,
+	req *ffstream_grpc.CreateOutputRequest,
+) (*ffstream_grpc.CreateOutputReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "CreateOutput: %v", req)
+	if req.GetSenderKey() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "sender_key is not set")
+	}
+	outputID, err := srv.FFStream.CreateOutput(ctx, goconv.SenderKeyFromGRPC(req.GetSenderKey()))
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "unable to create the output: %v", err)
+	}
+	return &ffstream_grpc.CreateOutputReply{
+		Id: uint64(outputID),
+	}, nil
+}
+
+func (srv *GRPCServer) RemoveOutput(
+	ctx context.Context,
+	req *ffstream_grpc.RemoveOutputRequest,
+) (*ffstream_grpc.RemoveOutputReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "RemoveOutput: %v", req)
+	err := srv.FFStream.RemoveOutput(ctx, streammux.OutputID(req.GetId()), req.GetDropOnClose())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to remove output %d: %v", req.GetId(), err)
+	}
+	return &ffstream_grpc.RemoveOutputReply{}, nil
+}
+
 func (srv *GRPCServer) GetStats(
 	ctx context.Context,
 	req *ffstream_grpc.GetStatsRequest,
@@ -293,41 +389,51 @@ func (srv *GRPCServer) GetInputsInfo(
 ) (*ffstream_grpc.GetInputsInfoReply, error) {
 	ctx = srv.ctx(ctx)
 
-	var result []*ffstream_grpc.InputInfo
+	// InputFactory.GetResources takes the FFStream lock, which is taken
+	// before InputChainsLocker, so the chains are iterated outside of it
+	var inputChains []*ffstream.InputChain
 	srv.FFStream.Inputs.InputChainsLocker.Do(ctx, func() {
-		for _, inputChain := range srv.FFStream.Inputs.InputChains {
-			k := inputChain.Input.Processor.Kernel
-			inputFactory := inputChain.InputFactory.(*ffstream.InputFactory)
-			resources, err := inputFactory.GetResources(ctx)
-			if err != nil {
-				logger.Errorf(ctx, "unable to get resources for input factory: %v", err)
-				continue
+		inputChains = append(inputChains, srv.FFStream.Inputs.InputChains...)
+	})
+
+	var result []*ffstream_grpc.InputInfo
+	for _, inputChain := range inputChains {
+		k := inputChain.Input.Processor.Kernel
+		isActive := ffstream.IsInputChainOpened(ctx, inputChain)
+		inputFactory := inputChain.InputFactory.(*ffstream.InputFactory)
+		resources, err := inputFactory.GetResources(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "unable to get resources for input factory: %v", err)
+			continue
+		}
+		for idx, res := range resources {
+			inputKernel := func() *kernel.Input {
+				if !k.KernelLocker.ManualTryLock(ctx) {
+					return nil
+				}
+				defer k.KernelLocker.ManualUnlock()
+				if k.Kernel == nil {
+					return nil
+				}
+				// the resources may be already added, but not opened yet
+				if idx >= len(k.Kernel.Kernel0) {
+					return nil
+				}
+				return k.Kernel.Kernel0[idx]
+			}()
+			info := &ffstream_grpc.InputInfo{
+				Priority:    uint64(inputFactory.FallbackPriority),
+				Num:         uint64(idx),
+				Url:         res.URL,
+				InputConfig: goconvavp.InputConfigToProto(res.InputConfig),
+				IsActive:    isActive,
 			}
-			for idx, res := range resources {
-				inputKernel := func() *kernel.Input {
-					if !k.KernelLocker.ManualTryLock(ctx) {
-						return nil
-					}
-					defer k.KernelLocker.ManualUnlock()
-					if k.Kernel == nil {
-						return nil
-					}
-					if len(k.Kernel.Kernel0) < idx {
-						return nil
-					}
-					return k.Kernel.Kernel0[idx]
-				}()
-				result = append(result, &ffstream_grpc.InputInfo{
-					Id:          uint64(inputKernel.GetObjectID()),
-					Priority:    uint64(inputFactory.FallbackPriority),
-					Num:         uint64(idx),
-					Url:         res.URL,
-					InputConfig: goconvavp.InputConfigToProto(res.InputConfig),
-					IsActive:    k.KernelIsSet,
-				})
+			if inputKernel != nil {
+				info.Id = uint64(inputKernel.GetObjectID())
 			}
+			result = append(result, info)
 		}
-	})
+	}
 
 	return &ffstream_grpc.GetInputsInfoReply{
 		Inputs: result,
@@ -341,33 +447,15 @@ func (srv *GRPCServer) SetInputCustomOption(
 	ctx = srv.ctx(ctx)
 	logger.Debugf(ctx, "SetInputCustomOption: %s", spew.Sdump(req))
 	defer func() { logger.Debugf(ctx, "/SetInputCustomOption: %s: %v %v", spew.Sdump(req), _ret, _err) }()
-	inputChain, err := xsync.DoR2(ctx, &srv.FFStream.Inputs.InputChainsLocker, func() (*ffstream.InputChain, error) {
-		if int(req.GetInputPriority()) > len(srv.FFStream.Inputs.InputChains) {
-			return nil, status.Errorf(codes.InvalidArgument, "input priority %d is out of range (input chains=%d)", req.GetInputPriority(), len(srv.FFStream.Inputs.InputChains))
-		}
-		return srv.FFStream.Inputs.InputChains[req.GetInputPriority()], nil
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	inputFactory := inputChain.InputFactory.(*ffstream.InputFactory)
-	if uint64(inputFactory.FallbackPriority) != req.GetInputPriority() {
-		return nil, status.Errorf(codes.Internal, "input factory priority %d does not match the requested input priority %d", inputFactory.FallbackPriority, req.GetInputPriority())
-	}
-
-	resources, err := inputFactory.GetResources(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "unable to get resources for input factory: %v", err)
-	}
-
-	if int(req.GetInputNum()) >= len(resources) {
-		return nil, status.Errorf(codes.InvalidArgument, "input num %d is out of range (resources=%d)", req.GetInputNum(), len(resources))
+	if int(req.GetInputPriority()) >= srv.FFStream.Inputs.GetInputChainsCount(ctx) {
+		return nil, status.Errorf(codes.InvalidArgument, "input priority %d is out of range (input chains=%d)", req.GetInputPriority(), srv.FFStream.Inputs.GetInputChainsCount(ctx))
 	}
-	resources[req.GetInputNum()].CustomOptions.SetFirst(avptypes.DictionaryItem{
+	if err := srv.FFStream.SetInputCustomOption(ctx, uint(req.GetInputPriority()), uint(req.GetInputNum()), avptypes.DictionaryItem{
 		Key:   req.GetKey(),
 		Value: req.GetValue(),
-	})
+	}); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to set the custom option: %v", err)
+	}
 
 	return &ffstream_grpc.SetInputCustomOptionReply{}, nil
 }
@@ -379,30 +467,76 @@ func (srv *GRPCServer) SetStopInput(
 	ctx = srv.ctx(ctx)
 	logger.Debugf(ctx, "SetStopInput: %s", spew.Sdump(req))
 	defer func() { logger.Debugf(ctx, "/SetStopInput: %s", spew.Sdump(req)) }()
-	inputChain, err := xsync.DoR2(ctx, &srv.FFStream.Inputs.InputChainsLocker, func() (*ffstream.InputChain, error) {
-		if int(req.GetInputPriority()) > len(srv.FFStream.Inputs.InputChains) {
-			return nil, status.Errorf(codes.InvalidArgument, "input priority %d is out of range (input chains=%d)", req.GetInputPriority(), len(srv.FFStream.Inputs.InputChains))
-		}
-		return srv.FFStream.Inputs.InputChains[req.GetInputPriority()], nil
+	if int(req.GetInputPriority()) >= srv.FFStream.Inputs.GetInputChainsCount(ctx) {
+		return nil, status.Errorf(codes.InvalidArgument, "input priority %d is out of range (input chains=%d)", req.GetInputPriority(), srv.FFStream.Inputs.GetInputChainsCount(ctx))
+	}
+	if err := srv.FFStream.SetStopInput(ctx, uint(req.GetInputPriority()), req.GetStop()); err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to stop or resume input at priority %d: %v", req.GetInputPriority(), err)
+	}
+
+	return &ffstream_grpc.SetStopInputReply{}, nil
+}
+
+func (srv *GRPCServer) AddInput(
+	ctx context.Context,
+	req *ffstream_grpc.AddInputRequest,
+) (_ret *ffstream_grpc.AddInputReply, _err error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "AddInput: %s", spew.Sdump(req))
+	defer func() { logger.Debugf(ctx, "/AddInput: %s: %v %v", spew.Sdump(req), _ret, _err) }()
+	if req.GetUrl() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url is not set")
+	}
+	customOptions := avptypes.DictionaryItems(goconv.CustomOptionsFromGRPC(req.GetCustomOptions()))
+	customOptions.SetFirst(avptypes.DictionaryItem{
+		Key:   "fallback_priority",
+		Value: strconv.FormatUint(req.GetInputPriority(), 10),
+	})
+	inputNum, err := srv.FFStream.AddInput(ctx, ffstream.Resource{
+		URL: req.GetUrl(),
+		InputConfig: kernel.InputConfig{
+			CustomOptions: customOptions,
+		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Unknown, "unable to add the input: %v", err)
 	}
+	return &ffstream_grpc.AddInputReply{
+		InputNum: uint64(inputNum),
+	}, nil
+}
 
-	switch req.GetStop() {
-	case true:
-		err := inputChain.Pause(ctx)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "unable to stop input at priority %d: %v", req.GetInputPriority(), err)
-		}
-	case false:
-		err := inputChain.Unpause(ctx)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "unable to resume input at priority %d: %v", req.GetInputPriority(), err)
-		}
+func (srv *GRPCServer) RemoveInput(
+	ctx context.Context,
+	req *ffstream_grpc.RemoveInputRequest,
+) (_ret *ffstream_grpc.RemoveInputReply, _err error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "RemoveInput: %s", spew.Sdump(req))
+	defer func() { logger.Debugf(ctx, "/RemoveInput: %s: %v %v", spew.Sdump(req), _ret, _err) }()
+	err := srv.FFStream.RemoveInput(ctx, uint(req.GetInputPriority()), uint(req.GetInputNum()))
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "unable to remove input %d at priority %d: %v", req.GetInputNum(), req.GetInputPriority(), err)
 	}
+	return &ffstream_grpc.RemoveInputReply{}, nil
+}
 
-	return &ffstream_grpc.SetStopInputReply{}, nil
+func (srv *GRPCServer) ReplaceInputURL(
+	ctx context.Context,
+	req *ffstream_grpc.ReplaceInputURLRequest,
+) (_ret *ffstream_grpc.ReplaceInputURLReply, _err error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "ReplaceInputURL: %d %d", req.GetInputPriority(), req.GetInputNum())
+	defer func() {
+		logger.Debugf(ctx, "/ReplaceInputURL: %d %d: %v %v", req.GetInputPriority(), req.GetInputNum(), _ret, _err)
+	}()
+	if req.GetUrl() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url is not set")
+	}
+	err := srv.FFStream.ReplaceInputURL(ctx, uint(req.GetInputPriority()), uint(req.GetInputNum()), req.GetUrl())
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "unable to replace the URL of input %d at priority %d: %v", req.GetInputNum(), req.GetInputPriority(), err)
+	}
+	return &ffstream_grpc.ReplaceInputURLReply{}, nil
 }
 
 func (srv *GRPCServer) SwitchOutputByProps(
@@ -411,11 +545,84 @@ func (srv *GRPCServer) SwitchOutputByProps(
 ) (*ffstream_grpc.SwitchOutputByPropsReply, error) {
 	ctx = srv.ctx(ctx)
 	logger.Debugf(ctx, "SwitchOutputByProps: %v", req)
+	cfg := goconv.TranscoderConfigFromGRPC(req.GetConfig())
+	cfg, err := srv.FFStream.Config.StreamMap.TranscoderConfig(
+		cfg.Output.VideoTrackConfigs[0],
+		cfg.Output.AudioTrackConfigs[0],
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to apply the stream map: %v", err)
+	}
 	props := streammuxtypes.SenderProps{
-		TranscoderConfig: goconv.TranscoderConfigFromGRPC(req.GetConfig()),
+		TranscoderConfig: cfg,
 	}
 	if err := srv.FFStream.SwitchOutputByProps(ctx, props); err != nil {
 		return nil, status.Errorf(codes.Unknown, "unable to switch output: %v", err)
 	}
 	return &ffstream_grpc.SwitchOutputByPropsReply{}, nil
 }
+
+func (srv *GRPCServer) SetFilterGraph(
+	ctx context.Context,
+	req *ffstream_grpc.SetFilterGraphRequest,
+) (*ffstream_grpc.SetFilterGraphReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "SetFilterGraph: %v", req)
+	var mediaType astiav.MediaType
+	switch req.GetKind() {
+	case ffstream_grpc.FilterGraphKind_FILTER_GRAPH_KIND_COMPLEX:
+		if err := srv.FFStream.SetFilterComplex(ctx, req.GetDescription()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to set the complex filter graph: %v", err)
+		}
+		return &ffstream_grpc.SetFilterGraphReply{}, nil
+	case ffstream_grpc.FilterGraphKind_FILTER_GRAPH_KIND_VIDEO:
+		mediaType = astiav.MediaTypeVideo
+	case ffstream_grpc.FilterGraphKind_FILTER_GRAPH_KIND_AUDIO:
+		mediaType = astiav.MediaTypeAudio
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported filter graph kind: %v", req.GetKind())
+	}
+	if err := srv.FFStream.SetFilterGraph(ctx, mediaType, req.GetDescription()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to set the filter graph: %v", err)
+	}
+	return &ffstream_grpc.SetFilterGraphReply{}, nil
+}
+
+func (srv *GRPCServer) WatchOutputSwitches(
+	req *ffstream_grpc.WatchOutputSwitchesRequest,
+	reqSrv ffstream_grpc.FFStream_WatchOutputSwitchesServer,
+) (_err error) {
+	ctx := srv.ctx(reqSrv.Context())
+	logger.Debugf(ctx, "WatchOutputSwitches: %v", req)
+	defer func() { logger.Debugf(ctx, "/WatchOutputSwitches: %v: %v", req, _err) }()
+
+	ch := srv.FFStream.SubscribeOutputSwitches(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-ch:
+			if !ok {
+				return ctx.Err()
+			}
+			if err := reqSrv.Send(outputSwitchEventToGRPC(ev)); err != nil {
+				return status.Errorf(codes.Unknown, "unable to send the output switch event: %v", err)
+			}
+		}
+	}
+}
+
+func outputSwitchEventToGRPC(
+	ev ffstream.OutputSwitchEvent,
+) *ffstream_grpc.OutputSwitchEvent {
+	return &ffstream_grpc.OutputSwitchEvent{
+		UnixNano:     ev.Time.UnixNano(),
+		SenderKey:    goconv.SenderKeyToGRPC(ev.SenderKey),
+		Destination:  uint64(ev.Destination),
+		FromPriority: uint64(ev.FromPriority),
+		FromUrl:      ev.FromURL,
+		ToPriority:   uint64(ev.ToPriority),
+		ToUrl:        ev.ToURL,
+		Reason:       ev.Reason,
+	}
+}
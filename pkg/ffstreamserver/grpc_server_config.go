@@ -0,0 +1,52 @@
+package ffstreamserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConfigApplier applies the content of a configuration file (see
+// ffstreamconfig) to the running stream; nil config means: reload
+// the configuration file the stream was started with.
+type ConfigApplier func(ctx context.Context, config []byte, dryRun bool) ([]ffstream.ConfigChange, error)
+
+func (srv *GRPCServer) ApplyConfig(
+	ctx context.Context,
+	req *ffstream_grpc.ApplyConfigRequest,
+) (*ffstream_grpc.ApplyConfigReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "ApplyConfig: dry_run:%v, config:%d bytes", req.GetDryRun(), len(req.GetConfig()))
+	if srv.ConfigApplier == nil {
+		return nil, status.Errorf(codes.Unimplemented, "applying the configuration is not supported by this server")
+	}
+	var config []byte
+	if len(req.GetConfig()) != 0 {
+		config = req.GetConfig()
+	}
+	changes, err := srv.ConfigApplier(ctx, config, req.GetDryRun())
+	switch {
+	case errors.Is(err, ffstream.ErrRestartRequired):
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case err != nil && len(changes) == 0:
+		return nil, status.Errorf(codes.InvalidArgument, "unable to apply the config: %v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Aborted, "%v", err)
+	}
+
+	result := &ffstream_grpc.ApplyConfigReply{}
+	for _, change := range changes {
+		result.Changes = append(result.Changes, &ffstream_grpc.ConfigChange{
+			Key:         change.Key,
+			Description: change.Description,
+			IsLive:      change.IsLive,
+			IsApplied:   change.IsApplied,
+		})
+	}
+	return result, nil
+}
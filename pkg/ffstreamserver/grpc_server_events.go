@@ -0,0 +1,142 @@
+package ffstreamserver
+
+import (
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srv *GRPCServer) SubscribeEvents(
+	req *ffstream_grpc.SubscribeEventsRequest,
+	reqSrv ffstream_grpc.FFStream_SubscribeEventsServer,
+) (_err error) {
+	ctx := srv.ctx(reqSrv.Context())
+	logger.Debugf(ctx, "SubscribeEvents: %v", req)
+	defer func() { logger.Debugf(ctx, "/SubscribeEvents: %v: %v", req, _err) }()
+
+	ch := srv.FFStream.SubscribeEvents(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-ch:
+			if !ok {
+				return ctx.Err()
+			}
+			msg, err := EventToGRPC(ev)
+			if err != nil {
+				logger.Errorf(ctx, "%v", err)
+				continue
+			}
+			if err := reqSrv.Send(msg); err != nil {
+				return status.Errorf(codes.Unknown, "unable to send the event: %v", err)
+			}
+			if _, ok := ev.(ffstream.EndOfStreamEvent); ok {
+				return nil
+			}
+		}
+	}
+}
+
+// EventToGRPC converts the event to its representation in the control API.
+func EventToGRPC(
+	ev ffstream.Event,
+) (*ffstream_grpc.Event, error) {
+	result := &ffstream_grpc.Event{
+		UnixNano: ev.GetTime().UnixNano(),
+	}
+	switch ev := ev.(type) {
+	case ffstream.InputActivatedEvent:
+		result.Event = &ffstream_grpc.Event_InputActivated{InputActivated: &ffstream_grpc.InputActivatedEvent{
+			InputPriority: uint64(ev.Priority),
+		}}
+	case ffstream.InputDeactivatedEvent:
+		result.Event = &ffstream_grpc.Event_InputDeactivated{InputDeactivated: &ffstream_grpc.InputDeactivatedEvent{
+			InputPriority: uint64(ev.Priority),
+		}}
+	case ffstream.InputReconnectingEvent:
+		result.Event = &ffstream_grpc.Event_InputReconnecting{InputReconnecting: &ffstream_grpc.InputReconnectingEvent{
+			InputPriority: uint64(ev.Priority),
+			Attempt:       uint64(ev.Attempt),
+			Delay:         uint64(ev.Delay.Nanoseconds()),
+		}}
+	case ffstream.InputDemotedEvent:
+		result.Event = &ffstream_grpc.Event_InputDemoted{InputDemoted: &ffstream_grpc.InputDemotedEvent{
+			InputPriority: uint64(ev.Priority),
+			Hold:          uint64(ev.Hold.Nanoseconds()),
+			Reason:        errorString(ev.Reason),
+		}}
+	case ffstream.InputPromotedEvent:
+		result.Event = &ffstream_grpc.Event_InputPromoted{InputPromoted: &ffstream_grpc.InputPromotedEvent{
+			InputPriority: uint64(ev.Priority),
+		}}
+	case ffstream.OutputCreatedEvent:
+		result.Event = &ffstream_grpc.Event_OutputCreated{OutputCreated: &ffstream_grpc.OutputCreatedEvent{
+			Id:        uint64(ev.OutputID),
+			SenderKey: goconv.SenderKeyToGRPC(ev.SenderKey),
+			Urls:      ev.URLs,
+		}}
+	case ffstream.OutputRemovedEvent:
+		result.Event = &ffstream_grpc.Event_OutputRemoved{OutputRemoved: &ffstream_grpc.OutputRemovedEvent{
+			Id:        uint64(ev.OutputID),
+			SenderKey: goconv.SenderKeyToGRPC(ev.SenderKey),
+		}}
+	case ffstream.OutputReconnectingEvent:
+		result.Event = &ffstream_grpc.Event_OutputReconnecting{OutputReconnecting: &ffstream_grpc.OutputReconnectingEvent{
+			SenderKey:   goconv.SenderKeyToGRPC(ev.SenderKey),
+			Destination: uint64(ev.Destination),
+			Url:         ev.URL,
+			Delay:       uint64(ev.Delay.Nanoseconds()),
+			Error:       errorString(ev.Err),
+		}}
+	case ffstream.OutputSwitchEvent:
+		result.Event = &ffstream_grpc.Event_OutputSwitch{OutputSwitch: outputSwitchEventToGRPC(ev)}
+	case ffstream.RetriesExhaustedEvent:
+		result.Event = &ffstream_grpc.Event_RetriesExhausted{RetriesExhausted: &ffstream_grpc.RetriesExhaustedEvent{
+			Target:      ffstream_grpc.RetryTarget(ev.Target),
+			OnExhausted: ffstream_grpc.RetryExhaustedAction(ev.OnExhausted),
+			Error:       errorString(ev.Err),
+		}}
+	case ffstream.VideoEncoderChangedEvent:
+		result.Event = &ffstream_grpc.Event_VideoEncoderChanged{VideoEncoderChanged: &ffstream_grpc.VideoEncoderChangedEvent{
+			FromWidth:   ev.FromResolution.Width,
+			FromHeight:  ev.FromResolution.Height,
+			FromBitRate: ev.FromBitRate,
+			ToWidth:     ev.ToResolution.Width,
+			ToHeight:    ev.ToResolution.Height,
+			ToBitRate:   ev.ToBitRate,
+		}}
+	case ffstream.BypassChangedEvent:
+		result.Event = &ffstream_grpc.Event_BypassChanged{BypassChanged: &ffstream_grpc.BypassChangedEvent{
+			IsBypass: ev.IsBypass,
+		}}
+	case ffstream.FPSFractionChangedEvent:
+		result.Event = &ffstream_grpc.Event_FpsFractionChanged{FpsFractionChanged: &ffstream_grpc.FPSFractionChangedEvent{
+			Num: ev.Num,
+			Den: ev.Den,
+		}}
+	case ffstream.ErrorEvent:
+		result.Event = &ffstream_grpc.Event_Error{Error: &ffstream_grpc.ErrorEvent{
+			Error: errorString(ev.Err),
+		}}
+	case ffstream.EndOfStreamEvent:
+		result.Event = &ffstream_grpc.Event_EndOfStream{EndOfStream: &ffstream_grpc.EndOfStreamEvent{
+			Error: errorString(ev.Err),
+		}}
+	default:
+		return nil, fmt.Errorf("unknown event type %T", ev)
+	}
+	return result, nil
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -5,7 +5,10 @@ package ffstreamserver
 
 import (
 	"context"
+	"time"
 
+	"github.com/facebookincubator/go-belt/tool/logger"
+	avptypes "github.com/xaionaro-go/avpipeline/types"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
 	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
 	"github.com/xaionaro-go/libsrt"
@@ -18,8 +21,53 @@ func (srv *GRPCServer) GetOutputSRTStats(
 	ctx context.Context,
 	req *ffstream_grpc.GetOutputSRTStatsRequest,
 ) (*ffstream_grpc.GetOutputSRTStatsReply, error) {
+	ctx = srv.ctx(ctx)
+	stats, err := srv.getOutputSRTStats(ctx, int(req.GetOutputId()), uint(req.GetDestination()))
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "unable to get the output SRT statistics: %v", err)
+	}
+
+	return goconv.OutputSRTStatsToGRPC(stats), nil
+}
+
+func (srv *GRPCServer) WatchOutputSRTStats(
+	req *ffstream_grpc.WatchOutputSRTStatsRequest,
+	reqSrv ffstream_grpc.FFStream_WatchOutputSRTStatsServer,
+) (_err error) {
+	ctx := srv.ctx(reqSrv.Context())
+	logger.Debugf(ctx, "WatchOutputSRTStats: %v", req)
+	defer func() { logger.Debugf(ctx, "/WatchOutputSRTStats: %v: %v", req, _err) }()
+
+	interval := goconv.DurationFromGRPC(int64(req.GetInterval()))
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		stats, err := srv.getOutputSRTStats(ctx, int(req.GetOutputId()), uint(req.GetDestination()))
+		if err != nil {
+			return status.Errorf(codes.Unknown, "unable to get the output SRT statistics: %v", err)
+		}
+		if err := reqSrv.Send(goconv.OutputSRTStatsToGRPC(stats)); err != nil {
+			return status.Errorf(codes.Unknown, "unable to send the output SRT statistics: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func (srv *GRPCServer) getOutputSRTStats(
+	ctx context.Context,
+	outputID int,
+	destination uint,
+) (*libsrt.Tracebstats, error) {
 	var stats *libsrt.Tracebstats
-	err := srv.FFStream.WithSRTOutput(ctx, int(req.GetOutputId()), func(sock *threadsafe.Socket) error {
+	err := srv.FFStream.WithSRTOutput(ctx, outputID, destination, func(sock *threadsafe.Socket) error {
 		result, err := sock.Bistats(false, true)
 		if err == nil {
 			stats = ptr(result.Convert())
@@ -27,22 +75,23 @@ func (srv *GRPCServer) GetOutputSRTStats(
 		return err
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "unable to get the output SRT statistics: %v", err)
+		return nil, err
 	}
-
-	return goconv.OutputSRTStatsToGRPC(stats), nil
+	return stats, nil
 }
 
-func (srv *GRPCServer) SetFlagInt(
+func (srv *GRPCServer) SetSRTFlagInt(
 	ctx context.Context,
 	req *ffstream_grpc.SetSRTFlagIntRequest,
 ) (*ffstream_grpc.SetSRTFlagIntReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "SetSRTFlagInt: %v", req)
 	sockOpt, ok := goconv.SRTSockoptIntFromGRPC(req.GetFlag())
 	if !ok {
-		return nil, status.Errorf(codes.Unknown, "unknown SRT socket option: %d", req.GetFlag())
+		return nil, status.Errorf(codes.InvalidArgument, "unknown SRT socket option: %d", req.GetFlag())
 	}
 
-	err := srv.FFStream.WithSRTOutput(ctx, int(req.GetOutputId()), func(sock *threadsafe.Socket) error {
+	err := srv.FFStream.WithSRTOutput(ctx, int(req.GetOutputId()), uint(req.GetDestination()), func(sock *threadsafe.Socket) error {
 		v := libsrt.BlobInt(req.GetValue())
 		return sock.Setsockflag(sockOpt, &v)
 	})
@@ -53,24 +102,115 @@ func (srv *GRPCServer) SetFlagInt(
 	return &ffstream_grpc.SetSRTFlagIntReply{}, nil
 }
 
-func (srv *GRPCServer) GetFlagInt(
+func (srv *GRPCServer) GetSRTFlagInt(
 	ctx context.Context,
 	req *ffstream_grpc.GetSRTFlagIntRequest,
 ) (*ffstream_grpc.GetSRTFlagIntReply, error) {
+	ctx = srv.ctx(ctx)
 	sockOpt, ok := goconv.SRTSockoptIntFromGRPC(req.GetFlag())
 	if !ok {
-		return nil, status.Errorf(codes.Unknown, "unknown SRT socket option: %d", req.GetFlag())
+		return nil, status.Errorf(codes.InvalidArgument, "unknown SRT socket option: %d", req.GetFlag())
 	}
 
 	var v libsrt.BlobInt
-	err := srv.FFStream.WithSRTOutput(ctx, int(req.GetOutputId()), func(sock *threadsafe.Socket) error {
+	err := srv.FFStream.WithSRTOutput(ctx, int(req.GetOutputId()), uint(req.GetDestination()), func(sock *threadsafe.Socket) error {
 		return sock.Getsockflag(sockOpt, &v)
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "unable to set the SRT socket option: %v", err)
+		return nil, status.Errorf(codes.Unknown, "unable to get the SRT socket option: %v", err)
+	}
+
+	return &ffstream_grpc.GetSRTFlagIntReply{
+		Value: int64(v),
+	}, nil
+}
+
+func (srv *GRPCServer) GetInputSRTStats(
+	ctx context.Context,
+	req *ffstream_grpc.GetInputSRTStatsRequest,
+) (*ffstream_grpc.GetOutputSRTStatsReply, error) {
+	ctx = srv.ctx(ctx)
+	var stats *libsrt.Tracebstats
+	err := srv.withSRTInput(ctx, req.GetInput(), func(sock *threadsafe.Socket) error {
+		result, err := sock.Bistats(false, true)
+		if err == nil {
+			stats = ptr(result.Convert())
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return goconv.OutputSRTStatsToGRPC(stats), nil
+}
+
+func (srv *GRPCServer) SetInputSRTFlagInt(
+	ctx context.Context,
+	req *ffstream_grpc.SetInputSRTFlagIntRequest,
+) (*ffstream_grpc.SetSRTFlagIntReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "SetInputSRTFlagInt: %v", req)
+	sockOpt, ok := goconv.SRTSockoptIntFromGRPC(req.GetFlag())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown SRT socket option: %d", req.GetFlag())
+	}
+
+	err := srv.withSRTInput(ctx, req.GetInput(), func(sock *threadsafe.Socket) error {
+		v := libsrt.BlobInt(req.GetValue())
+		return sock.Setsockflag(sockOpt, &v)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ffstream_grpc.SetSRTFlagIntReply{}, nil
+}
+
+func (srv *GRPCServer) GetInputSRTFlagInt(
+	ctx context.Context,
+	req *ffstream_grpc.GetInputSRTFlagIntRequest,
+) (*ffstream_grpc.GetSRTFlagIntReply, error) {
+	ctx = srv.ctx(ctx)
+	sockOpt, ok := goconv.SRTSockoptIntFromGRPC(req.GetFlag())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown SRT socket option: %d", req.GetFlag())
+	}
+
+	var v libsrt.BlobInt
+	err := srv.withSRTInput(ctx, req.GetInput(), func(sock *threadsafe.Socket) error {
+		return sock.Getsockflag(sockOpt, &v)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &ffstream_grpc.GetSRTFlagIntReply{
 		Value: int64(v),
 	}, nil
 }
+
+// withSRTInput resolves the input address and calls the callback with
+// the SRT socket of the input; the returned error is a gRPC status.
+func (srv *GRPCServer) withSRTInput(
+	ctx context.Context,
+	addr *ffstream_grpc.InputAddress,
+	callback func(*threadsafe.Socket) error,
+) error {
+	if addr == nil {
+		return status.Errorf(codes.InvalidArgument, "input is not set")
+	}
+	priority, num := uint(addr.GetInputPriority()), uint(addr.GetInputNum())
+	if addr.GetId() != 0 {
+		var err error
+		priority, num, err = srv.FFStream.FindInputByID(ctx, avptypes.ObjectID(addr.GetId()))
+		if err != nil {
+			return status.Errorf(codes.NotFound, "%v", err)
+		}
+	}
+	err := srv.FFStream.WithSRTInput(ctx, priority, num, callback)
+	if err != nil {
+		return status.Errorf(codes.Unknown, "unable to access the SRT socket of input %d at priority %d: %v", num, priority, err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package ffstreamserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/goconv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srv *GRPCServer) GetRetryPolicy(
+	ctx context.Context,
+	req *ffstream_grpc.GetRetryPolicyRequest,
+) (*ffstream_grpc.GetRetryPolicyReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "GetRetryPolicy: %v", req)
+	target, err := retryTargetFromGRPC(req.GetTarget())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	policy, err := srv.FFStream.GetRetryPolicy(ctx, target)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "unable to get the retry policy: %v", err)
+	}
+	counters := srv.FFStream.GetRetryCounters(target)
+	return &ffstream_grpc.GetRetryPolicyReply{
+		Policy: retryPolicyToGRPC(policy),
+		Counters: &ffstream_grpc.RetryCounters{
+			Attempts:  counters.Attempts.Load(),
+			Successes: counters.Successes.Load(),
+			Exhausted: counters.Exhausted.Load(),
+		},
+	}, nil
+}
+
+func (srv *GRPCServer) SetRetryPolicy(
+	ctx context.Context,
+	req *ffstream_grpc.SetRetryPolicyRequest,
+) (*ffstream_grpc.SetRetryPolicyReply, error) {
+	ctx = srv.ctx(ctx)
+	logger.Debugf(ctx, "SetRetryPolicy: %v", req)
+	target, err := retryTargetFromGRPC(req.GetTarget())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := srv.FFStream.SetRetryPolicy(ctx, target, retryPolicyFromGRPC(req.Policy)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to set the retry policy: %v", err)
+	}
+	return &ffstream_grpc.SetRetryPolicyReply{}, nil
+}
+
+func retryTargetFromGRPC(
+	target ffstream_grpc.RetryTarget,
+) (ffstream.RetryTarget, error) {
+	switch target {
+	case ffstream_grpc.RetryTarget_RETRY_TARGET_INPUT:
+		return ffstream.RetryTargetInput, nil
+	case ffstream_grpc.RetryTarget_RETRY_TARGET_OUTPUT:
+		return ffstream.RetryTargetOutput, nil
+	default:
+		return ffstream.UndefinedRetryTarget, fmt.Errorf("unknown retry target: %v", target)
+	}
+}
+
+func retryPolicyToGRPC(
+	policy *ffstream.RetryPolicy,
+) *ffstream_grpc.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &ffstream_grpc.RetryPolicy{
+		InitialInterval: uint64(goconv.DurationToGRPC(policy.InitialInterval)),
+		MaxInterval:     uint64(goconv.DurationToGRPC(policy.MaxInterval)),
+		Multiplier:      policy.Multiplier,
+		Jitter:          policy.Jitter,
+		MaxAttempts:     uint64(policy.MaxAttempts),
+		MaxElapsedTime:  uint64(goconv.DurationToGRPC(policy.MaxElapsedTime)),
+		OnExhausted:     ffstream_grpc.RetryExhaustedAction(policy.OnExhausted),
+	}
+}
+
+func retryPolicyFromGRPC(
+	policy *ffstream_grpc.RetryPolicy,
+) *ffstream.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &ffstream.RetryPolicy{
+		InitialInterval: goconv.DurationFromGRPC(int64(policy.GetInitialInterval())),
+		MaxInterval:     goconv.DurationFromGRPC(int64(policy.GetMaxInterval())),
+		Multiplier:      policy.GetMultiplier(),
+		Jitter:          policy.GetJitter(),
+		MaxAttempts:     uint(policy.GetMaxAttempts()),
+		MaxElapsedTime:  goconv.DurationFromGRPC(int64(policy.GetMaxElapsedTime())),
+		OnExhausted:     ffstream.RetryExhaustedAction(policy.GetOnExhausted()),
+	}
+}
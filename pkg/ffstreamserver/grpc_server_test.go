@@ -0,0 +1,71 @@
+package ffstreamserver
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstream/ffargs"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+)
+
+// newTestListenURL returns the URL of an input waiting for a TCP connection,
+// so the stream runs (with the input not opened) until it is stopped.
+func newTestListenURL(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return "tcp://" + addr + "?listen=1"
+}
+
+func TestGRPCServerGetInputsInfoOfAddedInput(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	flags, err := ffargs.Parse(ctx, []string{
+		"-i", newTestListenURL(t),
+		"-c:v", "copy", "-c:a", "copy",
+		"-f", "mpegts", filepath.Join(t.TempDir(), "output.ts"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, cfg, err := ffargs.New(ctx, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ffargs.Start(ctx, s, cfg); err != nil {
+		t.Fatal(err)
+	}
+	srv := NewGRPCServer(ctx, s)
+
+	// the resources of the running chain grow before its kernels are reopened
+	if _, err := srv.AddInput(ctx, &ffstream_grpc.AddInputRequest{Url: newTestListenURL(t)}); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		reply, err := srv.GetInputsInfo(ctx, &ffstream_grpc.GetInputsInfoRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs := reply.GetInputs()
+		if len(inputs) != 2 {
+			t.Fatalf("expected 2 inputs, got %d", len(inputs))
+		}
+		for idx, input := range inputs {
+			if input.GetPriority() != 0 || input.GetNum() != uint64(idx) {
+				t.Fatalf("unexpected input #%d: priority %d, num %d", idx, input.GetPriority(), input.GetNum())
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,464 @@
+package ffstreamserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"github.com/xaionaro-go/observability"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// HTTPGatewayPathPrefix is the prefix of the paths of the HTTP/JSON
+	// gateway, the path of an RPC is the prefix followed by its name,
+	// e.g. "/v1/GetStats".
+	HTTPGatewayPathPrefix = "/v1/"
+
+	// httpGatewayMaxRequestSize limits the size of a request body.
+	httpGatewayMaxRequestSize = 16 << 20
+)
+
+var (
+	httpGatewayUnmarshalOptions = protojson.UnmarshalOptions{}
+	httpGatewayMarshalOptions   = protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}
+)
+
+// HTTPGateway exposes the RPCs of the control API as HTTP/JSON endpoints:
+//   - the request message is read (in the protojson form) from the body
+//     of a POST request (which should be of type "application/json"), or
+//     from the "request" query parameter of a GET request (e.g. by
+//     an EventSource in a browser); no request means an empty request message;
+//   - GET is allowed only for the read-only (see MethodScope) and
+//     the streaming RPCs, and a request with the "Origin" header of
+//     another host is rejected, so that a foreign web page cannot call
+//     the RPCs with the credentials of the browser;
+//   - a unary RPC replies with the reply message, an error is replied
+//     with the HTTP status corresponding to its gRPC code and a JSON body
+//     {"code": "...", "message": "..."};
+//   - a server-streaming RPC (e.g. Monitor, WaitChan, SubscribeEvents)
+//     replies with Server-Sent Events, one "data:" event per message,
+//     and an "error" event if the RPC ends with an error;
+//   - if Auth is enabled, the token is taken from the "Authorization: Bearer"
+//     header, or from the "access_token" query parameter (since an
+//     EventSource cannot set headers);
+//   - the stream ID (see StreamIDMetadataKey) is taken from the
+//     StreamIDHTTPHeader header or the StreamIDQueryParameter query parameter.
+//
+// GET HTTPGatewayPathPrefix lists the available RPCs.
+//
+// The services are registered the same way as on a gRPC server (e.g. by
+// ffstream_grpc.RegisterFFStreamServer); the names of the RPCs should
+// be unique across the services.
+type HTTPGateway struct {
+	Auth *Auth
+	rpcs map[string]httpGatewayRPC
+}
+
+type httpGatewayRPC struct {
+	FullMethod string
+	Server     any
+	Unary      grpc.MethodHandler
+	Stream     *grpc.StreamDesc
+}
+
+var (
+	_ http.Handler          = (*HTTPGateway)(nil)
+	_ grpc.ServiceRegistrar = (*HTTPGateway)(nil)
+)
+
+// NewHTTPGateway returns a gateway to the given implementation of
+// the control API; if server is nil, the services should be added
+// by RegisterService.
+func NewHTTPGateway(server ffstream_grpc.FFStreamServer) *HTTPGateway {
+	gw := &HTTPGateway{
+		rpcs: map[string]httpGatewayRPC{},
+	}
+	if server != nil {
+		ffstream_grpc.RegisterFFStreamServer(gw, server)
+	}
+	return gw
+}
+
+// RegisterService implements grpc.ServiceRegistrar.
+func (gw *HTTPGateway) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	if impl != nil {
+		ht := reflect.TypeOf(desc.HandlerType).Elem()
+		if !reflect.TypeOf(impl).Implements(ht) {
+			panic(fmt.Sprintf("HTTPGateway.RegisterService: handler of type %T does not satisfy %v", impl, ht))
+		}
+	}
+	add := func(name string, rpc httpGatewayRPC) {
+		if _, ok := gw.rpcs[name]; ok {
+			panic(fmt.Sprintf("HTTPGateway.RegisterService: duplicate RPC %q", name))
+		}
+		rpc.FullMethod = "/" + desc.ServiceName + "/" + name
+		rpc.Server = impl
+		gw.rpcs[name] = rpc
+	}
+	for _, md := range desc.Methods {
+		add(md.MethodName, httpGatewayRPC{Unary: md.Handler})
+	}
+	for _, sd := range desc.Streams {
+		add(sd.StreamName, httpGatewayRPC{Stream: &sd})
+	}
+}
+
+// ServeHTTPGateway serves the HTTP/JSON gateway (see HTTPGateway) of
+// the services registered by register, and the web dashboard (see
+// DashboardHandler) on the listener until ctx is cancelled.
+func ServeHTTPGateway(
+	ctx context.Context,
+	listener net.Listener,
+	auth *Auth,
+	register func(grpc.ServiceRegistrar),
+) error {
+	mux := http.NewServeMux()
+	gw := NewHTTPGateway(nil)
+	gw.Auth = auth
+	register(gw)
+	mux.Handle(HTTPGatewayPathPrefix, gw)
+	mux.Handle("/", DashboardHandler())
+	srv := &http.Server{
+		Handler: mux,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+	observability.Go(ctx, func(ctx context.Context) {
+		<-ctx.Done()
+		_ = srv.Close()
+	})
+	err := srv.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// ServeHTTPContext serves the HTTP/JSON gateway (see HTTPGateway)
+// of the control API and the web dashboard (see DashboardHandler)
+// on the listener until ctx is cancelled.
+func (s *FFStreamServer) ServeHTTPContext(
+	ctx context.Context,
+	listener net.Listener,
+) error {
+	ffstreamGRPC := NewGRPCServer(ctx, s.ffStream)
+	ffstreamGRPC.ConfigApplier = s.ConfigApplier
+	return ServeHTTPGateway(ctx, listener, s.Auth, func(r grpc.ServiceRegistrar) {
+		ffstream_grpc.RegisterFFStreamServer(r, ffstreamGRPC)
+	})
+}
+
+func (gw *HTTPGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.Debugf(ctx, "ServeHTTP: %s %s", r.Method, r.URL.Path)
+	defer func() { logger.Debugf(ctx, "/ServeHTTP: %s %s", r.Method, r.URL.Path) }()
+
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeHTTPGatewayMethodNotAllowed(w, "GET, POST", status.Errorf(codes.Unimplemented, "method %s is not supported", r.Method))
+		return
+	}
+	methodName, ok := strings.CutPrefix(r.URL.Path, HTTPGatewayPathPrefix)
+	if !ok {
+		writeHTTPGatewayError(w, status.Errorf(codes.NotFound, "unknown path %q", r.URL.Path))
+		return
+	}
+
+	if methodName == "" {
+		gw.serveList(w)
+		return
+	}
+	token := BearerToken(r.Header.Get("Authorization"))
+	if token == "" {
+		token = r.URL.Query().Get("access_token")
+	}
+	rpc, ok := gw.rpcs[methodName]
+	if !ok {
+		writeHTTPGatewayError(w, status.Errorf(codes.NotFound, "unknown RPC %q", methodName))
+		return
+	}
+	if r.Method == http.MethodGet && rpc.Stream == nil && MethodScope(rpc.FullMethod) != ScopeReadOnly {
+		writeHTTPGatewayMethodNotAllowed(w, "POST", status.Errorf(codes.Unimplemented, "RPC %q changes the state, so it requires POST", methodName))
+		return
+	}
+	if !isSameOriginRequest(r) {
+		writeHTTPGatewayError(w, status.Errorf(codes.PermissionDenied, "cross-origin requests from %q are not allowed", r.Header.Get("Origin")))
+		return
+	}
+	if err := gw.Auth.Authorize(token, rpc.FullMethod); err != nil {
+		writeHTTPGatewayError(w, err)
+		return
+	}
+	if streamID := streamIDFromHTTPRequest(r); streamID != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(StreamIDMetadataKey, streamID))
+	}
+	if rpc.Stream != nil {
+		gw.serveStream(ctx, w, r, rpc)
+		return
+	}
+	gw.serveUnary(ctx, w, r, rpc)
+}
+
+func (gw *HTTPGateway) serveList(w http.ResponseWriter) {
+	type rpc struct {
+		Name      string `json:"name"`
+		Streaming bool   `json:"streaming"`
+	}
+	var rpcs []rpc
+	for name, r := range gw.rpcs {
+		rpcs = append(rpcs, rpc{Name: name, Streaming: r.Stream != nil})
+	}
+	sort.Slice(rpcs, func(i, j int) bool {
+		return rpcs[i].Name < rpcs[j].Name
+	})
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"rpcs": rpcs})
+}
+
+func (gw *HTTPGateway) serveUnary(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	rpc httpGatewayRPC,
+) {
+	reqBody, err := readHTTPGatewayRequest(w, r)
+	if err != nil {
+		writeHTTPGatewayError(w, err)
+		return
+	}
+	reply, err := rpc.Unary(rpc.Server, ctx, func(req any) error {
+		return unmarshalHTTPGatewayRequest(reqBody, req)
+	}, nil)
+	if err != nil {
+		writeHTTPGatewayError(w, err)
+		return
+	}
+	b, err := httpGatewayMarshalOptions.Marshal(reply.(proto.Message))
+	if err != nil {
+		writeHTTPGatewayError(w, status.Errorf(codes.Internal, "unable to serialize the reply: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (gw *HTTPGateway) serveStream(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	rpc httpGatewayRPC,
+) {
+	if rpc.Stream.ClientStreams {
+		writeHTTPGatewayError(w, status.Errorf(codes.Unimplemented, "client-streaming RPCs are not supported"))
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeHTTPGatewayError(w, status.Errorf(codes.Internal, "the connection does not support streaming"))
+		return
+	}
+	reqBody, err := readHTTPGatewayRequest(w, r)
+	if err != nil {
+		writeHTTPGatewayError(w, err)
+		return
+	}
+
+	stream := &sseServerStream{
+		ctx:     ctx,
+		w:       w,
+		flusher: flusher,
+		reqBody: reqBody,
+	}
+	err = rpc.Stream.Handler(rpc.Server, stream)
+	stream.locker.Lock()
+	defer stream.locker.Unlock()
+	if !stream.isStarted {
+		if err == nil {
+			stream.startLocked()
+			return
+		}
+		writeHTTPGatewayError(w, err)
+		return
+	}
+	if err != nil {
+		b, _ := json.Marshal(httpGatewayErrorBody(err))
+		_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", b)
+		flusher.Flush()
+	}
+}
+
+// sseServerStream is a grpc.ServerStream sending the messages
+// as Server-Sent Events.
+type sseServerStream struct {
+	ctx       context.Context
+	w         http.ResponseWriter
+	flusher   http.Flusher
+	reqBody   []byte
+	locker    sync.Mutex
+	isStarted bool
+	isRecv    bool
+}
+
+var _ grpc.ServerStream = (*sseServerStream)(nil)
+
+func (s *sseServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *sseServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *sseServerStream) SetTrailer(metadata.MD)       {}
+func (s *sseServerStream) Context() context.Context     { return s.ctx }
+
+func (s *sseServerStream) RecvMsg(m any) error {
+	if s.isRecv {
+		return io.EOF
+	}
+	s.isRecv = true
+	return unmarshalHTTPGatewayRequest(s.reqBody, m)
+}
+
+func (s *sseServerStream) SendMsg(m any) error {
+	b, err := httpGatewayMarshalOptions.Marshal(m.(proto.Message))
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to serialize the message: %v", err)
+	}
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.startLocked()
+	if _, err := fmt.Fprintf(s.w, "data: %s\n\n", b); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *sseServerStream) startLocked() {
+	if s.isStarted {
+		return
+	}
+	s.isStarted = true
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.WriteHeader(http.StatusOK)
+	s.flusher.Flush()
+}
+
+// isSameOriginRequest returns true if the request has no "Origin" header
+// (e.g. it is not sent by a browser) or the origin is the requested host.
+func isSameOriginRequest(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return u.Host != "" && strings.EqualFold(u.Host, r.Host)
+}
+
+func readHTTPGatewayRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) ([]byte, error) {
+	if r.Method == http.MethodGet {
+		return []byte(r.URL.Query().Get("request")), nil
+	}
+	// a web page may send a form or plain text to another site without
+	// a CORS preflight request, but not JSON
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		return nil, status.Errorf(codes.InvalidArgument, "the request should be of type application/json, got %q", r.Header.Get("Content-Type"))
+	}
+	b, err := io.ReadAll(http.MaxBytesReader(w, r.Body, httpGatewayMaxRequestSize))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to read the request: %v", err)
+	}
+	return b, nil
+}
+
+func unmarshalHTTPGatewayRequest(b []byte, req any) error {
+	if len(b) == 0 {
+		return nil
+	}
+	if err := httpGatewayUnmarshalOptions.Unmarshal(b, req.(proto.Message)); err != nil {
+		return status.Errorf(codes.InvalidArgument, "unable to parse the request: %v", err)
+	}
+	return nil
+}
+
+func httpGatewayErrorBody(err error) map[string]string {
+	st := status.Convert(err)
+	return map[string]string{
+		"code":    st.Code().String(),
+		"message": st.Message(),
+	}
+}
+
+func writeHTTPGatewayError(w http.ResponseWriter, err error) {
+	if status.Code(err) == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFromCode(status.Code(err)))
+	_ = json.NewEncoder(w).Encode(httpGatewayErrorBody(err))
+}
+
+func writeHTTPGatewayMethodNotAllowed(w http.ResponseWriter, allow string, err error) {
+	w.Header().Set("Allow", allow)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusMethodNotAllowed)
+	_ = json.NewEncoder(w).Encode(httpGatewayErrorBody(err))
+}
+
+// httpStatusFromCode maps gRPC codes to HTTP statuses the same way
+// as grpc-gateway does.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,165 @@
+package ffstreamserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testGatewayServer struct {
+	ffstream_grpc.UnimplementedFFStreamServer
+}
+
+func (testGatewayServer) SetFPSFraction(
+	_ context.Context,
+	req *ffstream_grpc.SetFPSFractionRequest,
+) (*ffstream_grpc.SetFPSFractionReply, error) {
+	if req.GetDen() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "zero denominator")
+	}
+	return &ffstream_grpc.SetFPSFractionReply{}, nil
+}
+
+func (testGatewayServer) GetFPSFraction(
+	context.Context,
+	*ffstream_grpc.GetFPSFractionRequest,
+) (*ffstream_grpc.GetFPSFractionReply, error) {
+	return &ffstream_grpc.GetFPSFractionReply{Num: 1, Den: 2}, nil
+}
+
+func (testGatewayServer) WaitChan(
+	_ *ffstream_grpc.WaitRequest,
+	srv ffstream_grpc.FFStream_WaitChanServer,
+) error {
+	for range 2 {
+		if err := srv.Send(&ffstream_grpc.WaitReply{}); err != nil {
+			return err
+		}
+	}
+	return status.Errorf(codes.Aborted, "the end")
+}
+
+func doGatewayRequest(
+	t *testing.T,
+	method string,
+	target string,
+	body string,
+) (int, string, string) {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	NewHTTPGateway(testGatewayServer{}).ServeHTTP(rec, req)
+	resp := rec.Result()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, resp.Header.Get("Content-Type"), string(b)
+}
+
+func TestHTTPGateway(t *testing.T) {
+	code, _, body := doGatewayRequest(t, http.MethodGet, "/v1/GetFPSFraction", "")
+	if code != http.StatusOK || !strings.Contains(body, `"num":1`) || !strings.Contains(body, `"den":2`) {
+		t.Errorf("unexpected reply of GetFPSFraction: %d %s", code, body)
+	}
+
+	code, _, body = doGatewayRequest(t, http.MethodPost, "/v1/SetFPSFraction", `{"num":1,"den":0}`)
+	if code != http.StatusBadRequest || !strings.Contains(body, "InvalidArgument") {
+		t.Errorf("unexpected reply of an invalid SetFPSFraction: %d %s", code, body)
+	}
+
+	code, _, body = doGatewayRequest(t, http.MethodGet, "/v1/SetFPSFraction?request="+url.QueryEscape(`{"num":1,"den":1}`), "")
+	if code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d for GET of a mutating RPC, got %d %s", http.StatusMethodNotAllowed, code, body)
+	}
+
+	code, _, body = doGatewayRequest(t, http.MethodGet, "/v1/GetFPSFraction?request="+url.QueryEscape(`{}`), "")
+	if code != http.StatusOK {
+		t.Errorf("unexpected reply of GetFPSFraction with the query request: %d %s", code, body)
+	}
+
+	code, _, body = doGatewayRequest(t, http.MethodPost, "/v1/SetFPSFraction", `{"nom":1}`)
+	if code != http.StatusBadRequest {
+		t.Errorf("expected an error on an unknown field, got %d %s", code, body)
+	}
+
+	code, _, _ = doGatewayRequest(t, http.MethodGet, "/v1/GetStats", "")
+	if code != http.StatusNotImplemented {
+		t.Errorf("expected status %d for an unimplemented RPC, got %d", http.StatusNotImplemented, code)
+	}
+
+	code, _, _ = doGatewayRequest(t, http.MethodGet, "/v1/NoSuchRPC", "")
+	if code != http.StatusNotFound {
+		t.Errorf("expected status %d for an unknown RPC, got %d", http.StatusNotFound, code)
+	}
+
+	code, _, body = doGatewayRequest(t, http.MethodGet, "/v1/", "")
+	if code != http.StatusOK || !strings.Contains(body, `{"name":"SubscribeEvents","streaming":true}`) {
+		t.Errorf("unexpected list of the RPCs: %d %s", code, body)
+	}
+}
+
+func TestHTTPGatewayCrossSite(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		contentType string
+		origin      string
+		code        int
+	}{
+		{"json", "application/json", "", http.StatusOK},
+		{"json_with_charset", "application/json; charset=utf-8", "", http.StatusOK},
+		{"same_origin", "application/json", "http://ffstream.example", http.StatusOK},
+		{"form", "application/x-www-form-urlencoded", "", http.StatusBadRequest},
+		{"text", "text/plain", "", http.StatusBadRequest},
+		{"no_content_type", "", "", http.StatusBadRequest},
+		{"foreign_origin", "application/json", "http://evil.example", http.StatusForbidden},
+		{"null_origin", "application/json", "null", http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "http://ffstream.example/v1/SetFPSFraction", strings.NewReader(`{"num":1,"den":1}`))
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+			if tc.origin != "" {
+				req.Header.Set("Origin", tc.origin)
+			}
+			rec := httptest.NewRecorder()
+			NewHTTPGateway(testGatewayServer{}).ServeHTTP(rec, req)
+			if rec.Code != tc.code {
+				t.Errorf("expected status %d, got %d %s", tc.code, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHTTPGatewayStream(t *testing.T) {
+	code, contentType, body := doGatewayRequest(t, http.MethodGet, "/v1/WaitChan", "")
+	if code != http.StatusOK || contentType != "text/event-stream" {
+		t.Fatalf("unexpected reply of WaitChan: %d %q", code, contentType)
+	}
+	want := "data: {}\n\n" +
+		"data: {}\n\n" +
+		"event: error\ndata: {\"code\":\"Aborted\",\"message\":\"the end\"}\n\n"
+	if body != want {
+		t.Errorf("unexpected events\nwant: %q\n got: %q", want, body)
+	}
+}
+
+func TestDashboardHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	DashboardHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `const API = "v1/"`) {
+		t.Errorf("unexpected dashboard page: %d", rec.Code)
+	}
+}
@@ -0,0 +1,71 @@
+package ffstreamserver
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+// Listen listens at the address of a control API in one of the forms:
+//   - "<path>" or "unix:<path>": a UNIX socket;
+//   - "tcp:<host>:<port>" (or "tcp4:", "tcp6:"): a plain TCP socket;
+//   - "tls:<host>:<port>" (or "tcp+ssl:"): a TCP socket with TLS,
+//     configured by tlsConfig;
+//   - "<host>:<port>" (deprecated): the same as "tls:<host>:<port>",
+//     as it was before the schemes were introduced.
+func Listen(
+	ctx context.Context,
+	addr string,
+	tlsConfig func() (*tls.Config, error),
+) (net.Listener, error) {
+	scheme, hostPort, ok := strings.Cut(addr, ":")
+	if !ok {
+		return net.Listen("unix", addr)
+	}
+
+	switch scheme {
+	case "unix", "unixpacket", "tcp", "tcp4", "tcp6":
+		return net.Listen(scheme, hostPort)
+	case "tls", "tcp+ssl":
+		return listenTLS(hostPort, tlsConfig)
+	default:
+		if isHostPort(addr) {
+			logger.Warnf(ctx, "the address %q without a scheme is deprecated, use \"tls:%s\" (or \"tcp:%s\" for a plain TCP socket)", addr, addr, addr)
+			return listenTLS(addr, tlsConfig)
+		}
+		return nil, fmt.Errorf("unknown scheme %q in address %q, expected one of: unix:<path>, tcp:<host>:<port>, tls:<host>:<port>", scheme, addr)
+	}
+}
+
+func listenTLS(
+	hostPort string,
+	tlsConfig func() (*tls.Config, error),
+) (net.Listener, error) {
+	cfg, err := tlsConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the TLS config: %w", err)
+	}
+	cfg = cfg.Clone()
+	cfg.NextProtos = append(cfg.NextProtos, "h2", "http/1.1")
+	listener, err := tls.Listen("tcp", hostPort, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TLS listener at %s: %w", hostPort, err)
+	}
+	return listener, nil
+}
+
+// isHostPort returns true if addr is "<host>:<port>" with a numeric port,
+// e.g. "0.0.0.0:3594" or "[::1]:3594".
+func isHostPort(addr string) bool {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	_, err = strconv.ParseUint(port, 10, 16)
+	return err == nil
+}
@@ -0,0 +1,71 @@
+package ffstreamserver
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+	"testing"
+
+	"github.com/xaionaro-go/ffstream/pkg/cert"
+)
+
+func TestListen(t *testing.T) {
+	ctx := context.Background()
+	tlsConfig := func() (*tls.Config, error) {
+		serverCert, err := cert.GenerateSelfSignedForServer()
+		if err != nil {
+			return nil, err
+		}
+		return &tls.Config{Certificates: []tls.Certificate{serverCert}}, nil
+	}
+
+	for _, tc := range []struct {
+		addr  string
+		isTLS bool
+	}{
+		{"tcp:127.0.0.1:0", false},
+		{"tls:127.0.0.1:0", true},
+		// the addresses without a scheme are TLS, as before the schemes
+		{"127.0.0.1:0", true},
+		{"localhost:0", true},
+	} {
+		t.Run(tc.addr, func(t *testing.T) {
+			listener, err := Listen(ctx, tc.addr, tlsConfig)
+			if err != nil {
+				t.Fatalf("unable to listen at %q: %v", tc.addr, err)
+			}
+			defer listener.Close()
+
+			errCh := make(chan error, 1)
+			go func() {
+				conn, err := listener.Accept()
+				if err != nil {
+					errCh <- err
+					return
+				}
+				defer conn.Close()
+				if tlsConn, ok := conn.(*tls.Conn); ok {
+					errCh <- tlsConn.HandshakeContext(ctx)
+					return
+				}
+				errCh <- nil
+			}()
+
+			if !tc.isTLS {
+				return
+			}
+			conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+			if err != nil {
+				t.Fatalf("unable to connect to %q with TLS: %v", tc.addr, err)
+			}
+			defer conn.Close()
+			if err := <-errCh; err != nil {
+				t.Fatalf("unable to accept the connection: %v", err)
+			}
+		})
+	}
+
+	if _, err := Listen(ctx, "ftp:127.0.0.1:0", tlsConfig); err == nil || !strings.Contains(err.Error(), "unknown scheme") {
+		t.Fatalf("expected an unknown scheme error, got %v", err)
+	}
+}
@@ -0,0 +1,21 @@
+package ffstreamserver
+
+import (
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	xlogrus "github.com/facebookincubator/go-belt/tool/logger/implementation/logrus"
+	"github.com/sirupsen/logrus"
+	"github.com/xaionaro-go/avpipeline"
+	"github.com/xaionaro-go/observability"
+)
+
+// SetProcessLoggingLevel changes the logging level of the whole process:
+// the level filter of our logger, the standard logrus logger (used directly
+// by some dependencies) and libav* logging. Under ffstreamd it affects all
+// the streams, so it is exposed by FFStreamDaemon.SetDaemonLoggingLevel
+// instead of the per-stream FFStream.SetLoggingLevel.
+func SetProcessLoggingLevel(level logger.Level) {
+	observability.LogLevelFilter.SetLevel(level)
+	logrus.SetLevel(xlogrus.LevelToLogrus(level))
+	astiav.SetLogLevel(avpipeline.LogLevelToAstiav(level))
+}
@@ -0,0 +1,115 @@
+package ffstreamserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// StreamIDMetadataKey is the gRPC metadata addressing the RPCs of FFStream
+	// to one of the streams of a server hosting many of them (see ffstreamd).
+	StreamIDMetadataKey = "ffstream-stream-id"
+
+	// StreamIDHTTPHeader is the same as StreamIDMetadataKey for the HTTP/JSON gateway.
+	StreamIDHTTPHeader = "X-FFStream-Stream-ID"
+
+	// StreamIDQueryParameter is the same as StreamIDHTTPHeader, but as a query
+	// parameter (since an EventSource cannot set headers).
+	StreamIDQueryParameter = "stream"
+)
+
+// StreamIDFromContext returns the stream ID of the incoming request
+// (see StreamIDMetadataKey), or an empty string.
+func StreamIDFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(StreamIDMetadataKey) {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func streamIDFromHTTPRequest(r *http.Request) string {
+	if v := r.Header.Get(StreamIDHTTPHeader); v != "" {
+		return v
+	}
+	return r.URL.Query().Get(StreamIDQueryParameter)
+}
+
+// FFStreamResolver returns the implementation of FFStream handling
+// the request, e.g. by its StreamIDFromContext.
+type FFStreamResolver func(ctx context.Context) (ffstream_grpc.FFStreamServer, error)
+
+// RegisterFFStreamResolver registers FFStream on the registrar (a gRPC
+// server or an HTTPGateway), handling each RPC by the implementation
+// returned by resolve.
+//
+// The implementation is resolved after the interceptors are passed, so
+// that, for example, an unauthenticated client cannot probe the streams.
+func RegisterFFStreamResolver(
+	registrar grpc.ServiceRegistrar,
+	resolve FFStreamResolver,
+) {
+	desc := ffstream_grpc.FFStream_ServiceDesc
+	desc.Methods = make([]grpc.MethodDesc, 0, len(ffstream_grpc.FFStream_ServiceDesc.Methods))
+	for _, md := range ffstream_grpc.FFStream_ServiceDesc.Methods {
+		md.Handler = resolvedUnaryHandler(md.Handler, resolve)
+		desc.Methods = append(desc.Methods, md)
+	}
+	desc.Streams = make([]grpc.StreamDesc, 0, len(ffstream_grpc.FFStream_ServiceDesc.Streams))
+	for _, sd := range ffstream_grpc.FFStream_ServiceDesc.Streams {
+		handler := sd.Handler
+		sd.Handler = func(_ any, stream grpc.ServerStream) error {
+			srv, err := resolve(stream.Context())
+			if err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}
+		desc.Streams = append(desc.Streams, sd)
+	}
+	registrar.RegisterService(&desc, ffstream_grpc.UnimplementedFFStreamServer{})
+}
+
+// resolvedUnaryHandler returns a grpc.MethodHandler calling the interceptor
+// (if any) first, and only then the handler of the resolved implementation
+// with the already decoded request.
+func resolvedUnaryHandler(
+	handler grpc.MethodHandler,
+	resolve FFStreamResolver,
+) grpc.MethodHandler {
+	return func(
+		srv any,
+		ctx context.Context,
+		dec func(any) error,
+		interceptor grpc.UnaryServerInterceptor,
+	) (any, error) {
+		return handler(srv, ctx, dec, func(
+			ctx context.Context,
+			req any,
+			info *grpc.UnaryServerInfo,
+			_ grpc.UnaryHandler,
+		) (any, error) {
+			resolvedHandler := func(ctx context.Context, req any) (any, error) {
+				srv, err := resolve(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return handler(srv, ctx, func(in any) error {
+					proto.Merge(in.(proto.Message), req.(proto.Message))
+					return nil
+				}, nil)
+			}
+			if interceptor == nil {
+				return resolvedHandler(ctx, req)
+			}
+			return interceptor(ctx, req, info, resolvedHandler)
+		})
+	}
+}
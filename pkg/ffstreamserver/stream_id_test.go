@@ -0,0 +1,104 @@
+package ffstreamserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testRegistrar struct {
+	desc *grpc.ServiceDesc
+	impl any
+}
+
+func (r *testRegistrar) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	r.desc, r.impl = desc, impl
+}
+
+func testResolver(resolved *int) FFStreamResolver {
+	return func(ctx context.Context) (ffstream_grpc.FFStreamServer, error) {
+		*resolved++
+		if StreamIDFromContext(ctx) != "a" {
+			return nil, status.Errorf(codes.NotFound, "unknown stream")
+		}
+		return testGatewayServer{}, nil
+	}
+}
+
+func TestRegisterFFStreamResolverHTTP(t *testing.T) {
+	var resolved int
+	gw := NewHTTPGateway(nil)
+	RegisterFFStreamResolver(gw, testResolver(&resolved))
+
+	for _, tc := range []struct {
+		Target string
+		Header string
+		Code   int
+	}{
+		{"/v1/GetFPSFraction", "a", http.StatusOK},
+		{"/v1/GetFPSFraction?stream=a", "", http.StatusOK},
+		{"/v1/GetFPSFraction?stream=b", "", http.StatusNotFound},
+		{"/v1/GetFPSFraction", "", http.StatusNotFound},
+		{"/v1/WaitChan?stream=a", "", http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodGet, tc.Target, nil)
+		if tc.Header != "" {
+			req.Header.Set(StreamIDHTTPHeader, tc.Header)
+		}
+		rec := httptest.NewRecorder()
+		gw.ServeHTTP(rec, req)
+		body, _ := io.ReadAll(rec.Result().Body)
+		if rec.Code != tc.Code {
+			t.Errorf("%s (header %q): expected status %d, got %d %s", tc.Target, tc.Header, tc.Code, rec.Code, body)
+		}
+	}
+	if resolved != 5 {
+		t.Errorf("expected 5 resolutions, got %d", resolved)
+	}
+}
+
+func TestRegisterFFStreamResolverInterceptor(t *testing.T) {
+	var resolved int
+	r := &testRegistrar{}
+	RegisterFFStreamResolver(r, testResolver(&resolved))
+
+	var handler grpc.MethodHandler
+	for _, md := range r.desc.Methods {
+		if md.MethodName == "GetFPSFraction" {
+			handler = md.Handler
+		}
+	}
+	if handler == nil {
+		t.Fatal("GetFPSFraction is not registered")
+	}
+	dec := func(any) error { return nil }
+
+	deny := func(context.Context, any, *grpc.UnaryServerInfo, grpc.UnaryHandler) (any, error) {
+		return nil, status.Errorf(codes.Unauthenticated, "denied")
+	}
+	if _, err := handler(r.impl, context.Background(), dec, deny); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected the error of the interceptor, got %v", err)
+	}
+	if resolved != 0 {
+		t.Errorf("the stream is resolved before the interceptor")
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(StreamIDMetadataKey, "a"))
+	reply, err := handler(r.impl, ctx, dec, func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+		return h(ctx, req)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reply.(*ffstream_grpc.GetFPSFractionReply); got.GetNum() != 1 || got.GetDen() != 2 {
+		t.Errorf("unexpected reply %v", got)
+	}
+}
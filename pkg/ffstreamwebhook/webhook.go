@@ -0,0 +1,272 @@
+// Package ffstreamwebhook sends the events of an FFStream
+// (see FFStream.SubscribeEvents) to an HTTP endpoint.
+package ffstreamwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver"
+	"github.com/xaionaro-go/ffstream/pkg/ffstreamserver/grpc/go/ffstream_grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// HeaderEventType is the header with the type of the event, e.g. "output_switch".
+	HeaderEventType = "X-FFStream-Event"
+
+	// HeaderSignature is the header with the HMAC-SHA256 signature of
+	// the body (see Signature); it is set only if Config.Secret is set.
+	HeaderSignature = "X-FFStream-Signature-256"
+
+	// DefaultTimeout is the default timeout of a single request.
+	DefaultTimeout = 10 * time.Second
+)
+
+// DefaultRetryPolicy returns the default policy of re-sending an event
+// after a failed request.
+func DefaultRetryPolicy() ffstream.RetryPolicy {
+	p := ffstream.DefaultRetryPolicy()
+	p.InitialInterval = time.Second
+	p.Jitter = 0.1
+	p.MaxAttempts = 5
+	return p
+}
+
+// Config is the configuration of a Notifier.
+type Config struct {
+	// URL is the endpoint the events are POSTed to.
+	URL string
+
+	// Secret is the key of the HMAC signature; empty means
+	// the requests are not signed.
+	Secret string
+
+	// EventTypes are the types of the events to be sent (see EventTypes);
+	// empty means all of them.
+	EventTypes []string
+
+	// RetryPolicy defines the re-sending of an event after a network error,
+	// a 5xx or a 429 response; OnExhausted is ignored.
+	RetryPolicy ffstream.RetryPolicy
+
+	// Timeout is the timeout of a single request; zero means no timeout.
+	Timeout time.Duration
+}
+
+// DrainTimeout returns how long to wait for the last events (e.g.
+// end_of_stream) to be delivered after the stream is finished: the request
+// timeout, or DefaultTimeout if the requests have no timeout.
+func (cfg Config) DrainTimeout() time.Duration {
+	if cfg.Timeout > 0 {
+		return cfg.Timeout
+	}
+	return DefaultTimeout
+}
+
+// Notifier POSTs the events as JSON (the Event message of the control API
+// in the protojson form) to Config.URL.
+type Notifier struct {
+	Config     Config
+	HTTPClient *http.Client
+	eventTypes map[string]struct{}
+}
+
+// New validates the config and returns a new Notifier.
+func New(cfg Config) (*Notifier, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the URL %q: %w", cfg.URL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("the URL %q should be http or https", cfg.URL)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("the URL %q has no host", cfg.URL)
+	}
+	if err := cfg.RetryPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid retry policy: %w", err)
+	}
+	if cfg.Timeout < 0 {
+		return nil, fmt.Errorf("the timeout is negative: %v", cfg.Timeout)
+	}
+
+	knownTypes := map[string]struct{}{}
+	for _, t := range EventTypes() {
+		knownTypes[t] = struct{}{}
+	}
+	var eventTypes map[string]struct{}
+	if len(cfg.EventTypes) != 0 {
+		eventTypes = map[string]struct{}{}
+	}
+	for _, t := range cfg.EventTypes {
+		if _, ok := knownTypes[t]; !ok {
+			return nil, fmt.Errorf("unknown event type %q, the known types are: %v", t, EventTypes())
+		}
+		eventTypes[t] = struct{}{}
+	}
+
+	return &Notifier{
+		Config:     cfg,
+		HTTPClient: http.DefaultClient,
+		eventTypes: eventTypes,
+	}, nil
+}
+
+// EventTypes returns the names of all the event types, as they are used
+// in HeaderEventType and in the JSON payload.
+func EventTypes() []string {
+	fields := eventOneof().Fields()
+	result := make([]string, 0, fields.Len())
+	for i := range fields.Len() {
+		result = append(result, string(fields.Get(i).Name()))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func eventOneof() protoreflect.OneofDescriptor {
+	return (&ffstream_grpc.Event{}).ProtoReflect().Descriptor().Oneofs().ByName("event")
+}
+
+// Signature returns the value of HeaderSignature for the body:
+// "sha256=" followed by the hex-encoded HMAC-SHA256 of the body.
+func Signature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send sends the event, if it passes the Config.EventTypes filter,
+// re-sending it according to Config.RetryPolicy.
+func (n *Notifier) Send(
+	ctx context.Context,
+	ev ffstream.Event,
+) (_err error) {
+	msg, err := ffstreamserver.EventToGRPC(ev)
+	if err != nil {
+		return err
+	}
+	eventType := eventType(msg)
+	if n.eventTypes != nil {
+		if _, ok := n.eventTypes[eventType]; !ok {
+			return nil
+		}
+	}
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the event: %w", err)
+	}
+
+	logger.Debugf(ctx, "Send: %s", eventType)
+	defer func() { logger.Debugf(ctx, "/Send: %s: %v", eventType, _err) }()
+
+	startedAt := time.Now()
+	// retry is the number of the next re-send (the initial request is not a retry)
+	for retry := uint(1); ; retry++ {
+		err := n.post(ctx, eventType, body)
+		if err == nil {
+			return nil
+		}
+		var permanentErr permanentError
+		if errors.As(err, &permanentErr) {
+			return err
+		}
+		if n.Config.RetryPolicy.IsExhausted(retry, time.Since(startedAt)) {
+			return fmt.Errorf("%w: %w", ffstream.ErrRetriesExhausted, err)
+		}
+		delay := n.Config.RetryPolicy.Backoff(retry)
+		logger.Warnf(ctx, "unable to send the event %s (retry %d), retrying in %v: %v", eventType, retry, delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+type permanentError struct {
+	error
+}
+
+func (e permanentError) Unwrap() error {
+	return e.error
+}
+
+func (n *Notifier) post(
+	ctx context.Context,
+	eventType string,
+	body []byte,
+) error {
+	if n.Config.Timeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, n.Config.Timeout)
+		defer cancelFn()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.Config.URL, bytes.NewReader(body))
+	if err != nil {
+		return permanentError{fmt.Errorf("unable to create the request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventType, eventType)
+	if n.Config.Secret != "" {
+		req.Header.Set(HeaderSignature, Signature(n.Config.Secret, body))
+	}
+
+	resp, err := n.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests:
+		return fmt.Errorf("received status %q", resp.Status)
+	default:
+		return permanentError{fmt.Errorf("received status %q", resp.Status)}
+	}
+}
+
+// Serve sends the events from the channel (see FFStream.SubscribeEvents)
+// one by one, until the channel is closed or an EndOfStreamEvent is sent.
+func (n *Notifier) Serve(
+	ctx context.Context,
+	events <-chan ffstream.Event,
+) {
+	logger.Debugf(ctx, "Serve")
+	defer func() { logger.Debugf(ctx, "/Serve") }()
+
+	for ev := range events {
+		if err := n.Send(ctx, ev); err != nil {
+			logger.Errorf(ctx, "unable to send the event %s to the webhook: %v", ev, err)
+		}
+		if _, ok := ev.(ffstream.EndOfStreamEvent); ok {
+			return
+		}
+	}
+}
+
+func eventType(msg *ffstream_grpc.Event) string {
+	field := msg.ProtoReflect().WhichOneof(eventOneof())
+	if field == nil {
+		return ""
+	}
+	return string(field.Name())
+}
@@ -0,0 +1,174 @@
+package ffstreamwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/ffstream/pkg/ffstream"
+)
+
+type receivedRequest struct {
+	EventType string
+	Signature string
+	Body      []byte
+}
+
+func newTestServer(
+	t *testing.T,
+	failures int,
+) (*httptest.Server, func() []receivedRequest) {
+	var (
+		locker   sync.Mutex
+		received []receivedRequest
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("unable to read the body: %v", err)
+		}
+		locker.Lock()
+		defer locker.Unlock()
+		received = append(received, receivedRequest{
+			EventType: r.Header.Get(HeaderEventType),
+			Signature: r.Header.Get(HeaderSignature),
+			Body:      body,
+		})
+		if len(received) <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []receivedRequest {
+		locker.Lock()
+		defer locker.Unlock()
+		return append([]receivedRequest(nil), received...)
+	}
+}
+
+func testRetryPolicy() ffstream.RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.InitialInterval = time.Millisecond
+	p.MaxInterval = time.Millisecond
+	p.Jitter = 0
+	p.MaxAttempts = 2
+	return p
+}
+
+func TestNotifierSend(t *testing.T) {
+	ctx := context.Background()
+	srv, received := newTestServer(t, 0)
+	n, err := New(Config{
+		URL:         srv.URL,
+		Secret:      "secret",
+		EventTypes:  []string{"bypass_changed"},
+		RetryPolicy: testRetryPolicy(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := n.Send(ctx, ffstream.FPSFractionChangedEvent{Time: time.Unix(1, 0), Num: 1, Den: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Send(ctx, ffstream.BypassChangedEvent{Time: time.Unix(1, 0), IsBypass: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := received()
+	if len(reqs) != 1 {
+		t.Fatalf("expected exactly one request (the filtered one), got %d", len(reqs))
+	}
+	req := reqs[0]
+	if req.EventType != "bypass_changed" {
+		t.Errorf("unexpected event type %q", req.EventType)
+	}
+	if want := Signature("secret", req.Body); req.Signature != want {
+		t.Errorf("unexpected signature %q, expected %q", req.Signature, want)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(req.Body, &payload); err != nil {
+		t.Fatalf("unable to parse the payload %q: %v", req.Body, err)
+	}
+	if _, ok := payload["bypass_changed"]; !ok {
+		t.Errorf("the payload has no bypass_changed: %s", req.Body)
+	}
+}
+
+func TestNotifierRetry(t *testing.T) {
+	ctx := context.Background()
+	srv, received := newTestServer(t, 1)
+	n, err := New(Config{
+		URL:         srv.URL,
+		RetryPolicy: testRetryPolicy(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := n.Send(ctx, ffstream.BypassChangedEvent{Time: time.Unix(1, 0)}); err != nil {
+		t.Fatal(err)
+	}
+	reqs := received()
+	if len(reqs) != 2 {
+		t.Fatalf("expected a retry after the failure, got %d requests", len(reqs))
+	}
+	if reqs[0].Signature != "" {
+		t.Errorf("expected no signature without a secret, got %q", reqs[0].Signature)
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, cfg := range []Config{
+		{URL: "ftp://example.com", RetryPolicy: DefaultRetryPolicy()},
+		{URL: "http://example.com", RetryPolicy: DefaultRetryPolicy(), EventTypes: []string{"no_such_event"}},
+		{URL: "http://example.com", RetryPolicy: DefaultRetryPolicy(), Timeout: -time.Second},
+		{URL: "http://example.com"},
+	} {
+		if _, err := New(cfg); err == nil {
+			t.Errorf("expected an error for %#+v", cfg)
+		}
+	}
+}
+
+func TestConfigDrainTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{0, DefaultTimeout},
+		{time.Second, time.Second},
+		{time.Minute, time.Minute},
+	} {
+		if got := (Config{Timeout: tc.timeout}).DrainTimeout(); got != tc.expected {
+			t.Errorf("expected the drain timeout %v for the request timeout %v, got %v", tc.expected, tc.timeout, got)
+		}
+	}
+}
+
+func TestNotifierRetriesExhausted(t *testing.T) {
+	ctx := context.Background()
+	srv, received := newTestServer(t, 100)
+	policy := testRetryPolicy()
+	n, err := New(Config{
+		URL:         srv.URL,
+		RetryPolicy: policy,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = n.Send(ctx, ffstream.BypassChangedEvent{Time: time.Unix(1, 0)})
+	if !errors.Is(err, ffstream.ErrRetriesExhausted) {
+		t.Fatalf("expected the retries to be exhausted, got %v", err)
+	}
+	if got, want := len(received()), int(policy.MaxAttempts)+1; got != want {
+		t.Fatalf("expected the initial request and %d retries (%d requests), got %d requests", policy.MaxAttempts, want, got)
+	}
+}